@@ -0,0 +1,81 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"github.com/grpc-ecosystem/go-grpc-middleware/auth"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// authenticateApiKey handles calls presenting an "authorization: Bearer" key instead of a session.
+// The call acts as the admin who created the key but is limited to the key's scopes.
+func (s *robocupGrpcServer) authenticateApiKey(ctx context.Context, meta metadata.MD, fullMethodName string) (context.Context, error) {
+	token, err := grpc_auth.AuthFromMD(ctx, "bearer")
+	if err != nil {
+		return nil, err
+	}
+	apiKey, err := s.Store.FetchApiKeyByToken(ctx, token)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Error: %+v", err)
+	}
+	if apiKey == nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "API key invalid or revoked")
+	}
+	if !scopeAllowed(apiKey, fullMethodName) {
+		return nil, grpc.Errorf(codes.PermissionDenied, "API key is not permitted to call %s", fullMethodName)
+	}
+	authMeta := meta.Copy()
+	authMeta.Set("user-id", apiKey.GetCreatedBy())
+	// API keys carry scopes rather than roles
+	delete(authMeta, "user-role")
+	competitionID, err := s.activeCompetition(ctx, meta, "")
+	if err != nil {
+		return nil, statusError(err, "Internal error encountered while selecting competition")
+	}
+	ctx = crdbStore.WithAuditActor(ctx, apiKey.GetCreatedBy(), fullMethodName)
+	ctx = crdbStore.WithCompetition(ctx, competitionID)
+	return metadata.NewIncomingContext(ctx, authMeta), nil
+}
+
+func (s *robocupGrpcServer) CreateApiKey(ctx context.Context, req *serv.CreateApiKeyRequest) (*serv.CreateApiKeyResponse, error) {
+	if req.GetApiKey().GetName() == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "A name is required")
+	}
+	if len(req.GetApiKey().GetScopes()) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "At least one scope is required")
+	}
+	apiKey, token, err := s.Store.CreateApiKey(ctx, func(newKey *serv.ApiKey) error {
+		newKey.Name = req.GetApiKey().GetName()
+		newKey.Scopes = req.GetApiKey().GetScopes()
+		newKey.CreatedBy = currentUser(ctx).GetId()
+		return nil
+	})
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while creating API key")
+	}
+	return &serv.CreateApiKeyResponse{
+		ApiKey: apiKey,
+		Key:    token,
+	}, nil
+}
+
+func (s *robocupGrpcServer) GetApiKeys(ctx context.Context, req *serv.GetApiKeysRequest) (*serv.GetApiKeysResponse, error) {
+	apiKeys, err := s.Store.FetchApiKeys(ctx, nil, nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching API keys")
+	}
+	return &serv.GetApiKeysResponse{
+		ApiKeys: apiKeys,
+	}, nil
+}
+
+func (s *robocupGrpcServer) RevokeApiKey(ctx context.Context, req *serv.RevokeApiKeyRequest) (*serv.RevokeApiKeyResponse, error) {
+	err := s.Store.RevokeApiKey(ctx, req.GetApiKeyId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while revoking API key")
+	}
+	return &serv.RevokeApiKeyResponse{}, nil
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"github.com/golang/protobuf/ptypes"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const defaultAuditLogLimit = 500
+
+func (s *robocupGrpcServer) GetAuditLog(ctx context.Context, req *serv.GetAuditLogRequest) (*serv.GetAuditLogResponse, error) {
+	opts := &crdbStore.FetchAuditLogOptions{
+		Limit: defaultAuditLogLimit,
+	}
+	if entityType := req.GetEntityType(); entityType != "" {
+		opts.EntityType = &entityType
+	}
+	if entityID := req.GetEntityId(); entityID != "" {
+		opts.EntityID = &entityID
+	}
+	if actorID := req.GetActorId(); actorID != "" {
+		opts.ActorID = &actorID
+	}
+	if req.GetStartTime() != nil {
+		start, err := ptypes.Timestamp(req.GetStartTime())
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "Invalid start time: %v", err)
+		}
+		opts.Start = &start
+	}
+	if req.GetEndTime() != nil {
+		end, err := ptypes.Timestamp(req.GetEndTime())
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "Invalid end time: %v", err)
+		}
+		opts.End = &end
+	}
+	if req.GetLimit() > 0 {
+		opts.Limit = uint64(req.GetLimit())
+	}
+	entries, err := s.Store.FetchAuditLog(ctx, opts)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching audit log")
+	}
+	return &serv.GetAuditLogResponse{
+		Entries: entries,
+	}, nil
+}
@@ -0,0 +1,35 @@
+// Package auth contains the backends that can verify who is logging in.
+package auth
+
+import (
+	"context"
+)
+
+// Identity is a user as described by an authentication backend.
+type Identity struct {
+	// Provider is the name of the backend that verified the identity
+	Provider string
+	// Subject uniquely and permanently identifies the user within the provider
+	Subject string
+	// UserID is set when the identity already refers to a local user
+	UserID   string
+	Username string
+	Name     string
+}
+
+// PasswordAuthenticator verifies a username and password, as submitted to Login.
+// Authenticate returns nil without an error when the credentials are rejected.
+type PasswordAuthenticator interface {
+	Name() string
+	Authenticate(ctx context.Context, username, password string) (*Identity, error)
+}
+
+// RedirectAuthenticator verifies users by sending them to an external login page,
+// such as an OpenID Connect provider.
+type RedirectAuthenticator interface {
+	Name() string
+	// LoginURL returns the address to send the browser to. state is echoed back to the callback.
+	LoginURL(ctx context.Context, state string) (string, error)
+	// Callback exchanges the code returned to the callback for the user's identity.
+	Callback(ctx context.Context, code string) (*Identity, error)
+}
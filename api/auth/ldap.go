@@ -0,0 +1,148 @@
+package auth
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"gopkg.in/ldap.v2"
+	"net"
+	"net/url"
+	"strings"
+)
+
+// LDAPConfig describes a directory to authenticate against by binding as the user.
+type LDAPConfig struct {
+	Name string `json:"name"`
+	// URL of the directory, e.g. ldap://localhost:389 or ldaps://ldap.example.org
+	URL                string `json:"url"`
+	StartTLS           bool   `json:"startTLS"`
+	InsecureSkipVerify bool   `json:"insecureSkipVerify"`
+	// BindDN and BindPassword are used to search for the user. Anonymous search is used if empty.
+	BindDN       string `json:"bindDN"`
+	BindPassword string `json:"bindPassword"`
+	BaseDN       string `json:"baseDN"`
+	// UserFilter locates the user entry, with %s replaced by the escaped username. Defaults to (uid=%s).
+	UserFilter string `json:"userFilter"`
+	// SubjectAttribute permanently identifies the user. Defaults to the entry DN.
+	SubjectAttribute  string `json:"subjectAttribute"`
+	UsernameAttribute string `json:"usernameAttribute"`
+	NameAttribute     string `json:"nameAttribute"`
+	// AutoProvision creates a local user the first time someone logs in through this directory
+	AutoProvision bool `json:"autoProvision"`
+}
+
+// LDAPAuthenticator verifies passwords with a bind against an LDAP directory.
+type LDAPAuthenticator struct {
+	Config LDAPConfig
+}
+
+func (a *LDAPAuthenticator) Name() string {
+	return a.Config.Name
+}
+
+func (a *LDAPAuthenticator) dial() (*ldap.Conn, error) {
+	u, err := url.Parse(a.Config.URL)
+	if err != nil {
+		return nil, err
+	}
+	host := u.Host
+	tlsConfig := &tls.Config{
+		ServerName:         u.Hostname(),
+		InsecureSkipVerify: a.Config.InsecureSkipVerify,
+	}
+	switch u.Scheme {
+	case "ldaps":
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "636")
+		}
+		return ldap.DialTLS("tcp", host, tlsConfig)
+	case "ldap":
+		if u.Port() == "" {
+			host = net.JoinHostPort(u.Hostname(), "389")
+		}
+		conn, err := ldap.Dial("tcp", host)
+		if err != nil {
+			return nil, err
+		}
+		if a.Config.StartTLS {
+			if err = conn.StartTLS(tlsConfig); err != nil {
+				conn.Close()
+				return nil, err
+			}
+		}
+		return conn, nil
+	}
+	return nil, errors.New(fmt.Sprintf("Unsupported LDAP URL scheme %s", u.Scheme))
+}
+
+func (a *LDAPAuthenticator) Authenticate(ctx context.Context, username, password string) (*Identity, error) {
+	// An empty password would be an unauthenticated bind, which most servers accept
+	if username == "" || password == "" {
+		return nil, nil
+	}
+	conn, err := a.dial()
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error connecting to LDAP server: %+v", err))
+	}
+	defer conn.Close()
+	if a.Config.BindDN != "" {
+		if err = conn.Bind(a.Config.BindDN, a.Config.BindPassword); err != nil {
+			return nil, errors.New(fmt.Sprintf("Error binding LDAP search account: %+v", err))
+		}
+	}
+	filter := a.Config.UserFilter
+	if filter == "" {
+		filter = "(uid=%s)"
+	}
+	usernameAttribute := a.Config.UsernameAttribute
+	if usernameAttribute == "" {
+		usernameAttribute = "uid"
+	}
+	nameAttribute := a.Config.NameAttribute
+	if nameAttribute == "" {
+		nameAttribute = "cn"
+	}
+	attributes := []string{usernameAttribute, nameAttribute}
+	if a.Config.SubjectAttribute != "" {
+		attributes = append(attributes, a.Config.SubjectAttribute)
+	}
+	result, err := conn.Search(ldap.NewSearchRequest(
+		a.Config.BaseDN,
+		ldap.ScopeWholeSubtree, ldap.NeverDerefAliases, 0, 0, false,
+		strings.Replace(filter, "%s", ldap.EscapeFilter(username), -1),
+		attributes,
+		nil,
+	))
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error searching LDAP directory: %+v", err))
+	}
+	if len(result.Entries) != 1 {
+		return nil, nil
+	}
+	entry := result.Entries[0]
+	err = conn.Bind(entry.DN, password)
+	if err != nil {
+		if ldap.IsErrorWithCode(err, ldap.LDAPResultInvalidCredentials) {
+			return nil, nil
+		}
+		return nil, errors.New(fmt.Sprintf("Error binding LDAP user: %+v", err))
+	}
+	subject := entry.DN
+	if a.Config.SubjectAttribute != "" && entry.GetAttributeValue(a.Config.SubjectAttribute) != "" {
+		subject = entry.GetAttributeValue(a.Config.SubjectAttribute)
+	}
+	identity := &Identity{
+		Provider: a.Config.Name,
+		Subject:  subject,
+		Username: entry.GetAttributeValue(usernameAttribute),
+		Name:     entry.GetAttributeValue(nameAttribute),
+	}
+	if identity.Username == "" {
+		identity.Username = username
+	}
+	if identity.Name == "" {
+		identity.Name = identity.Username
+	}
+	return identity, nil
+}
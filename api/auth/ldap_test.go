@@ -0,0 +1,268 @@
+package auth
+
+import (
+	"context"
+	"gopkg.in/asn1-ber.v1"
+	"gopkg.in/ldap.v2"
+	"net"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type ldapEntry struct {
+	dn         string
+	password   string
+	attributes map[string][]string
+}
+
+// ldapStub is an in-process directory answering simple binds and equality searches against a
+// fixed set of entries.
+type ldapStub struct {
+	listener net.Listener
+	entries  []*ldapEntry
+
+	mutex sync.Mutex
+	binds []string
+}
+
+func newLDAPStub(t *testing.T, entries ...*ldapEntry) *ldapStub {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("Error starting LDAP stub: %+v", err)
+	}
+	stub := &ldapStub{
+		listener: listener,
+		entries:  entries,
+	}
+	go func() {
+		for {
+			conn, err := listener.Accept()
+			if err != nil {
+				return
+			}
+			go stub.serve(conn)
+		}
+	}()
+	return stub
+}
+
+func (s *ldapStub) URL() string {
+	return "ldap://" + s.listener.Addr().String()
+}
+
+func (s *ldapStub) Close() {
+	s.listener.Close()
+}
+
+// boundDNs returns the DNs that binds were attempted with, in order.
+func (s *ldapStub) boundDNs() []string {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return append([]string{}, s.binds...)
+}
+
+func (s *ldapStub) serve(conn net.Conn) {
+	defer conn.Close()
+	for {
+		packet, err := ber.ReadPacket(conn)
+		if err != nil || len(packet.Children) < 2 {
+			return
+		}
+		messageID, _ := packet.Children[0].Value.(int64)
+		request := packet.Children[1]
+		switch request.Tag {
+		case ldap.ApplicationBindRequest:
+			dn := request.Children[1].Data.String()
+			password := request.Children[2].Data.String()
+			s.mutex.Lock()
+			s.binds = append(s.binds, dn)
+			s.mutex.Unlock()
+			code := uint8(ldap.LDAPResultInvalidCredentials)
+			for _, entry := range s.entries {
+				if entry.dn == dn && entry.password == password {
+					code = ldap.LDAPResultSuccess
+				}
+			}
+			conn.Write(ldapResult(messageID, ldap.ApplicationBindResponse, code).Bytes())
+		case ldap.ApplicationSearchRequest:
+			filter, err := ldap.DecompileFilter(request.Children[6])
+			if err != nil {
+				conn.Write(ldapResult(messageID, ldap.ApplicationSearchResultDone, ldap.LDAPResultOperationsError).Bytes())
+				continue
+			}
+			for _, entry := range s.entries {
+				if entry.matches(filter) {
+					conn.Write(entry.packet(messageID).Bytes())
+				}
+			}
+			conn.Write(ldapResult(messageID, ldap.ApplicationSearchResultDone, ldap.LDAPResultSuccess).Bytes())
+		default:
+			return
+		}
+	}
+}
+
+// matches reports whether the entry satisfies a single equality filter such as (uid=jsmith).
+func (e *ldapEntry) matches(filter string) bool {
+	parts := strings.SplitN(strings.Trim(filter, "()"), "=", 2)
+	if len(parts) != 2 {
+		return false
+	}
+	for _, value := range e.attributes[parts[0]] {
+		if value == parts[1] {
+			return true
+		}
+	}
+	return false
+}
+
+func (e *ldapEntry) packet(messageID int64) *ber.Packet {
+	entry := ber.Encode(ber.ClassApplication, ber.TypeConstructed, ldap.ApplicationSearchResultEntry, nil, "Search Result Entry")
+	entry.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, e.dn, "DN"))
+	attributes := ber.NewSequence("Attributes")
+	for name, values := range e.attributes {
+		attribute := ber.NewSequence("Attribute")
+		attribute.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, name, "Type"))
+		set := ber.Encode(ber.ClassUniversal, ber.TypeConstructed, ber.TagSet, nil, "Values")
+		for _, value := range values {
+			set.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, value, "Value"))
+		}
+		attribute.AppendChild(set)
+		attributes.AppendChild(attribute)
+	}
+	entry.AppendChild(attributes)
+	return ldapMessage(messageID, entry)
+}
+
+func ldapResult(messageID int64, tag ber.Tag, code uint8) *ber.Packet {
+	result := ber.Encode(ber.ClassApplication, ber.TypeConstructed, tag, nil, "Result")
+	result.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagEnumerated, uint64(code), "Result Code"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Matched DN"))
+	result.AppendChild(ber.NewString(ber.ClassUniversal, ber.TypePrimitive, ber.TagOctetString, "", "Diagnostic Message"))
+	return ldapMessage(messageID, result)
+}
+
+func ldapMessage(messageID int64, op *ber.Packet) *ber.Packet {
+	packet := ber.NewSequence("LDAP Message")
+	packet.AppendChild(ber.NewInteger(ber.ClassUniversal, ber.TypePrimitive, ber.TagInteger, messageID, "Message ID"))
+	packet.AppendChild(op)
+	return packet
+}
+
+var (
+	searchAccount = &ldapEntry{
+		dn:       "cn=search,dc=example,dc=org",
+		password: "search-password",
+	}
+	directoryUser = &ldapEntry{
+		dn:       "uid=jsmith,ou=people,dc=example,dc=org",
+		password: "user-password",
+		attributes: map[string][]string{
+			"uid":        {"jsmith"},
+			"cn":         {"Jo Smith"},
+			"mail":       {"jo@example.org"},
+			"entryUUID":  {"6d3c1c1e-4f0a-4d8e-9d5e-8f1f0f7a2b11"},
+			"employeeID": {"E1234"},
+		},
+	}
+)
+
+func testLDAPAuthenticator(stub *ldapStub) *LDAPAuthenticator {
+	return &LDAPAuthenticator{
+		Config: LDAPConfig{
+			Name:         "directory",
+			URL:          stub.URL(),
+			BindDN:       searchAccount.dn,
+			BindPassword: searchAccount.password,
+			BaseDN:       "dc=example,dc=org",
+		},
+	}
+}
+
+func TestLDAPAuthenticate(t *testing.T) {
+	stub := newLDAPStub(t, searchAccount, directoryUser)
+	defer stub.Close()
+	identity, err := testLDAPAuthenticator(stub).Authenticate(context.Background(), "jsmith", "user-password")
+	if err != nil {
+		t.Fatalf("Error authenticating: %+v", err)
+	}
+	if identity == nil {
+		t.Fatal("Expected the credentials to be accepted")
+	}
+	expected := Identity{
+		Provider: "directory",
+		Subject:  directoryUser.dn,
+		Username: "jsmith",
+		Name:     "Jo Smith",
+	}
+	if *identity != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *identity)
+	}
+	binds := stub.boundDNs()
+	if len(binds) != 2 || binds[0] != searchAccount.dn || binds[1] != directoryUser.dn {
+		t.Errorf("Expected binds as the search account then the user, got %v", binds)
+	}
+}
+
+func TestLDAPConfiguredAttributes(t *testing.T) {
+	stub := newLDAPStub(t, searchAccount, directoryUser)
+	defer stub.Close()
+	authenticator := testLDAPAuthenticator(stub)
+	authenticator.Config.UserFilter = "(mail=%s)"
+	authenticator.Config.SubjectAttribute = "entryUUID"
+	authenticator.Config.UsernameAttribute = "employeeID"
+	authenticator.Config.NameAttribute = "displayName"
+	identity, err := authenticator.Authenticate(context.Background(), "jo@example.org", "user-password")
+	if err != nil {
+		t.Fatalf("Error authenticating: %+v", err)
+	}
+	if identity == nil {
+		t.Fatal("Expected the credentials to be accepted")
+	}
+	// The name falls back to the username when the entry has no value for the name attribute
+	expected := Identity{
+		Provider: "directory",
+		Subject:  "6d3c1c1e-4f0a-4d8e-9d5e-8f1f0f7a2b11",
+		Username: "E1234",
+		Name:     "E1234",
+	}
+	if *identity != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *identity)
+	}
+}
+
+func TestLDAPRejectsCredentials(t *testing.T) {
+	stub := newLDAPStub(t, searchAccount, directoryUser)
+	defer stub.Close()
+	tests := []struct {
+		name     string
+		username string
+		password string
+	}{
+		{name: "wrong password", username: "jsmith", password: "guess"},
+		{name: "unknown user", username: "nobody", password: "user-password"},
+		{name: "empty password", username: "jsmith", password: ""},
+		{name: "filter injection", username: "*", password: "user-password"},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			identity, err := testLDAPAuthenticator(stub).Authenticate(context.Background(), test.username, test.password)
+			if err != nil {
+				t.Fatalf("Expected a rejection rather than an error, got %+v", err)
+			}
+			if identity != nil {
+				t.Errorf("Expected the credentials to be rejected, got %+v", identity)
+			}
+		})
+	}
+}
+
+func TestLDAPSearchAccountRejected(t *testing.T) {
+	stub := newLDAPStub(t, directoryUser)
+	defer stub.Close()
+	identity, err := testLDAPAuthenticator(stub).Authenticate(context.Background(), "jsmith", "user-password")
+	if err == nil {
+		t.Fatalf("Expected an error when the search account cannot bind, got %+v", identity)
+	}
+}
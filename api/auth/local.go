@@ -0,0 +1,32 @@
+package auth
+
+import (
+	"context"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+)
+
+// LocalProvider is the name of the built in users table backend.
+const LocalProvider = "local"
+
+// LocalAuthenticator checks passwords against the scrypt hashes in the users table.
+type LocalAuthenticator struct {
+	Store *crdbStore.CockroachStore
+}
+
+func (a *LocalAuthenticator) Name() string {
+	return LocalProvider
+}
+
+func (a *LocalAuthenticator) Authenticate(ctx context.Context, username, password string) (*Identity, error) {
+	user, err := a.Store.AuthenticateUserWithCredentials(username, password)
+	if err != nil || user == nil {
+		return nil, err
+	}
+	return &Identity{
+		Provider: LocalProvider,
+		Subject:  user.GetId(),
+		UserID:   user.GetId(),
+		Username: user.GetUsername(),
+		Name:     user.GetName(),
+	}, nil
+}
@@ -0,0 +1,154 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"golang.org/x/oauth2"
+	"net/http"
+	"strings"
+	"sync"
+)
+
+// OIDCConfig describes an OpenID Connect provider used with the authorization code flow.
+// Endpoints left empty are read from the issuer's discovery document.
+type OIDCConfig struct {
+	Name         string   `json:"name"`
+	IssuerURL    string   `json:"issuerURL"`
+	ClientID     string   `json:"clientID"`
+	ClientSecret string   `json:"clientSecret"`
+	RedirectURL  string   `json:"redirectURL"`
+	Scopes       []string `json:"scopes"`
+	AuthURL      string   `json:"authURL"`
+	TokenURL     string   `json:"tokenURL"`
+	UserInfoURL  string   `json:"userInfoURL"`
+	// UsernameClaim names the userinfo claim used as the local username. Defaults to preferred_username.
+	UsernameClaim string `json:"usernameClaim"`
+	// AutoProvision creates a local user the first time someone logs in through this provider
+	AutoProvision bool `json:"autoProvision"`
+}
+
+// OIDCAuthenticator identifies users from the userinfo endpoint of an OpenID Connect provider.
+type OIDCAuthenticator struct {
+	Config OIDCConfig
+
+	mutex       sync.Mutex
+	oauthConfig *oauth2.Config
+	userInfoURL string
+}
+
+func (a *OIDCAuthenticator) Name() string {
+	return a.Config.Name
+}
+
+// endpoints returns the OAuth configuration, performing discovery on first use.
+func (a *OIDCAuthenticator) endpoints(ctx context.Context) (*oauth2.Config, string, error) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+	if a.oauthConfig != nil {
+		return a.oauthConfig, a.userInfoURL, nil
+	}
+	authURL := a.Config.AuthURL
+	tokenURL := a.Config.TokenURL
+	userInfoURL := a.Config.UserInfoURL
+	if authURL == "" || tokenURL == "" || userInfoURL == "" {
+		discovery := struct {
+			AuthorizationEndpoint string `json:"authorization_endpoint"`
+			TokenEndpoint         string `json:"token_endpoint"`
+			UserInfoEndpoint      string `json:"userinfo_endpoint"`
+		}{}
+		if err := getJSON(ctx, http.DefaultClient, strings.TrimSuffix(a.Config.IssuerURL, "/")+"/.well-known/openid-configuration", &discovery); err != nil {
+			return nil, "", errors.New(fmt.Sprintf("Error discovering OIDC provider %s: %+v", a.Config.Name, err))
+		}
+		if authURL == "" {
+			authURL = discovery.AuthorizationEndpoint
+		}
+		if tokenURL == "" {
+			tokenURL = discovery.TokenEndpoint
+		}
+		if userInfoURL == "" {
+			userInfoURL = discovery.UserInfoEndpoint
+		}
+	}
+	scopes := a.Config.Scopes
+	if len(scopes) == 0 {
+		scopes = []string{"openid", "profile"}
+	}
+	a.oauthConfig = &oauth2.Config{
+		ClientID:     a.Config.ClientID,
+		ClientSecret: a.Config.ClientSecret,
+		RedirectURL:  a.Config.RedirectURL,
+		Scopes:       scopes,
+		Endpoint: oauth2.Endpoint{
+			AuthURL:  authURL,
+			TokenURL: tokenURL,
+		},
+	}
+	a.userInfoURL = userInfoURL
+	return a.oauthConfig, a.userInfoURL, nil
+}
+
+func (a *OIDCAuthenticator) LoginURL(ctx context.Context, state string) (string, error) {
+	config, _, err := a.endpoints(ctx)
+	if err != nil {
+		return "", err
+	}
+	return config.AuthCodeURL(state), nil
+}
+
+func (a *OIDCAuthenticator) Callback(ctx context.Context, code string) (*Identity, error) {
+	config, userInfoURL, err := a.endpoints(ctx)
+	if err != nil {
+		return nil, err
+	}
+	token, err := config.Exchange(ctx, code)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error exchanging OIDC code: %+v", err))
+	}
+	claims := map[string]interface{}{}
+	if err = getJSON(ctx, config.Client(ctx, token), userInfoURL, &claims); err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching OIDC user info: %+v", err))
+	}
+	subject, _ := claims["sub"].(string)
+	if subject == "" {
+		return nil, errors.New("OIDC user info did not include a subject")
+	}
+	usernameClaim := a.Config.UsernameClaim
+	if usernameClaim == "" {
+		usernameClaim = "preferred_username"
+	}
+	identity := &Identity{
+		Provider: a.Config.Name,
+		Subject:  subject,
+	}
+	identity.Username, _ = claims[usernameClaim].(string)
+	identity.Name, _ = claims["name"].(string)
+	if identity.Username == "" {
+		identity.Username, _ = claims["email"].(string)
+	}
+	if identity.Username == "" {
+		identity.Username = subject
+	}
+	if identity.Name == "" {
+		identity.Name = identity.Username
+	}
+	return identity, nil
+}
+
+func getJSON(ctx context.Context, client *http.Client, url string, v interface{}) error {
+	req, err := http.NewRequest("GET", url, nil)
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	resp, err := client.Do(req.WithContext(ctx))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return errors.New(fmt.Sprintf("Unexpected status %s from %s", resp.Status, url))
+	}
+	return json.NewDecoder(resp.Body).Decode(v)
+}
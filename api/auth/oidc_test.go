@@ -0,0 +1,238 @@
+package auth
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+const (
+	testClientID     = "rcj"
+	testClientSecret = "secret"
+	testRedirectURL  = "https://rcj.example.org/auth/example/callback"
+	testCode         = "authorization-code"
+	testAccessToken  = "access-token"
+)
+
+// oidcProvider is an OpenID Connect provider serving discovery, authorize, token and userinfo
+// endpoints. claims are returned from userinfo to holders of the access token.
+func oidcProvider(claims map[string]interface{}) *httptest.Server {
+	mux := http.NewServeMux()
+	server := httptest.NewServer(mux)
+	writeJSON := func(w http.ResponseWriter, v interface{}) {
+		w.Header().Set("Content-Type", "application/json")
+		json.NewEncoder(w).Encode(v)
+	}
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, map[string]string{
+			"issuer":                 server.URL,
+			"authorization_endpoint": server.URL + "/authorize",
+			"token_endpoint":         server.URL + "/token",
+			"userinfo_endpoint":      server.URL + "/userinfo",
+		})
+	})
+	mux.HandleFunc("/authorize", func(w http.ResponseWriter, r *http.Request) {
+		query := r.URL.Query()
+		if query.Get("client_id") != testClientID || query.Get("response_type") != "code" || query.Get("redirect_uri") != testRedirectURL {
+			http.Error(w, "invalid authorization request", http.StatusBadRequest)
+			return
+		}
+		redirect, _ := url.Parse(query.Get("redirect_uri"))
+		redirect.RawQuery = url.Values{"code": {testCode}, "state": {query.Get("state")}}.Encode()
+		http.Redirect(w, r, redirect.String(), http.StatusFound)
+	})
+	mux.HandleFunc("/token", func(w http.ResponseWriter, r *http.Request) {
+		r.ParseForm()
+		clientID, clientSecret, ok := r.BasicAuth()
+		if !ok {
+			clientID, clientSecret = r.PostForm.Get("client_id"), r.PostForm.Get("client_secret")
+		}
+		if clientID != testClientID || clientSecret != testClientSecret {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusUnauthorized)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_client"})
+			return
+		}
+		if r.PostForm.Get("grant_type") != "authorization_code" || r.PostForm.Get("code") != testCode {
+			w.Header().Set("Content-Type", "application/json")
+			w.WriteHeader(http.StatusBadRequest)
+			json.NewEncoder(w).Encode(map[string]string{"error": "invalid_grant"})
+			return
+		}
+		writeJSON(w, map[string]interface{}{
+			"access_token": testAccessToken,
+			"token_type":   "Bearer",
+			"expires_in":   3600,
+		})
+	})
+	mux.HandleFunc("/userinfo", func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer "+testAccessToken {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		writeJSON(w, claims)
+	})
+	return server
+}
+
+func testOIDCAuthenticator(server *httptest.Server) *OIDCAuthenticator {
+	return &OIDCAuthenticator{
+		Config: OIDCConfig{
+			Name:         "example",
+			IssuerURL:    server.URL,
+			ClientID:     testClientID,
+			ClientSecret: testClientSecret,
+			RedirectURL:  testRedirectURL,
+		},
+	}
+}
+
+// authorize follows the login URL to the provider and returns the code and state it redirects
+// back with.
+func authorize(t *testing.T, loginURL string) (string, string) {
+	client := &http.Client{
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+	resp, err := client.Get(loginURL)
+	if err != nil {
+		t.Fatalf("Error following login URL: %+v", err)
+	}
+	resp.Body.Close()
+	if resp.StatusCode != http.StatusFound {
+		t.Fatalf("Expected the provider to redirect back, got %s", resp.Status)
+	}
+	location, err := resp.Location()
+	if err != nil {
+		t.Fatalf("Error reading redirect: %+v", err)
+	}
+	if got := location.Scheme + "://" + location.Host + location.Path; got != testRedirectURL {
+		t.Fatalf("Expected a redirect to %s, got %s", testRedirectURL, got)
+	}
+	return location.Query().Get("code"), location.Query().Get("state")
+}
+
+func TestOIDCLogin(t *testing.T) {
+	server := oidcProvider(map[string]interface{}{
+		"sub":                "user-1234",
+		"preferred_username": "jsmith",
+		"name":               "Jo Smith",
+		"email":              "jo@example.org",
+	})
+	defer server.Close()
+	authenticator := testOIDCAuthenticator(server)
+	ctx := context.Background()
+	loginURL, err := authenticator.LoginURL(ctx, "login-state")
+	if err != nil {
+		t.Fatalf("Error building login URL: %+v", err)
+	}
+	code, state := authorize(t, loginURL)
+	if state != "login-state" {
+		t.Errorf("Expected the state to be echoed back, got %q", state)
+	}
+	identity, err := authenticator.Callback(ctx, code)
+	if err != nil {
+		t.Fatalf("Error completing login: %+v", err)
+	}
+	expected := Identity{
+		Provider: "example",
+		Subject:  "user-1234",
+		Username: "jsmith",
+		Name:     "Jo Smith",
+	}
+	if *identity != expected {
+		t.Errorf("Expected %+v, got %+v", expected, *identity)
+	}
+}
+
+func TestOIDCUsernameFallback(t *testing.T) {
+	tests := []struct {
+		name          string
+		usernameClaim string
+		claims        map[string]interface{}
+		username      string
+		displayName   string
+	}{
+		{
+			name:          "configured claim",
+			usernameClaim: "upn",
+			claims:        map[string]interface{}{"sub": "1", "upn": "jsmith@example.org", "preferred_username": "jsmith"},
+			username:      "jsmith@example.org",
+			displayName:   "jsmith@example.org",
+		},
+		{
+			name:        "email",
+			claims:      map[string]interface{}{"sub": "2", "email": "jo@example.org", "name": "Jo Smith"},
+			username:    "jo@example.org",
+			displayName: "Jo Smith",
+		},
+		{
+			name:        "subject",
+			claims:      map[string]interface{}{"sub": "3"},
+			username:    "3",
+			displayName: "3",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			server := oidcProvider(test.claims)
+			defer server.Close()
+			authenticator := testOIDCAuthenticator(server)
+			authenticator.Config.UsernameClaim = test.usernameClaim
+			identity, err := authenticator.Callback(context.Background(), testCode)
+			if err != nil {
+				t.Fatalf("Error completing login: %+v", err)
+			}
+			if identity.Username != test.username || identity.Name != test.displayName {
+				t.Errorf("Expected username %q and name %q, got %q and %q", test.username, test.displayName, identity.Username, identity.Name)
+			}
+		})
+	}
+}
+
+func TestOIDCRejectsInvalidCode(t *testing.T) {
+	server := oidcProvider(map[string]interface{}{"sub": "user-1234"})
+	defer server.Close()
+	authenticator := testOIDCAuthenticator(server)
+	identity, err := authenticator.Callback(context.Background(), "forged-code")
+	if err == nil {
+		t.Fatalf("Expected an error, got %+v", identity)
+	}
+}
+
+func TestOIDCRequiresSubject(t *testing.T) {
+	server := oidcProvider(map[string]interface{}{"preferred_username": "jsmith"})
+	defer server.Close()
+	authenticator := testOIDCAuthenticator(server)
+	identity, err := authenticator.Callback(context.Background(), testCode)
+	if err == nil {
+		t.Fatalf("Expected an error, got %+v", identity)
+	}
+}
+
+func TestOIDCConfiguredEndpoints(t *testing.T) {
+	server := oidcProvider(map[string]interface{}{"sub": "user-1234"})
+	defer server.Close()
+	authenticator := testOIDCAuthenticator(server)
+	// Discovery is skipped when every endpoint is configured
+	authenticator.Config.IssuerURL = "http://127.0.0.1:0"
+	authenticator.Config.AuthURL = server.URL + "/authorize"
+	authenticator.Config.TokenURL = server.URL + "/token"
+	authenticator.Config.UserInfoURL = server.URL + "/userinfo"
+	loginURL, err := authenticator.LoginURL(context.Background(), "login-state")
+	if err != nil {
+		t.Fatalf("Error building login URL: %+v", err)
+	}
+	code, _ := authorize(t, loginURL)
+	identity, err := authenticator.Callback(context.Background(), code)
+	if err != nil {
+		t.Fatalf("Error completing login: %+v", err)
+	}
+	if identity.Subject != "user-1234" {
+		t.Errorf("Expected subject user-1234, got %q", identity.Subject)
+	}
+}
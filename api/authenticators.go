@@ -0,0 +1,206 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"github.com/davefinster/rcj-go/api/auth"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"net/http"
+	"sort"
+	"time"
+)
+
+const loginStateCookieName = "rcj-login-state"
+
+// Authenticators holds the configured login backends, keyed by provider name.
+type Authenticators struct {
+	Password map[string]auth.PasswordAuthenticator
+	Redirect map[string]auth.RedirectAuthenticator
+	// AutoProvision lists the providers allowed to create local users for unknown identities
+	AutoProvision map[string]bool
+}
+
+// newAuthenticators builds the login backends from the server config. The local users
+// table is always available.
+func newAuthenticators(config ServerConfig, local auth.PasswordAuthenticator) *Authenticators {
+	a := &Authenticators{
+		Password:      map[string]auth.PasswordAuthenticator{local.Name(): local},
+		Redirect:      map[string]auth.RedirectAuthenticator{},
+		AutoProvision: map[string]bool{},
+	}
+	for _, ldapConfig := range config.LDAP {
+		a.Password[ldapConfig.Name] = &auth.LDAPAuthenticator{Config: ldapConfig}
+		a.AutoProvision[ldapConfig.Name] = ldapConfig.AutoProvision
+	}
+	for _, oidcConfig := range config.OIDC {
+		a.Redirect[oidcConfig.Name] = &auth.OIDCAuthenticator{Config: oidcConfig}
+		a.AutoProvision[oidcConfig.Name] = oidcConfig.AutoProvision
+	}
+	return a
+}
+
+// resolveIdentity maps an authenticated identity to its local user, or nil if there is none.
+func (s *robocupGrpcServer) resolveIdentity(ctx context.Context, identity *auth.Identity) (*serv.User, error) {
+	if identity.UserID != "" {
+		return s.Store.FetchUser(identity.UserID, nil)
+	}
+	return s.Store.ResolveIdentity(ctx, identity.Provider, identity.Subject, identity.Username, identity.Name, s.Authenticators.AutoProvision[identity.Provider])
+}
+
+// externalProvider reports whether name is a configured LDAP or OpenID Connect backend.
+func (a *Authenticators) externalProvider(name string) bool {
+	if _, ok := a.Redirect[name]; ok {
+		return true
+	}
+	_, ok := a.Password[name]
+	return ok && name != auth.LocalProvider
+}
+
+func (s *robocupGrpcServer) GetUserIdentities(ctx context.Context, req *serv.GetUserIdentitiesRequest) (*serv.GetUserIdentitiesResponse, error) {
+	identities, err := s.Store.FetchUserIdentities(ctx, req.GetUserId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching user identities")
+	}
+	return &serv.GetUserIdentitiesResponse{
+		Identities: identities,
+	}, nil
+}
+
+// LinkUserIdentity lets an existing user log in through an LDAP or OpenID Connect provider.
+func (s *robocupGrpcServer) LinkUserIdentity(ctx context.Context, req *serv.LinkUserIdentityRequest) (*serv.LinkUserIdentityResponse, error) {
+	identity := req.GetIdentity()
+	if !s.Authenticators.externalProvider(identity.GetProvider()) {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Unknown login provider %s", identity.GetProvider())
+	}
+	if identity.GetSubject() == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "A subject is required")
+	}
+	linked, err := s.Store.LinkIdentity(ctx, identity.GetProvider(), identity.GetSubject(), identity.GetUserId())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while linking user identity")
+	}
+	return &serv.LinkUserIdentityResponse{
+		Identity: linked,
+	}, nil
+}
+
+func (s *robocupGrpcServer) UnlinkUserIdentity(ctx context.Context, req *serv.UnlinkUserIdentityRequest) (*serv.UnlinkUserIdentityResponse, error) {
+	err := s.Store.UnlinkIdentity(ctx, req.GetProvider(), req.GetSubject())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while unlinking user identity")
+	}
+	return &serv.UnlinkUserIdentityResponse{}, nil
+}
+
+func (s *robocupGrpcServer) newSessionCookie(token string, expiresAt time.Time) *http.Cookie {
+	return &http.Cookie{
+		Name:     sessionCookieName,
+		Value:    token,
+		Path:     "/",
+		HttpOnly: true,
+		Expires:  expiresAt,
+		MaxAge:   int(s.SessionDuration.Seconds()),
+	}
+}
+
+func (s *robocupGrpcServer) GetAuthProviders(ctx context.Context, req *serv.GetAuthProvidersRequest) (*serv.GetAuthProvidersResponse, error) {
+	providers := []*serv.AuthProvider{}
+	for name := range s.Authenticators.Password {
+		providers = append(providers, &serv.AuthProvider{
+			Name: name,
+			Type: serv.AuthProvider_PASSWORD,
+		})
+	}
+	for name := range s.Authenticators.Redirect {
+		providers = append(providers, &serv.AuthProvider{
+			Name:     name,
+			Type:     serv.AuthProvider_REDIRECT,
+			LoginUrl: fmt.Sprintf("/auth/%s/login", name),
+		})
+	}
+	sort.Slice(providers, func(i, j int) bool {
+		return providers[i].Name < providers[j].Name
+	})
+	return &serv.GetAuthProvidersResponse{
+		Providers: providers,
+	}, nil
+}
+
+// redirectLogin sends the browser to the provider's login page.
+func (s *Server) redirectLogin(c *gin.Context) {
+	authenticator, ok := s.GRPC.Authenticators.Redirect[c.Param("provider")]
+	if !ok {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	state := base64.RawURLEncoding.EncodeToString(b)
+	loginURL, err := authenticator.LoginURL(c.Request.Context(), state)
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadGateway)
+		return
+	}
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     loginStateCookieName,
+		Value:    state,
+		Path:     "/auth/",
+		HttpOnly: true,
+		MaxAge:   int((10 * time.Minute).Seconds()),
+	})
+	c.Redirect(http.StatusFound, loginURL)
+}
+
+// redirectCallback completes a provider login and starts a session for the linked user.
+func (s *Server) redirectCallback(c *gin.Context) {
+	authenticator, ok := s.GRPC.Authenticators.Redirect[c.Param("provider")]
+	if !ok {
+		c.AbortWithStatus(http.StatusNotFound)
+		return
+	}
+	state, err := c.Cookie(loginStateCookieName)
+	if err != nil || state == "" || state != c.Query("state") {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+	http.SetCookie(c.Writer, &http.Cookie{
+		Name:     loginStateCookieName,
+		Value:    "",
+		Path:     "/auth/",
+		HttpOnly: true,
+		MaxAge:   -1,
+	})
+	identity, err := authenticator.Callback(c.Request.Context(), c.Query("code"))
+	if err != nil {
+		c.AbortWithStatus(http.StatusUnauthorized)
+		return
+	}
+	user, err := s.GRPC.resolveIdentity(c.Request.Context(), identity)
+	if conflict, ok := err.(*crdbStore.ConflictError); ok {
+		c.String(http.StatusConflict, conflict.Message)
+		return
+	} else if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	if user == nil {
+		c.AbortWithStatus(http.StatusForbidden)
+		return
+	}
+	token, expiresAt, err := s.Store.CreateSession(c.Request.Context(), user.GetId(), s.GRPC.SessionDuration)
+	if err != nil {
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	http.SetCookie(c.Writer, s.GRPC.newSessionCookie(token, expiresAt))
+	c.Redirect(http.StatusFound, "/")
+}
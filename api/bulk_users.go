@@ -0,0 +1,181 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"fmt"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+const (
+	maxBulkUsers      = 500
+	maxBulkUploadSize = 1 << 20
+)
+
+// parseRole accepts either the display name ("Head Judge") or the enum name ("HEAD_JUDGE") of a role.
+func parseRole(name string) (serv.User_Role, bool) {
+	normalised := strings.ToUpper(strings.TrimSpace(name))
+	normalised = strings.Replace(normalised, "-", "", -1)
+	normalised = strings.Replace(normalised, " ", "_", -1)
+	value, ok := serv.User_Role_value[normalised]
+	return serv.User_Role(value), ok
+}
+
+// bulkCreateUsers validates every row of the CSV and, only if all of them are valid, creates the users together.
+func (s *robocupGrpcServer) bulkCreateUsers(ctx context.Context, data []byte) (*serv.BulkCreateUsersResponse, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Invalid CSV: %v", err)
+	}
+	rowOffset := 1
+	if len(records) > 0 && len(records[0]) > 1 &&
+		strings.EqualFold(strings.TrimSpace(records[0][0]), "name") &&
+		strings.EqualFold(strings.TrimSpace(records[0][1]), "username") {
+		records = records[1:]
+		rowOffset = 2
+	}
+	if len(records) == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "No users found in CSV")
+	}
+	if len(records) > maxBulkUsers {
+		return nil, grpc.Errorf(codes.InvalidArgument, "At most %d users may be created at once", maxBulkUsers)
+	}
+	resp := &serv.BulkCreateUsersResponse{}
+	users := make([]*serv.User, len(records))
+	seenUsernames := map[string]int32{}
+	valid := true
+	for idx, record := range records {
+		result := &serv.BulkUserResult{
+			Row: int32(idx + rowOffset),
+		}
+		resp.Results = append(resp.Results, result)
+		rowError := func(format string, a ...interface{}) {
+			result.Error = fmt.Sprintf(format, a...)
+			valid = false
+		}
+		if len(record) < 3 {
+			rowError("Expected name, username, role and optional password")
+			continue
+		}
+		user := &serv.User{
+			Name:     strings.TrimSpace(record[0]),
+			Username: strings.TrimSpace(record[1]),
+		}
+		result.Username = user.Username
+		users[idx] = user
+		if user.Name == "" || user.Username == "" {
+			rowError("Name and username are required")
+			continue
+		}
+		if firstRow, ok := seenUsernames[user.Username]; ok {
+			rowError("Username %s is repeated from row %d", user.Username, firstRow)
+			continue
+		}
+		seenUsernames[user.Username] = result.Row
+		for _, roleName := range strings.Split(record[2], ";") {
+			if strings.TrimSpace(roleName) == "" {
+				continue
+			}
+			role, ok := parseRole(roleName)
+			if !ok {
+				rowError("Unknown role %s", strings.TrimSpace(roleName))
+				break
+			}
+			user.Roles = append(user.Roles, role)
+		}
+		if result.Error != "" {
+			continue
+		}
+		if len(record) > 3 && record[3] != "" {
+			user.Password = record[3]
+			if len(user.Password) < minPasswordLength {
+				rowError("Password must be at least %d characters", minPasswordLength)
+				continue
+			}
+		} else {
+			password, err := crdbStore.GenerateTemporaryPassword()
+			if err != nil {
+				return nil, grpc.Errorf(codes.Internal, "Internal error encountered while generating passwords")
+			}
+			user.Password = password
+			user.MustChangePassword = true
+			result.GeneratedPassword = password
+		}
+	}
+	if !valid {
+		return clearGeneratedPasswords(resp), nil
+	}
+	created, existing, err := s.Store.BulkCreateUsers(ctx, users)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while creating users")
+	}
+	if len(existing) > 0 {
+		taken := map[string]bool{}
+		for _, username := range existing {
+			taken[username] = true
+		}
+		for _, result := range resp.Results {
+			if taken[result.Username] {
+				result.Error = fmt.Sprintf("Username %s already exists", result.Username)
+			}
+		}
+		return clearGeneratedPasswords(resp), nil
+	}
+	for idx, user := range created {
+		resp.Results[idx].User = user
+	}
+	resp.Created = true
+	return resp, nil
+}
+
+// clearGeneratedPasswords drops passwords that were never saved so they are not mistaken for real ones.
+func clearGeneratedPasswords(resp *serv.BulkCreateUsersResponse) *serv.BulkCreateUsersResponse {
+	for _, result := range resp.Results {
+		result.GeneratedPassword = ""
+	}
+	return resp
+}
+
+func (s *robocupGrpcServer) BulkCreateUsers(ctx context.Context, req *serv.BulkCreateUsersRequest) (*serv.BulkCreateUsersResponse, error) {
+	return s.bulkCreateUsers(ctx, []byte(req.GetCsv()))
+}
+
+// postBulkUsers accepts a CSV either as a multipart "file" field or as the raw request body.
+func (s *Server) postBulkUsers(c *gin.Context) {
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBulkUploadSize)
+	var data []byte
+	file, _, err := c.Request.FormFile("file")
+	if err == nil {
+		defer file.Close()
+		data, err = ioutil.ReadAll(file)
+	} else {
+		data, err = ioutil.ReadAll(c.Request.Body)
+	}
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+	user := c.MustGet(authenticatedUserKey).(*serv.User)
+	ctx := crdbStore.WithAuditActor(c.Request.Context(), user.GetId(), "POST /api/users/bulk")
+	resp, err := s.GRPC.bulkCreateUsers(ctx, data)
+	if err != nil {
+		if grpc.Code(err) == codes.InvalidArgument {
+			c.String(http.StatusBadRequest, grpc.ErrorDesc(err))
+			return
+		}
+		c.AbortWithStatus(http.StatusInternalServerError)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
@@ -0,0 +1,141 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+)
+
+// activeCompetition picks the competition a call is scoped to. Sessions use the competition
+// selected with SelectCompetition and API keys may name one in "competition-id" metadata.
+// Otherwise the most recently created competition is used.
+func (s *robocupGrpcServer) activeCompetition(ctx context.Context, meta metadata.MD, token string) (string, error) {
+	if token != "" {
+		competitionID, err := s.Store.FetchSessionCompetition(ctx, token)
+		if err != nil {
+			return "", err
+		}
+		if competitionID != "" {
+			return competitionID, nil
+		}
+	} else if ids := meta.Get("competition-id"); len(ids) > 0 && ids[0] != "" {
+		competition, err := s.Store.FetchCompetition(ctx, ids[0], nil)
+		if err != nil {
+			return "", err
+		}
+		if competition == nil {
+			return "", grpc.Errorf(codes.NotFound, "Competition %s not found", ids[0])
+		}
+		return competition.GetId(), nil
+	}
+	return s.Store.LatestCompetitionID(ctx)
+}
+
+// requireCompetition rejects calls that create competition data before any competition exists.
+func requireCompetition(ctx context.Context) error {
+	if crdbStore.CompetitionFromContext(ctx) == "" {
+		return grpc.Errorf(codes.FailedPrecondition, "No competition selected")
+	}
+	return nil
+}
+
+// checkDivisionCompetition rejects references to divisions outside the active competition.
+func (s *robocupGrpcServer) checkDivisionCompetition(ctx context.Context, divisionID string) error {
+	if err := requireCompetition(ctx); err != nil {
+		return err
+	}
+	division, err := s.Store.FetchDivision(divisionID, nil)
+	if err != nil {
+		return grpc.Errorf(codes.NotFound, "Division %s not found", divisionID)
+	}
+	if division.GetCompetitionId() != crdbStore.CompetitionFromContext(ctx) {
+		return grpc.Errorf(codes.FailedPrecondition, "Division %s belongs to another competition", divisionID)
+	}
+	return nil
+}
+
+func (s *robocupGrpcServer) CreateCompetition(ctx context.Context, req *serv.CreateCompetitionRequest) (*serv.CreateCompetitionResponse, error) {
+	if req.GetCompetition().GetName() == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "A name is required")
+	}
+	competition, err := s.Store.CreateCompetition(ctx, func(newCompetition *serv.Competition) error {
+		newCompetition.Name = req.GetCompetition().GetName()
+		return nil
+	})
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while creating competition")
+	}
+	return &serv.CreateCompetitionResponse{
+		Competition: competition,
+	}, nil
+}
+
+func (s *robocupGrpcServer) GetCompetitions(ctx context.Context, req *serv.GetCompetitionsRequest) (*serv.GetCompetitionsResponse, error) {
+	competitions, err := s.Store.FetchCompetitions(ctx, nil, nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching competitions")
+	}
+	return &serv.GetCompetitionsResponse{
+		Competitions:        competitions,
+		ActiveCompetitionId: crdbStore.CompetitionFromContext(ctx),
+	}, nil
+}
+
+func (s *robocupGrpcServer) SelectCompetition(ctx context.Context, req *serv.SelectCompetitionRequest) (*serv.SelectCompetitionResponse, error) {
+	meta, _ := metadata.FromIncomingContext(ctx)
+	token := sessionToken(meta)
+	if token == "" {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "Competitions can only be selected for a session")
+	}
+	competition, err := s.Store.FetchCompetition(ctx, req.GetCompetitionId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while selecting competition")
+	}
+	if competition == nil {
+		return nil, grpc.Errorf(codes.NotFound, "Competition %s not found", req.GetCompetitionId())
+	}
+	err = s.Store.SetSessionCompetition(ctx, token, competition.GetId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while selecting competition")
+	}
+	return &serv.SelectCompetitionResponse{
+		Competition: competition,
+	}, nil
+}
+
+func (s *robocupGrpcServer) CloneCompetitionSetup(ctx context.Context, req *serv.CloneCompetitionSetupRequest) (*serv.CloneCompetitionSetupResponse, error) {
+	if req.GetName() == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "A name is required")
+	}
+	source, err := s.Store.FetchCompetition(ctx, req.GetSourceCompetitionId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while cloning competition")
+	}
+	if source == nil {
+		return nil, grpc.Errorf(codes.NotFound, "Competition %s not found", req.GetSourceCompetitionId())
+	}
+	competition, err := s.Store.CloneCompetitionSetup(ctx, source.GetId(), &crdbStore.CloneCompetitionOptions{
+		Name:                    req.GetName(),
+		IncludeJudgeAssignments: req.GetIncludeJudgeAssignments(),
+	})
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while cloning competition")
+	}
+	cloneCtx := crdbStore.WithCompetition(ctx, competition.GetId())
+	divisions, err := s.Store.FetchDivisions(cloneCtx, nil, nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching divisions")
+	}
+	templates, err := s.Store.FetchScoreSheetTemplates(cloneCtx, nil, nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching templates")
+	}
+	return &serv.CloneCompetitionSetupResponse{
+		Competition:         competition,
+		Divisions:           divisions,
+		ScoreSheetTemplates: templates,
+	}, nil
+}
@@ -0,0 +1,19 @@
+package api
+
+import (
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// staleStatus converts a store StaleError to an Aborted status. The current copy of the row is
+// attached as a detail so clients can merge their changes and retry against its version.
+func staleStatus(stale *crdbStore.StaleError) error {
+	st := status.New(codes.Aborted, stale.Message)
+	if stale.Current != nil {
+		if detailed, err := st.WithDetails(stale.Current); err == nil {
+			st = detailed
+		}
+	}
+	return st.Err()
+}
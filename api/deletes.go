@@ -0,0 +1,186 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// storeStatusError maps the not found and conflict errors returned by the store to gRPC statuses.
+func storeStatusError(err error, message string) error {
+	if err == crdbStore.ErrNotFound {
+		return grpc.Errorf(codes.NotFound, "Not found or not in the expected state")
+	}
+	if conflict, ok := err.(*crdbStore.ConflictError); ok {
+		return status.Error(codes.FailedPrecondition, conflict.Message)
+	}
+	return status.Error(codes.Internal, message)
+}
+
+func (s *robocupGrpcServer) DeleteTeam(ctx context.Context, req *serv.DeleteTeamRequest) (*serv.DeleteTeamResponse, error) {
+	err := s.Store.DeleteTeam(ctx, req.GetTeamId(), req.GetCascade())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while deleting team")
+	}
+	return &serv.DeleteTeamResponse{}, nil
+}
+
+func (s *robocupGrpcServer) RestoreTeam(ctx context.Context, req *serv.RestoreTeamRequest) (*serv.RestoreTeamResponse, error) {
+	err := s.Store.RestoreTeam(ctx, req.GetTeamId(), req.GetCascade())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while restoring team")
+	}
+	team, err := s.Store.FetchTeam(ctx, req.GetTeamId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching team")
+	}
+	return &serv.RestoreTeamResponse{
+		Team: team,
+	}, nil
+}
+
+func (s *robocupGrpcServer) DeleteDivision(ctx context.Context, req *serv.DeleteDivisionRequest) (*serv.DeleteDivisionResponse, error) {
+	err := s.Store.DeleteDivision(ctx, req.GetDivisionId(), req.GetCascade())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while deleting division")
+	}
+	return &serv.DeleteDivisionResponse{}, nil
+}
+
+func (s *robocupGrpcServer) RestoreDivision(ctx context.Context, req *serv.RestoreDivisionRequest) (*serv.RestoreDivisionResponse, error) {
+	err := s.Store.RestoreDivision(ctx, req.GetDivisionId(), req.GetCascade())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while restoring division")
+	}
+	division, err := s.Store.FetchDivision(req.GetDivisionId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching division")
+	}
+	return &serv.RestoreDivisionResponse{
+		Division: division,
+	}, nil
+}
+
+func (s *robocupGrpcServer) DeleteInstitution(ctx context.Context, req *serv.DeleteInstitutionRequest) (*serv.DeleteInstitutionResponse, error) {
+	err := s.Store.DeleteInstitution(ctx, req.GetInstitutionId(), req.GetCascade())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while deleting institution")
+	}
+	return &serv.DeleteInstitutionResponse{}, nil
+}
+
+func (s *robocupGrpcServer) RestoreInstitution(ctx context.Context, req *serv.RestoreInstitutionRequest) (*serv.RestoreInstitutionResponse, error) {
+	err := s.Store.RestoreInstitution(ctx, req.GetInstitutionId(), req.GetCascade())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while restoring institution")
+	}
+	insts, err := s.Store.FetchInstitutions(ctx, "")
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching institution")
+	}
+	resp := &serv.RestoreInstitutionResponse{}
+	for _, inst := range insts {
+		if inst.GetId() == req.GetInstitutionId() {
+			resp.Institution = inst
+		}
+	}
+	return resp, nil
+}
+
+func (s *robocupGrpcServer) DeleteUser(ctx context.Context, req *serv.DeleteUserRequest) (*serv.DeleteUserResponse, error) {
+	if req.GetUserId() == currentUser(ctx).GetId() {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "Users cannot delete themselves")
+	}
+	err := s.Store.DeleteUser(ctx, req.GetUserId(), req.GetCascade())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while deleting user")
+	}
+	return &serv.DeleteUserResponse{}, nil
+}
+
+func (s *robocupGrpcServer) RestoreUser(ctx context.Context, req *serv.RestoreUserRequest) (*serv.RestoreUserResponse, error) {
+	err := s.Store.RestoreUser(ctx, req.GetUserId(), req.GetCascade())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while restoring user")
+	}
+	user, err := s.Store.FetchUser(req.GetUserId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching user")
+	}
+	return &serv.RestoreUserResponse{
+		User: user,
+	}, nil
+}
+
+func (s *robocupGrpcServer) DeleteScoreSheet(ctx context.Context, req *serv.DeleteScoreSheetRequest) (*serv.DeleteScoreSheetResponse, error) {
+	err := s.Store.DeleteScoreSheet(ctx, req.GetScoreSheetId())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while deleting score sheet")
+	}
+	return &serv.DeleteScoreSheetResponse{}, nil
+}
+
+func (s *robocupGrpcServer) RestoreScoreSheet(ctx context.Context, req *serv.RestoreScoreSheetRequest) (*serv.RestoreScoreSheetResponse, error) {
+	err := s.Store.RestoreScoreSheet(ctx, req.GetScoreSheetId())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while restoring score sheet")
+	}
+	sheet, err := s.Store.FetchScoreSheet(ctx, req.GetScoreSheetId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching score sheet")
+	}
+	return &serv.RestoreScoreSheetResponse{
+		ScoreSheet: sheet,
+	}, nil
+}
+
+func (s *robocupGrpcServer) DeleteScoreSheetTemplate(ctx context.Context, req *serv.DeleteScoreSheetTemplateRequest) (*serv.DeleteScoreSheetTemplateResponse, error) {
+	err := s.Store.DeleteScoreSheetTemplate(ctx, req.GetScoreSheetTemplateId(), req.GetCascade())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while deleting template")
+	}
+	return &serv.DeleteScoreSheetTemplateResponse{}, nil
+}
+
+func (s *robocupGrpcServer) RestoreScoreSheetTemplate(ctx context.Context, req *serv.RestoreScoreSheetTemplateRequest) (*serv.RestoreScoreSheetTemplateResponse, error) {
+	err := s.Store.RestoreScoreSheetTemplate(ctx, req.GetScoreSheetTemplateId(), req.GetCascade())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while restoring template")
+	}
+	templates, err := s.Store.FetchScoreSheetTemplates(ctx, &crdbStore.FetchScoreSheetTemplateOptions{
+		IDs: []string{req.GetScoreSheetTemplateId()},
+	}, nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching template")
+	}
+	resp := &serv.RestoreScoreSheetTemplateResponse{}
+	if len(templates) > 0 {
+		resp.ScoreSheetTemplate = templates[0]
+	}
+	return resp, nil
+}
+
+func (s *robocupGrpcServer) DeleteCheckin(ctx context.Context, req *serv.DeleteCheckinRequest) (*serv.DeleteCheckinResponse, error) {
+	err := s.Store.DeleteCheckin(ctx, req.GetCheckinId())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while deleting checkin")
+	}
+	return &serv.DeleteCheckinResponse{}, nil
+}
+
+func (s *robocupGrpcServer) RestoreCheckin(ctx context.Context, req *serv.RestoreCheckinRequest) (*serv.RestoreCheckinResponse, error) {
+	err := s.Store.RestoreCheckin(ctx, req.GetCheckinId())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while restoring checkin")
+	}
+	checkin, err := s.Store.FetchCheckin(ctx, req.GetCheckinId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching checkin")
+	}
+	return &serv.RestoreCheckinResponse{
+		CheckIn: checkin,
+	}, nil
+}
@@ -0,0 +1,22 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *robocupGrpcServer) GetDuplicateScoreSheets(ctx context.Context, req *serv.GetDuplicateScoreSheetsRequest) (*serv.GetDuplicateScoreSheetsResponse, error) {
+	var divisionID *string
+	if id := req.GetDivisionId(); id != "" {
+		divisionID = &id
+	}
+	duplicates, err := s.Store.FetchDuplicateScoreSheets(ctx, divisionID)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching duplicate score sheets")
+	}
+	return &serv.GetDuplicateScoreSheetsResponse{
+		Duplicates: duplicates,
+	}, nil
+}
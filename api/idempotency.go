@@ -0,0 +1,27 @@
+package api
+
+import (
+	"context"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"google.golang.org/grpc/metadata"
+)
+
+// idempotencyMetadataKey is the metadata clients may send an idempotency key in instead of the
+// request field.
+const idempotencyMetadataKey = "idempotency-key"
+
+// withIdempotencyKey attaches the create request's idempotency key for the store. The request
+// field takes precedence over metadata.
+func withIdempotencyKey(ctx context.Context, field string) context.Context {
+	key := field
+	if key == "" {
+		meta, _ := metadata.FromIncomingContext(ctx)
+		if keys := meta.Get(idempotencyMetadataKey); len(keys) > 0 {
+			key = keys[0]
+		}
+	}
+	if key == "" {
+		return ctx
+	}
+	return crdbStore.WithIdempotencyKey(ctx, key)
+}
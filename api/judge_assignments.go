@@ -0,0 +1,77 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+// checkJudgeAssignment rejects score sheet writes by judges outside the divisions and rounds
+// they are assigned to. Head judges and admins may score anything.
+func (s *robocupGrpcServer) checkJudgeAssignment(ctx context.Context, scoreSheet *serv.ScoreSheet) error {
+	user := currentUser(ctx)
+	if userHasAnyRole(user, officials) {
+		return nil
+	}
+	assigned, err := s.Store.IsJudgeAssigned(ctx, user.GetId(), scoreSheet.GetDivisionId(), scoreSheet.GetRound(), nil)
+	if err != nil {
+		return err
+	}
+	if !assigned {
+		return grpc.Errorf(codes.PermissionDenied, "Not assigned to round %d of division %s", scoreSheet.GetRound(), scoreSheet.GetDivisionId())
+	}
+	return nil
+}
+
+func (s *robocupGrpcServer) GetJudgeAssignments(ctx context.Context, req *serv.GetJudgeAssignmentsRequest) (*serv.GetJudgeAssignmentsResponse, error) {
+	opts := &crdbStore.FetchJudgeAssignmentsOptions{}
+	judgeID := req.GetJudgeId()
+	user := currentUser(ctx)
+	if !userHasAnyRole(user, officials) {
+		// Judges may only see their own assignments
+		judgeID = user.GetId()
+	}
+	if judgeID != "" {
+		opts.JudgeID = &judgeID
+	}
+	if divisionID := req.GetDivisionId(); divisionID != "" {
+		opts.DivisionID = &divisionID
+	}
+	assignments, err := s.Store.FetchJudgeAssignments(ctx, opts, nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching judge assignments")
+	}
+	return &serv.GetJudgeAssignmentsResponse{
+		JudgeAssignments: assignments,
+	}, nil
+}
+
+func (s *robocupGrpcServer) CreateJudgeAssignment(ctx context.Context, req *serv.CreateJudgeAssignmentRequest) (*serv.CreateJudgeAssignmentResponse, error) {
+	if req.GetJudgeAssignment().GetJudgeId() == "" || req.GetJudgeAssignment().GetDivisionId() == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "A judge and division are required")
+	}
+	if err := s.checkDivisionCompetition(ctx, req.GetJudgeAssignment().GetDivisionId()); err != nil {
+		return nil, err
+	}
+	assignment, err := s.Store.CreateJudgeAssignment(ctx, func(newAssignment *serv.JudgeAssignment) error {
+		proto.Merge(newAssignment, req.GetJudgeAssignment())
+		return nil
+	})
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while creating judge assignment")
+	}
+	return &serv.CreateJudgeAssignmentResponse{
+		JudgeAssignment: assignment,
+	}, nil
+}
+
+func (s *robocupGrpcServer) DeleteJudgeAssignment(ctx context.Context, req *serv.DeleteJudgeAssignmentRequest) (*serv.DeleteJudgeAssignmentResponse, error) {
+	err := s.Store.DeleteJudgeAssignment(ctx, req.GetJudgeAssignmentId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while deleting judge assignment")
+	}
+	return &serv.DeleteJudgeAssignmentResponse{}, nil
+}
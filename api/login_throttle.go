@@ -0,0 +1,112 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"net"
+	"strings"
+	"time"
+)
+
+var (
+	// usernameLoginPolicy throttles guesses against a single account.
+	usernameLoginPolicy = crdbStore.LoginThrottlePolicy{
+		FreeAttempts: 5,
+		BaseDelay:    2 * time.Second,
+		MaxDelay:     15 * time.Minute,
+		Window:       time.Hour,
+	}
+	// clientLoginPolicy is more lenient as judges at a venue often share an address.
+	clientLoginPolicy = crdbStore.LoginThrottlePolicy{
+		FreeAttempts: 30,
+		BaseDelay:    2 * time.Second,
+		MaxDelay:     15 * time.Minute,
+		Window:       time.Hour,
+	}
+)
+
+func usernameLoginKey(username string) string {
+	return "user:" + strings.ToLower(strings.TrimSpace(username))
+}
+
+func clientLoginKey(address string) string {
+	return "ip:" + address
+}
+
+// clientAddress returns the address of the caller, preferring the first X-Forwarded-For
+// entry when the server is configured to sit behind a trusted proxy.
+func (s *robocupGrpcServer) clientAddress(ctx context.Context) string {
+	if s.TrustForwardedFor {
+		meta, _ := metadata.FromIncomingContext(ctx)
+		if forwarded := meta.Get("x-forwarded-for"); len(forwarded) > 0 {
+			first := strings.TrimSpace(strings.Split(forwarded[0], ",")[0])
+			if first != "" {
+				return first
+			}
+		}
+	}
+	p, ok := peer.FromContext(ctx)
+	if !ok || p.Addr == nil {
+		return ""
+	}
+	host, _, err := net.SplitHostPort(p.Addr.String())
+	if err != nil {
+		return p.Addr.String()
+	}
+	return host
+}
+
+func loginThrottlePolicies(username, address string) map[string]crdbStore.LoginThrottlePolicy {
+	policies := map[string]crdbStore.LoginThrottlePolicy{
+		usernameLoginKey(username): usernameLoginPolicy,
+	}
+	if address != "" {
+		policies[clientLoginKey(address)] = clientLoginPolicy
+	}
+	return policies
+}
+
+// startLoginAttempt counts the attempt against the username and client before credentials are
+// checked, rejecting it if either is locked out so scrypt is not run for throttled callers.
+// finishLoginAttempt must be called once the credentials have been checked.
+func (s *robocupGrpcServer) startLoginAttempt(ctx context.Context, username, address string) error {
+	lockedUntil, err := s.Store.StartLoginAttempt(ctx, loginThrottlePolicies(username, address))
+	if err != nil {
+		return grpc.Errorf(codes.Internal, "Internal error encountered while performing login")
+	}
+	if !lockedUntil.IsZero() {
+		wait := time.Until(lockedUntil) + time.Second
+		return grpc.Errorf(codes.ResourceExhausted, "Too many failed login attempts, try again in %s", wait.Truncate(time.Second))
+	}
+	return nil
+}
+
+func (s *robocupGrpcServer) finishLoginAttempt(ctx context.Context, username, address string, failed bool) error {
+	return s.Store.FinishLoginAttempt(ctx, loginThrottlePolicies(username, address), failed)
+}
+
+func (s *robocupGrpcServer) GetLoginLockouts(ctx context.Context, req *serv.GetLoginLockoutsRequest) (*serv.GetLoginLockoutsResponse, error) {
+	lockouts, err := s.Store.FetchLoginLockouts(ctx)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching login lockouts")
+	}
+	return &serv.GetLoginLockoutsResponse{
+		Lockouts: lockouts,
+	}, nil
+}
+
+func (s *robocupGrpcServer) ClearLoginLockout(ctx context.Context, req *serv.ClearLoginLockoutRequest) (*serv.ClearLoginLockoutResponse, error) {
+	if req.GetKey() == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "A lockout key is required")
+	}
+	err := s.Store.ClearLoginAttempts(ctx, []string{req.GetKey()})
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while clearing login lockout")
+	}
+	return &serv.ClearLoginLockoutResponse{}, nil
+}
@@ -0,0 +1,65 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+const minPasswordLength = 8
+
+// passwordChangeMethods may still be called by users who must change their password.
+var passwordChangeMethods = map[string]bool{
+	"/Robocup/ChangePassword": true,
+	"/Robocup/GetCurrentUser": true,
+	"/Robocup/Logout":         true,
+}
+
+func (s *robocupGrpcServer) ChangePassword(ctx context.Context, req *serv.ChangePasswordRequest) (*serv.ChangePasswordResponse, error) {
+	if len(req.GetNewPassword()) < minPasswordLength {
+		return nil, grpc.Errorf(codes.InvalidArgument, "New password must be at least %d characters", minPasswordLength)
+	}
+	if req.GetNewPassword() == req.GetCurrentPassword() {
+		return nil, grpc.Errorf(codes.InvalidArgument, "New password must differ from the current password")
+	}
+	user, err := s.Store.FetchUser(currentUser(ctx).GetId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while changing password")
+	}
+	// Wrong current passwords count towards the login throttle so sessions cannot be used to guess
+	address := s.clientAddress(ctx)
+	if err := s.startLoginAttempt(ctx, user.GetUsername(), address); err != nil {
+		return nil, err
+	}
+	changed, err := s.Store.ChangePassword(ctx, user.GetId(), req.GetCurrentPassword(), req.GetNewPassword())
+	if finishErr := s.finishLoginAttempt(ctx, user.GetUsername(), address, err == nil && !changed); finishErr != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while changing password")
+	}
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while changing password")
+	}
+	if !changed {
+		return nil, grpc.Errorf(codes.PermissionDenied, "Current password is incorrect")
+	}
+	user, err = s.Store.FetchUser(user.GetId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while changing password")
+	}
+	return &serv.ChangePasswordResponse{
+		User: user,
+	}, nil
+}
+
+func (s *robocupGrpcServer) ResetUserPassword(ctx context.Context, req *serv.ResetUserPasswordRequest) (*serv.ResetUserPasswordResponse, error) {
+	if req.GetUserId() == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "A user is required")
+	}
+	password, err := s.Store.ResetUserPassword(ctx, req.GetUserId())
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while resetting password")
+	}
+	return &serv.ResetUserPasswordResponse{
+		TemporaryPassword: password,
+	}, nil
+}
@@ -0,0 +1,171 @@
+package api
+
+import (
+	serv "github.com/davefinster/rcj-go/api/proto"
+	"github.com/gin-gonic/gin"
+	"net/http"
+)
+
+var (
+	anyRole       = []serv.User_Role{serv.User_ADMIN, serv.User_HEAD_JUDGE, serv.User_JUDGE, serv.User_CHECKIN_AGENT, serv.User_VIEWER}
+	adminOnly     = []serv.User_Role{serv.User_ADMIN}
+	officials     = []serv.User_Role{serv.User_ADMIN, serv.User_HEAD_JUDGE}
+	judges        = []serv.User_Role{serv.User_ADMIN, serv.User_HEAD_JUDGE, serv.User_JUDGE}
+	checkinAgents = []serv.User_Role{serv.User_ADMIN, serv.User_HEAD_JUDGE, serv.User_CHECKIN_AGENT}
+)
+
+// methodPolicy maps each Robocup RPC to the roles allowed to call it.
+// Methods missing from the table are denied.
+var methodPolicy = map[string][]serv.User_Role{
+	"/Robocup/Logout":                    anyRole,
+	"/Robocup/GetCurrentUser":            anyRole,
+	"/Robocup/ChangePassword":            anyRole,
+	"/Robocup/GetDanceLadder":            anyRole,
+	"/Robocup/GetDivision":               anyRole,
+	"/Robocup/GetDivisions":              anyRole,
+	"/Robocup/GetTeam":                   anyRole,
+	"/Robocup/GetTeams":                  anyRole,
+	"/Robocup/GetInstitutions":           anyRole,
+	"/Robocup/GetScoreSheetTemplates":    anyRole,
+	"/Robocup/GetCheckins":               anyRole,
+	"/Robocup/GetCompetitions":           anyRole,
+	"/Robocup/SelectCompetition":         anyRole,
+	"/Robocup/GetScoreSheet":             judges,
+	"/Robocup/GetScoreSheets":            judges,
+	"/Robocup/CreateScoreSheet":          judges,
+	"/Robocup/UpdateScoreSheet":          judges,
+	"/Robocup/GetJudgeAssignments":       judges,
+	"/Robocup/CreateCheckin":             checkinAgents,
+	"/Robocup/CreateTeam":                officials,
+	"/Robocup/UpdateTeam":                officials,
+	"/Robocup/GetUsers":                  officials,
+	"/Robocup/CreateJudgeAssignment":     officials,
+	"/Robocup/DeleteJudgeAssignment":     officials,
+	"/Robocup/ExportScoreSheetTemplate":  officials,
+	"/Robocup/LockRound":                 officials,
+	"/Robocup/UnlockRound":               officials,
+	"/Robocup/GetRoundLocks":             judges,
+	"/Robocup/GetScoreSheetHistory":      officials,
+	"/Robocup/RestoreScoreSheetRevision": officials,
+	"/Robocup/GetDuplicateScoreSheets":   officials,
+	"/Robocup/SyncScoreSheets":           judges,
+	"/Robocup/DeleteTeam":                officials,
+	"/Robocup/RestoreTeam":               officials,
+	"/Robocup/DeleteInstitution":         officials,
+	"/Robocup/RestoreInstitution":        officials,
+	"/Robocup/DeleteScoreSheet":          officials,
+	"/Robocup/RestoreScoreSheet":         officials,
+	"/Robocup/DeleteCheckin":             officials,
+	"/Robocup/RestoreCheckin":            officials,
+	"/Robocup/CreateCompetition":         adminOnly,
+	"/Robocup/CloneCompetitionSetup":     adminOnly,
+	"/Robocup/CreateDivision":            adminOnly,
+	"/Robocup/UpdateDivision":            adminOnly,
+	"/Robocup/DeleteDivision":            adminOnly,
+	"/Robocup/RestoreDivision":           adminOnly,
+	"/Robocup/CreateScoreSheetTemplate":  adminOnly,
+	"/Robocup/UpdateScoreSheetTemplate":  adminOnly,
+	"/Robocup/MigrateScoreSheets":        adminOnly,
+	"/Robocup/ImportScoreSheetTemplate":  adminOnly,
+	"/Robocup/DeleteScoreSheetTemplate":  adminOnly,
+	"/Robocup/RestoreScoreSheetTemplate": adminOnly,
+	"/Robocup/CreateUser":                adminOnly,
+	"/Robocup/UpdateUser":                adminOnly,
+	"/Robocup/DeleteUser":                adminOnly,
+	"/Robocup/RestoreUser":               adminOnly,
+	"/Robocup/BulkCreateUsers":           adminOnly,
+	"/Robocup/ResetUserPassword":         adminOnly,
+	"/Robocup/GetUserIdentities":         adminOnly,
+	"/Robocup/LinkUserIdentity":          adminOnly,
+	"/Robocup/UnlinkUserIdentity":        adminOnly,
+	"/Robocup/GetSheetTeams":             adminOnly,
+	"/Robocup/SyncCheckins":              adminOnly,
+	"/Robocup/GetSheetAuthUrl":           adminOnly,
+	"/Robocup/GetSheetConfig":            adminOnly,
+	"/Robocup/SubmitSheetConfig":         adminOnly,
+	"/Robocup/GetAuditLog":               adminOnly,
+	"/Robocup/GetLoginLockouts":          adminOnly,
+	"/Robocup/ClearLoginLockout":         adminOnly,
+	"/Robocup/CreateApiKey":              adminOnly,
+	"/Robocup/GetApiKeys":                adminOnly,
+	"/Robocup/RevokeApiKey":              adminOnly,
+}
+
+// apiKeyScopePolicy maps each API key scope to the RPCs it grants.
+var apiKeyScopePolicy = map[serv.ApiKey_Scope][]string{
+	serv.ApiKey_LADDER_READ: {
+		"/Robocup/GetDanceLadder",
+		"/Robocup/GetDivisions",
+		"/Robocup/GetDivision",
+	},
+	serv.ApiKey_TEAMS_READ: {
+		"/Robocup/GetTeams",
+		"/Robocup/GetTeam",
+		"/Robocup/GetInstitutions",
+	},
+	serv.ApiKey_CHECKIN_WRITE: {
+		"/Robocup/GetTeams",
+		"/Robocup/GetCheckins",
+		"/Robocup/CreateCheckin",
+	},
+}
+
+// publicMethods may be called without a session.
+var publicMethods = map[string]bool{
+	"/Robocup/Login":            true,
+	"/Robocup/GetAuthProviders": true,
+}
+
+// userHasAnyRole reports whether the user holds one of the roles. Users without
+// any assigned role are treated as viewers.
+func userHasAnyRole(user *serv.User, allowed []serv.User_Role) bool {
+	roles := user.GetRoles()
+	if len(roles) == 0 {
+		roles = []serv.User_Role{serv.User_VIEWER}
+	}
+	for _, role := range roles {
+		for _, allowedRole := range allowed {
+			if role == allowedRole {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// methodAllowed reports whether the user may call the RPC according to methodPolicy.
+func methodAllowed(user *serv.User, fullMethodName string) bool {
+	allowed, ok := methodPolicy[fullMethodName]
+	if !ok {
+		return false
+	}
+	return userHasAnyRole(user, allowed)
+}
+
+// scopeAllowed reports whether one of the API key's scopes grants the RPC.
+func scopeAllowed(apiKey *serv.ApiKey, fullMethodName string) bool {
+	for _, scope := range apiKey.GetScopes() {
+		for _, method := range apiKeyScopePolicy[scope] {
+			if method == fullMethodName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Authorize restricts a route to users holding one of the roles. It must run after Authenticate.
+func (s *Server) Authorize(allowed ...serv.User_Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := c.Get(authenticatedUserKey)
+		if !ok {
+			c.AbortWithStatus(http.StatusUnauthorized)
+			return
+		}
+		if !userHasAnyRole(user.(*serv.User), allowed) {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+		c.Next()
+	}
+}
@@ -24,6 +24,29 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.ProtoPackageIsVersion2 // please upgrade the proto package
 
+type TemplateFormat int32
+
+const (
+	TemplateFormat_JSON TemplateFormat = 0
+	TemplateFormat_YAML TemplateFormat = 1
+)
+
+var TemplateFormat_name = map[int32]string{
+	0: "JSON",
+	1: "YAML",
+}
+var TemplateFormat_value = map[string]int32{
+	"JSON": 0,
+	"YAML": 1,
+}
+
+func (x TemplateFormat) String() string {
+	return proto.EnumName(TemplateFormat_name, int32(x))
+}
+func (TemplateFormat) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{0}
+}
+
 type Division_League int32
 
 const (
@@ -47,7 +70,7 @@ func (x Division_League) String() string {
 	return proto.EnumName(Division_League_name, int32(x))
 }
 func (Division_League) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{0, 0}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{0, 0}
 }
 
 type Member_Gender int32
@@ -73,7 +96,76 @@ func (x Member_Gender) String() string {
 	return proto.EnumName(Member_Gender_name, int32(x))
 }
 func (Member_Gender) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{2, 0}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{3, 0}
+}
+
+type User_Role int32
+
+const (
+	User_VIEWER        User_Role = 0
+	User_JUDGE         User_Role = 1
+	User_HEAD_JUDGE    User_Role = 2
+	User_CHECKIN_AGENT User_Role = 3
+	User_ADMIN         User_Role = 4
+)
+
+var User_Role_name = map[int32]string{
+	0: "VIEWER",
+	1: "JUDGE",
+	2: "HEAD_JUDGE",
+	3: "CHECKIN_AGENT",
+	4: "ADMIN",
+}
+var User_Role_value = map[string]int32{
+	"VIEWER":        0,
+	"JUDGE":         1,
+	"HEAD_JUDGE":    2,
+	"CHECKIN_AGENT": 3,
+	"ADMIN":         4,
+}
+
+func (x User_Role) String() string {
+	return proto.EnumName(User_Role_name, int32(x))
+}
+func (User_Role) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{7, 0}
+}
+
+type ScoreSheetTemplateSection_Kind int32
+
+const (
+	// A value between 0 and max_value in multiples of step
+	ScoreSheetTemplateSection_NUMERIC ScoreSheetTemplateSection_Kind = 0
+	// 0 or 1, worth points when ticked
+	ScoreSheetTemplateSection_CHECKBOX ScoreSheetTemplateSection_Kind = 1
+	// The value of one of the labelled levels
+	ScoreSheetTemplateSection_SCALE ScoreSheetTemplateSection_Kind = 2
+	// A whole number of items up to max_value, each worth points
+	ScoreSheetTemplateSection_COUNT ScoreSheetTemplateSection_Kind = 3
+	// A whole number of penalties up to max_value, each deducting points
+	ScoreSheetTemplateSection_PENALTY ScoreSheetTemplateSection_Kind = 4
+)
+
+var ScoreSheetTemplateSection_Kind_name = map[int32]string{
+	0: "NUMERIC",
+	1: "CHECKBOX",
+	2: "SCALE",
+	3: "COUNT",
+	4: "PENALTY",
+}
+var ScoreSheetTemplateSection_Kind_value = map[string]int32{
+	"NUMERIC":  0,
+	"CHECKBOX": 1,
+	"SCALE":    2,
+	"COUNT":    3,
+	"PENALTY":  4,
+}
+
+func (x ScoreSheetTemplateSection_Kind) String() string {
+	return proto.EnumName(ScoreSheetTemplateSection_Kind_name, int32(x))
+}
+func (ScoreSheetTemplateSection_Kind) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{10, 0}
 }
 
 type ScoreSheetTemplate_Type int32
@@ -96,7 +188,109 @@ func (x ScoreSheetTemplate_Type) String() string {
 	return proto.EnumName(ScoreSheetTemplate_Type_name, int32(x))
 }
 func (ScoreSheetTemplate_Type) EnumDescriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{10, 0}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{11, 0}
+}
+
+type AuthProvider_Type int32
+
+const (
+	AuthProvider_PASSWORD AuthProvider_Type = 0
+	AuthProvider_REDIRECT AuthProvider_Type = 1
+)
+
+var AuthProvider_Type_name = map[int32]string{
+	0: "PASSWORD",
+	1: "REDIRECT",
+}
+var AuthProvider_Type_value = map[string]int32{
+	"PASSWORD": 0,
+	"REDIRECT": 1,
+}
+
+func (x AuthProvider_Type) String() string {
+	return proto.EnumName(AuthProvider_Type_name, int32(x))
+}
+func (AuthProvider_Type) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{15, 0}
+}
+
+type ScoreSheet_Status int32
+
+const (
+	ScoreSheet_DRAFT     ScoreSheet_Status = 0
+	ScoreSheet_SUBMITTED ScoreSheet_Status = 1
+	// Set when the sheet's round is locked and cannot be requested directly
+	ScoreSheet_LOCKED ScoreSheet_Status = 2
+)
+
+var ScoreSheet_Status_name = map[int32]string{
+	0: "DRAFT",
+	1: "SUBMITTED",
+	2: "LOCKED",
+}
+var ScoreSheet_Status_value = map[string]int32{
+	"DRAFT":     0,
+	"SUBMITTED": 1,
+	"LOCKED":    2,
+}
+
+func (x ScoreSheet_Status) String() string {
+	return proto.EnumName(ScoreSheet_Status_name, int32(x))
+}
+func (ScoreSheet_Status) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{43, 0}
+}
+
+type ScoreSheetSyncResult_Outcome int32
+
+const (
+	ScoreSheetSyncResult_ACCEPTED   ScoreSheetSyncResult_Outcome = 0
+	ScoreSheetSyncResult_REJECTED   ScoreSheetSyncResult_Outcome = 1
+	ScoreSheetSyncResult_CONFLICTED ScoreSheetSyncResult_Outcome = 2
+)
+
+var ScoreSheetSyncResult_Outcome_name = map[int32]string{
+	0: "ACCEPTED",
+	1: "REJECTED",
+	2: "CONFLICTED",
+}
+var ScoreSheetSyncResult_Outcome_value = map[string]int32{
+	"ACCEPTED":   0,
+	"REJECTED":   1,
+	"CONFLICTED": 2,
+}
+
+func (x ScoreSheetSyncResult_Outcome) String() string {
+	return proto.EnumName(ScoreSheetSyncResult_Outcome_name, int32(x))
+}
+func (ScoreSheetSyncResult_Outcome) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{60, 0}
+}
+
+type ApiKey_Scope int32
+
+const (
+	ApiKey_LADDER_READ   ApiKey_Scope = 0
+	ApiKey_TEAMS_READ    ApiKey_Scope = 1
+	ApiKey_CHECKIN_WRITE ApiKey_Scope = 2
+)
+
+var ApiKey_Scope_name = map[int32]string{
+	0: "LADDER_READ",
+	1: "TEAMS_READ",
+	2: "CHECKIN_WRITE",
+}
+var ApiKey_Scope_value = map[string]int32{
+	"LADDER_READ":   0,
+	"TEAMS_READ":    1,
+	"CHECKIN_WRITE": 2,
+}
+
+func (x ApiKey_Scope) String() string {
+	return proto.EnumName(ApiKey_Scope_name, int32(x))
+}
+func (ApiKey_Scope) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{121, 0}
 }
 
 type Division struct {
@@ -107,6 +301,7 @@ type Division struct {
 	FinalRounds           int32           `protobuf:"varint,5,opt,name=final_rounds,json=finalRounds,proto3" json:"final_rounds,omitempty"`
 	InterviewTemplateId   string          `protobuf:"bytes,6,opt,name=interview_template_id,json=interviewTemplateId,proto3" json:"interview_template_id,omitempty"`
 	PerformanceTemplateId string          `protobuf:"bytes,7,opt,name=performance_template_id,json=performanceTemplateId,proto3" json:"performance_template_id,omitempty"`
+	CompetitionId         string          `protobuf:"bytes,8,opt,name=competition_id,json=competitionId,proto3" json:"competition_id,omitempty"`
 	XXX_NoUnkeyedLiteral  struct{}        `json:"-"`
 	XXX_unrecognized      []byte          `json:"-"`
 	XXX_sizecache         int32           `json:"-"`
@@ -116,7 +311,7 @@ func (m *Division) Reset()         { *m = Division{} }
 func (m *Division) String() string { return proto.CompactTextString(m) }
 func (*Division) ProtoMessage()    {}
 func (*Division) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{0}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{0}
 }
 func (m *Division) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Division.Unmarshal(m, b)
@@ -185,6 +380,67 @@ func (m *Division) GetPerformanceTemplateId() string {
 	return ""
 }
 
+func (m *Division) GetCompetitionId() string {
+	if m != nil {
+		return m.CompetitionId
+	}
+	return ""
+}
+
+type Competition struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,3,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *Competition) Reset()         { *m = Competition{} }
+func (m *Competition) String() string { return proto.CompactTextString(m) }
+func (*Competition) ProtoMessage()    {}
+func (*Competition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{1}
+}
+func (m *Competition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Competition.Unmarshal(m, b)
+}
+func (m *Competition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Competition.Marshal(b, m, deterministic)
+}
+func (dst *Competition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Competition.Merge(dst, src)
+}
+func (m *Competition) XXX_Size() int {
+	return xxx_messageInfo_Competition.Size(m)
+}
+func (m *Competition) XXX_DiscardUnknown() {
+	xxx_messageInfo_Competition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Competition proto.InternalMessageInfo
+
+func (m *Competition) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *Competition) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Competition) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
 type Institution struct {
 	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -197,7 +453,7 @@ func (m *Institution) Reset()         { *m = Institution{} }
 func (m *Institution) String() string { return proto.CompactTextString(m) }
 func (*Institution) ProtoMessage()    {}
 func (*Institution) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{1}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{2}
 }
 func (m *Institution) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Institution.Unmarshal(m, b)
@@ -244,7 +500,7 @@ func (m *Member) Reset()         { *m = Member{} }
 func (m *Member) String() string { return proto.CompactTextString(m) }
 func (*Member) ProtoMessage()    {}
 func (*Member) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{2}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{3}
 }
 func (m *Member) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Member.Unmarshal(m, b)
@@ -286,22 +542,24 @@ func (m *Member) GetGender() Member_Gender {
 }
 
 type Team struct {
-	Id                   string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Institution          *Institution `protobuf:"bytes,3,opt,name=institution,proto3" json:"institution,omitempty"`
-	Division             string       `protobuf:"bytes,4,opt,name=division,proto3" json:"division,omitempty"`
-	ImportId             string       `protobuf:"bytes,5,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
-	Members              []*Member    `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+	Id          string       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name        string       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Institution *Institution `protobuf:"bytes,3,opt,name=institution,proto3" json:"institution,omitempty"`
+	Division    string       `protobuf:"bytes,4,opt,name=division,proto3" json:"division,omitempty"`
+	ImportId    string       `protobuf:"bytes,5,opt,name=import_id,json=importId,proto3" json:"import_id,omitempty"`
+	Members     []*Member    `protobuf:"bytes,6,rep,name=members,proto3" json:"members,omitempty"`
+	// version is incremented on every update. Updates must send the version they were read at.
+	Version              int32    `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Team) Reset()         { *m = Team{} }
 func (m *Team) String() string { return proto.CompactTextString(m) }
 func (*Team) ProtoMessage()    {}
 func (*Team) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{3}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{4}
 }
 func (m *Team) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_Team.Unmarshal(m, b)
@@ -363,7 +621,15 @@ func (m *Team) GetMembers() []*Member {
 	return nil
 }
 
+func (m *Team) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type GetDivisionsRequest struct {
+	AssignedOnly         bool     `protobuf:"varint,1,opt,name=assigned_only,json=assignedOnly,proto3" json:"assigned_only,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -373,7 +639,7 @@ func (m *GetDivisionsRequest) Reset()         { *m = GetDivisionsRequest{} }
 func (m *GetDivisionsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetDivisionsRequest) ProtoMessage()    {}
 func (*GetDivisionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{4}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{5}
 }
 func (m *GetDivisionsRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDivisionsRequest.Unmarshal(m, b)
@@ -393,6 +659,13 @@ func (m *GetDivisionsRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetDivisionsRequest proto.InternalMessageInfo
 
+func (m *GetDivisionsRequest) GetAssignedOnly() bool {
+	if m != nil {
+		return m.AssignedOnly
+	}
+	return false
+}
+
 type GetDivisionsResponse struct {
 	Divisions            []*Division `protobuf:"bytes,1,rep,name=divisions,proto3" json:"divisions,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
@@ -404,7 +677,7 @@ func (m *GetDivisionsResponse) Reset()         { *m = GetDivisionsResponse{} }
 func (m *GetDivisionsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetDivisionsResponse) ProtoMessage()    {}
 func (*GetDivisionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{5}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{6}
 }
 func (m *GetDivisionsResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetDivisionsResponse.Unmarshal(m, b)
@@ -432,11 +705,14 @@ func (m *GetDivisionsResponse) GetDivisions() []*Division {
 }
 
 type User struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Username             string   `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
-	IsAdmin              bool     `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
-	Password             string   `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	Id       string      `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name     string      `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Username string      `protobuf:"bytes,3,opt,name=username,proto3" json:"username,omitempty"`
+	IsAdmin  bool        `protobuf:"varint,4,opt,name=is_admin,json=isAdmin,proto3" json:"is_admin,omitempty"`
+	Password string      `protobuf:"bytes,5,opt,name=password,proto3" json:"password,omitempty"`
+	Roles    []User_Role `protobuf:"varint,6,rep,packed,name=roles,proto3,enum=User_Role" json:"roles,omitempty"`
+	// must_change_password is set after an admin reset until the user picks a new password
+	MustChangePassword   bool     `protobuf:"varint,7,opt,name=must_change_password,json=mustChangePassword,proto3" json:"must_change_password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -446,7 +722,7 @@ func (m *User) Reset()         { *m = User{} }
 func (m *User) String() string { return proto.CompactTextString(m) }
 func (*User) ProtoMessage()    {}
 func (*User) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{6}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{7}
 }
 func (m *User) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_User.Unmarshal(m, b)
@@ -501,6 +777,20 @@ func (m *User) GetPassword() string {
 	return ""
 }
 
+func (m *User) GetRoles() []User_Role {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *User) GetMustChangePassword() bool {
+	if m != nil {
+		return m.MustChangePassword
+	}
+	return false
+}
+
 type GetUsersRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
@@ -511,7 +801,7 @@ func (m *GetUsersRequest) Reset()         { *m = GetUsersRequest{} }
 func (m *GetUsersRequest) String() string { return proto.CompactTextString(m) }
 func (*GetUsersRequest) ProtoMessage()    {}
 func (*GetUsersRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{7}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{8}
 }
 func (m *GetUsersRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetUsersRequest.Unmarshal(m, b)
@@ -542,7 +832,7 @@ func (m *GetUsersResponse) Reset()         { *m = GetUsersResponse{} }
 func (m *GetUsersResponse) String() string { return proto.CompactTextString(m) }
 func (*GetUsersResponse) ProtoMessage()    {}
 func (*GetUsersResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{8}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{9}
 }
 func (m *GetUsersResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetUsersResponse.Unmarshal(m, b)
@@ -570,22 +860,26 @@ func (m *GetUsersResponse) GetUsers() []*User {
 }
 
 type ScoreSheetTemplateSection struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	MaxValue             int32    `protobuf:"varint,4,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
-	Multiplier           int32    `protobuf:"varint,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
-	DisplayOrder         int32    `protobuf:"varint,6,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Id                   string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                string                             `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string                             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MaxValue             int32                              `protobuf:"varint,4,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Multiplier           int32                              `protobuf:"varint,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	DisplayOrder         int32                              `protobuf:"varint,6,opt,name=display_order,json=displayOrder,proto3" json:"display_order,omitempty"`
+	Kind                 ScoreSheetTemplateSection_Kind     `protobuf:"varint,7,opt,name=kind,proto3,enum=ScoreSheetTemplateSection_Kind" json:"kind,omitempty"`
+	Step                 float64                            `protobuf:"fixed64,8,opt,name=step,proto3" json:"step,omitempty"`
+	Levels               []*ScoreSheetTemplateSection_Level `protobuf:"bytes,9,rep,name=levels,proto3" json:"levels,omitempty"`
+	Points               float64                            `protobuf:"fixed64,10,opt,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
 func (m *ScoreSheetTemplateSection) Reset()         { *m = ScoreSheetTemplateSection{} }
 func (m *ScoreSheetTemplateSection) String() string { return proto.CompactTextString(m) }
 func (*ScoreSheetTemplateSection) ProtoMessage()    {}
 func (*ScoreSheetTemplateSection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{9}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{10}
 }
 func (m *ScoreSheetTemplateSection) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScoreSheetTemplateSection.Unmarshal(m, b)
@@ -647,12 +941,88 @@ func (m *ScoreSheetTemplateSection) GetDisplayOrder() int32 {
 	return 0
 }
 
+func (m *ScoreSheetTemplateSection) GetKind() ScoreSheetTemplateSection_Kind {
+	if m != nil {
+		return m.Kind
+	}
+	return ScoreSheetTemplateSection_NUMERIC
+}
+
+func (m *ScoreSheetTemplateSection) GetStep() float64 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
+}
+
+func (m *ScoreSheetTemplateSection) GetLevels() []*ScoreSheetTemplateSection_Level {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
+}
+
+func (m *ScoreSheetTemplateSection) GetPoints() float64 {
+	if m != nil {
+		return m.Points
+	}
+	return 0
+}
+
+type ScoreSheetTemplateSection_Level struct {
+	Label                string   `protobuf:"bytes,1,opt,name=label,proto3" json:"label,omitempty"`
+	Value                float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScoreSheetTemplateSection_Level) Reset()         { *m = ScoreSheetTemplateSection_Level{} }
+func (m *ScoreSheetTemplateSection_Level) String() string { return proto.CompactTextString(m) }
+func (*ScoreSheetTemplateSection_Level) ProtoMessage()    {}
+func (*ScoreSheetTemplateSection_Level) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{10, 0}
+}
+func (m *ScoreSheetTemplateSection_Level) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoreSheetTemplateSection_Level.Unmarshal(m, b)
+}
+func (m *ScoreSheetTemplateSection_Level) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoreSheetTemplateSection_Level.Marshal(b, m, deterministic)
+}
+func (dst *ScoreSheetTemplateSection_Level) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreSheetTemplateSection_Level.Merge(dst, src)
+}
+func (m *ScoreSheetTemplateSection_Level) XXX_Size() int {
+	return xxx_messageInfo_ScoreSheetTemplateSection_Level.Size(m)
+}
+func (m *ScoreSheetTemplateSection_Level) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreSheetTemplateSection_Level.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreSheetTemplateSection_Level proto.InternalMessageInfo
+
+func (m *ScoreSheetTemplateSection_Level) GetLabel() string {
+	if m != nil {
+		return m.Label
+	}
+	return ""
+}
+
+func (m *ScoreSheetTemplateSection_Level) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
 type ScoreSheetTemplate struct {
 	Id                   string                       `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
 	Name                 string                       `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 	Type                 ScoreSheetTemplate_Type      `protobuf:"varint,3,opt,name=type,proto3,enum=ScoreSheetTemplate_Type" json:"type,omitempty"`
 	Timings              []string                     `protobuf:"bytes,4,rep,name=timings,proto3" json:"timings,omitempty"`
 	Sections             []*ScoreSheetTemplateSection `protobuf:"bytes,5,rep,name=sections,proto3" json:"sections,omitempty"`
+	CompetitionId        string                       `protobuf:"bytes,6,opt,name=competition_id,json=competitionId,proto3" json:"competition_id,omitempty"`
+	Version              int32                        `protobuf:"varint,7,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                     `json:"-"`
 	XXX_unrecognized     []byte                       `json:"-"`
 	XXX_sizecache        int32                        `json:"-"`
@@ -662,7 +1032,7 @@ func (m *ScoreSheetTemplate) Reset()         { *m = ScoreSheetTemplate{} }
 func (m *ScoreSheetTemplate) String() string { return proto.CompactTextString(m) }
 func (*ScoreSheetTemplate) ProtoMessage()    {}
 func (*ScoreSheetTemplate) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{10}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{11}
 }
 func (m *ScoreSheetTemplate) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_ScoreSheetTemplate.Unmarshal(m, b)
@@ -717,6 +1087,20 @@ func (m *ScoreSheetTemplate) GetSections() []*ScoreSheetTemplateSection {
 	return nil
 }
 
+func (m *ScoreSheetTemplate) GetCompetitionId() string {
+	if m != nil {
+		return m.CompetitionId
+	}
+	return ""
+}
+
+func (m *ScoreSheetTemplate) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
+
 type GetScoreSheetTemplatesRequest struct {
 	Filter               *GetScoreSheetTemplatesRequest_QueryParameters `protobuf:"bytes,1,opt,name=filter,proto3" json:"filter,omitempty"`
 	PopulateSections     bool                                           `protobuf:"varint,2,opt,name=populate_sections,json=populateSections,proto3" json:"populate_sections,omitempty"`
@@ -729,7 +1113,7 @@ func (m *GetScoreSheetTemplatesRequest) Reset()         { *m = GetScoreSheetTemp
 func (m *GetScoreSheetTemplatesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetScoreSheetTemplatesRequest) ProtoMessage()    {}
 func (*GetScoreSheetTemplatesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{11}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{12}
 }
 func (m *GetScoreSheetTemplatesRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetScoreSheetTemplatesRequest.Unmarshal(m, b)
@@ -778,7 +1162,7 @@ func (m *GetScoreSheetTemplatesRequest_QueryParameters) String() string {
 }
 func (*GetScoreSheetTemplatesRequest_QueryParameters) ProtoMessage() {}
 func (*GetScoreSheetTemplatesRequest_QueryParameters) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{11, 0}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{12, 0}
 }
 func (m *GetScoreSheetTemplatesRequest_QueryParameters) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetScoreSheetTemplatesRequest_QueryParameters.Unmarshal(m, b)
@@ -816,7 +1200,7 @@ func (m *GetScoreSheetTemplatesResponse) Reset()         { *m = GetScoreSheetTem
 func (m *GetScoreSheetTemplatesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetScoreSheetTemplatesResponse) ProtoMessage()    {}
 func (*GetScoreSheetTemplatesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{12}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{13}
 }
 func (m *GetScoreSheetTemplatesResponse) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_GetScoreSheetTemplatesResponse.Unmarshal(m, b)
@@ -844,8 +1228,10 @@ func (m *GetScoreSheetTemplatesResponse) GetScoreSheetTemplates() []*ScoreSheetT
 }
 
 type LoginRequest struct {
-	Username             string   `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
-	Password             string   `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	Username string `protobuf:"bytes,1,opt,name=username,proto3" json:"username,omitempty"`
+	Password string `protobuf:"bytes,2,opt,name=password,proto3" json:"password,omitempty"`
+	// provider names the password backend to check the credentials against. Defaults to "local".
+	Provider             string   `protobuf:"bytes,3,opt,name=provider,proto3" json:"provider,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -855,7 +1241,7 @@ func (m *LoginRequest) Reset()         { *m = LoginRequest{} }
 func (m *LoginRequest) String() string { return proto.CompactTextString(m) }
 func (*LoginRequest) ProtoMessage()    {}
 func (*LoginRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{13}
+	return fileDescriptor_robocup_c61a48116c66836a, []int{14}
 }
 func (m *LoginRequest) XXX_Unmarshal(b []byte) error {
 	return xxx_messageInfo_LoginRequest.Unmarshal(m, b)
@@ -889,2686 +1275,8241 @@ func (m *LoginRequest) GetPassword() string {
 	return ""
 }
 
-type LoginResponse struct {
-	AuthenticatedUser    *User    `protobuf:"bytes,1,opt,name=authenticated_user,json=authenticatedUser,proto3" json:"authenticated_user,omitempty"`
+func (m *LoginRequest) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+type AuthProvider struct {
+	Name string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Type AuthProvider_Type `protobuf:"varint,2,opt,name=type,proto3,enum=AuthProvider_Type" json:"type,omitempty"`
+	// login_url is where to send the browser for REDIRECT providers
+	LoginUrl             string   `protobuf:"bytes,3,opt,name=login_url,json=loginUrl,proto3" json:"login_url,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
-func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
-func (*LoginResponse) ProtoMessage()    {}
-func (*LoginResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{14}
+func (m *AuthProvider) Reset()         { *m = AuthProvider{} }
+func (m *AuthProvider) String() string { return proto.CompactTextString(m) }
+func (*AuthProvider) ProtoMessage()    {}
+func (*AuthProvider) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{15}
 }
-func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_LoginResponse.Unmarshal(m, b)
+func (m *AuthProvider) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuthProvider.Unmarshal(m, b)
 }
-func (m *LoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_LoginResponse.Marshal(b, m, deterministic)
+func (m *AuthProvider) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuthProvider.Marshal(b, m, deterministic)
 }
-func (dst *LoginResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_LoginResponse.Merge(dst, src)
+func (dst *AuthProvider) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuthProvider.Merge(dst, src)
 }
-func (m *LoginResponse) XXX_Size() int {
-	return xxx_messageInfo_LoginResponse.Size(m)
+func (m *AuthProvider) XXX_Size() int {
+	return xxx_messageInfo_AuthProvider.Size(m)
 }
-func (m *LoginResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_LoginResponse.DiscardUnknown(m)
+func (m *AuthProvider) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuthProvider.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_LoginResponse proto.InternalMessageInfo
+var xxx_messageInfo_AuthProvider proto.InternalMessageInfo
 
-func (m *LoginResponse) GetAuthenticatedUser() *User {
+func (m *AuthProvider) GetName() string {
 	if m != nil {
-		return m.AuthenticatedUser
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type GetCurrentUserRequest struct {
+func (m *AuthProvider) GetType() AuthProvider_Type {
+	if m != nil {
+		return m.Type
+	}
+	return AuthProvider_PASSWORD
+}
+
+func (m *AuthProvider) GetLoginUrl() string {
+	if m != nil {
+		return m.LoginUrl
+	}
+	return ""
+}
+
+type BulkCreateUsersRequest struct {
+	// csv holds one user per row as name, username, role and an optional password.
+	// Several roles may be separated by semicolons. A leading header row is ignored.
+	Csv                  string   `protobuf:"bytes,1,opt,name=csv,proto3" json:"csv,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCurrentUserRequest) Reset()         { *m = GetCurrentUserRequest{} }
-func (m *GetCurrentUserRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCurrentUserRequest) ProtoMessage()    {}
-func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{15}
+func (m *BulkCreateUsersRequest) Reset()         { *m = BulkCreateUsersRequest{} }
+func (m *BulkCreateUsersRequest) String() string { return proto.CompactTextString(m) }
+func (*BulkCreateUsersRequest) ProtoMessage()    {}
+func (*BulkCreateUsersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{16}
 }
-func (m *GetCurrentUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCurrentUserRequest.Unmarshal(m, b)
+func (m *BulkCreateUsersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkCreateUsersRequest.Unmarshal(m, b)
 }
-func (m *GetCurrentUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCurrentUserRequest.Marshal(b, m, deterministic)
+func (m *BulkCreateUsersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkCreateUsersRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetCurrentUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCurrentUserRequest.Merge(dst, src)
+func (dst *BulkCreateUsersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkCreateUsersRequest.Merge(dst, src)
 }
-func (m *GetCurrentUserRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCurrentUserRequest.Size(m)
+func (m *BulkCreateUsersRequest) XXX_Size() int {
+	return xxx_messageInfo_BulkCreateUsersRequest.Size(m)
 }
-func (m *GetCurrentUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCurrentUserRequest.DiscardUnknown(m)
+func (m *BulkCreateUsersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkCreateUsersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCurrentUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_BulkCreateUsersRequest proto.InternalMessageInfo
 
-type GetCurrentUserResponse struct {
-	AuthenticatedUser    *User    `protobuf:"bytes,1,opt,name=authenticated_user,json=authenticatedUser,proto3" json:"authenticated_user,omitempty"`
+func (m *BulkCreateUsersRequest) GetCsv() string {
+	if m != nil {
+		return m.Csv
+	}
+	return ""
+}
+
+type BulkUserResult struct {
+	// row is the 1-based record number in the uploaded CSV, counting any header
+	Row      int32  `protobuf:"varint,1,opt,name=row,proto3" json:"row,omitempty"`
+	Username string `protobuf:"bytes,2,opt,name=username,proto3" json:"username,omitempty"`
+	User     *User  `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	// generated_password is set when the row did not include a password
+	GeneratedPassword    string   `protobuf:"bytes,4,opt,name=generated_password,json=generatedPassword,proto3" json:"generated_password,omitempty"`
+	Error                string   `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCurrentUserResponse) Reset()         { *m = GetCurrentUserResponse{} }
-func (m *GetCurrentUserResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCurrentUserResponse) ProtoMessage()    {}
-func (*GetCurrentUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{16}
+func (m *BulkUserResult) Reset()         { *m = BulkUserResult{} }
+func (m *BulkUserResult) String() string { return proto.CompactTextString(m) }
+func (*BulkUserResult) ProtoMessage()    {}
+func (*BulkUserResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{17}
 }
-func (m *GetCurrentUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCurrentUserResponse.Unmarshal(m, b)
+func (m *BulkUserResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkUserResult.Unmarshal(m, b)
 }
-func (m *GetCurrentUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCurrentUserResponse.Marshal(b, m, deterministic)
+func (m *BulkUserResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkUserResult.Marshal(b, m, deterministic)
 }
-func (dst *GetCurrentUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCurrentUserResponse.Merge(dst, src)
+func (dst *BulkUserResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkUserResult.Merge(dst, src)
 }
-func (m *GetCurrentUserResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCurrentUserResponse.Size(m)
+func (m *BulkUserResult) XXX_Size() int {
+	return xxx_messageInfo_BulkUserResult.Size(m)
 }
-func (m *GetCurrentUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCurrentUserResponse.DiscardUnknown(m)
+func (m *BulkUserResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkUserResult.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCurrentUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_BulkUserResult proto.InternalMessageInfo
 
-func (m *GetCurrentUserResponse) GetAuthenticatedUser() *User {
+func (m *BulkUserResult) GetRow() int32 {
 	if m != nil {
-		return m.AuthenticatedUser
+		return m.Row
 	}
-	return nil
+	return 0
 }
 
-type DivisionLadder struct {
-	Division             *Division                     `protobuf:"bytes,1,opt,name=division,proto3" json:"division,omitempty"`
-	Ladder               []*DivisionLadder_LadderEntry `protobuf:"bytes,2,rep,name=ladder,proto3" json:"ladder,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
-	XXX_unrecognized     []byte                        `json:"-"`
-	XXX_sizecache        int32                         `json:"-"`
+func (m *BulkUserResult) GetUsername() string {
+	if m != nil {
+		return m.Username
+	}
+	return ""
 }
 
-func (m *DivisionLadder) Reset()         { *m = DivisionLadder{} }
-func (m *DivisionLadder) String() string { return proto.CompactTextString(m) }
-func (*DivisionLadder) ProtoMessage()    {}
-func (*DivisionLadder) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{17}
-}
-func (m *DivisionLadder) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DivisionLadder.Unmarshal(m, b)
-}
-func (m *DivisionLadder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DivisionLadder.Marshal(b, m, deterministic)
-}
-func (dst *DivisionLadder) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DivisionLadder.Merge(dst, src)
-}
-func (m *DivisionLadder) XXX_Size() int {
-	return xxx_messageInfo_DivisionLadder.Size(m)
-}
-func (m *DivisionLadder) XXX_DiscardUnknown() {
-	xxx_messageInfo_DivisionLadder.DiscardUnknown(m)
+func (m *BulkUserResult) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
 }
 
-var xxx_messageInfo_DivisionLadder proto.InternalMessageInfo
-
-func (m *DivisionLadder) GetDivision() *Division {
+func (m *BulkUserResult) GetGeneratedPassword() string {
 	if m != nil {
-		return m.Division
+		return m.GeneratedPassword
 	}
-	return nil
+	return ""
 }
 
-func (m *DivisionLadder) GetLadder() []*DivisionLadder_LadderEntry {
+func (m *BulkUserResult) GetError() string {
 	if m != nil {
-		return m.Ladder
+		return m.Error
 	}
-	return nil
+	return ""
 }
 
-type DivisionLadder_LadderEntry struct {
-	Team                 *Team                                      `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
-	Rounds               []*DivisionLadder_LadderEntry_RoundAverage `protobuf:"bytes,2,rep,name=rounds,proto3" json:"rounds,omitempty"`
-	InterviewScore       float64                                    `protobuf:"fixed64,3,opt,name=interview_score,json=interviewScore,proto3" json:"interview_score,omitempty"`
-	BestRound            float64                                    `protobuf:"fixed64,4,opt,name=best_round,json=bestRound,proto3" json:"best_round,omitempty"`
-	BestFinal            float64                                    `protobuf:"fixed64,5,opt,name=best_final,json=bestFinal,proto3" json:"best_final,omitempty"`
-	RoundTotal           float64                                    `protobuf:"fixed64,6,opt,name=round_total,json=roundTotal,proto3" json:"round_total,omitempty"`
-	FinalTotal           float64                                    `protobuf:"fixed64,7,opt,name=final_total,json=finalTotal,proto3" json:"final_total,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
-	XXX_unrecognized     []byte                                     `json:"-"`
-	XXX_sizecache        int32                                      `json:"-"`
+type BulkCreateUsersResponse struct {
+	// created is false if any row had an error, in which case no users were created
+	Created              bool              `protobuf:"varint,1,opt,name=created,proto3" json:"created,omitempty"`
+	Results              []*BulkUserResult `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *DivisionLadder_LadderEntry) Reset()         { *m = DivisionLadder_LadderEntry{} }
-func (m *DivisionLadder_LadderEntry) String() string { return proto.CompactTextString(m) }
-func (*DivisionLadder_LadderEntry) ProtoMessage()    {}
-func (*DivisionLadder_LadderEntry) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{17, 0}
+func (m *BulkCreateUsersResponse) Reset()         { *m = BulkCreateUsersResponse{} }
+func (m *BulkCreateUsersResponse) String() string { return proto.CompactTextString(m) }
+func (*BulkCreateUsersResponse) ProtoMessage()    {}
+func (*BulkCreateUsersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{18}
 }
-func (m *DivisionLadder_LadderEntry) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DivisionLadder_LadderEntry.Unmarshal(m, b)
+func (m *BulkCreateUsersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_BulkCreateUsersResponse.Unmarshal(m, b)
 }
-func (m *DivisionLadder_LadderEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DivisionLadder_LadderEntry.Marshal(b, m, deterministic)
+func (m *BulkCreateUsersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_BulkCreateUsersResponse.Marshal(b, m, deterministic)
 }
-func (dst *DivisionLadder_LadderEntry) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DivisionLadder_LadderEntry.Merge(dst, src)
+func (dst *BulkCreateUsersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BulkCreateUsersResponse.Merge(dst, src)
 }
-func (m *DivisionLadder_LadderEntry) XXX_Size() int {
-	return xxx_messageInfo_DivisionLadder_LadderEntry.Size(m)
+func (m *BulkCreateUsersResponse) XXX_Size() int {
+	return xxx_messageInfo_BulkCreateUsersResponse.Size(m)
 }
-func (m *DivisionLadder_LadderEntry) XXX_DiscardUnknown() {
-	xxx_messageInfo_DivisionLadder_LadderEntry.DiscardUnknown(m)
+func (m *BulkCreateUsersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_BulkCreateUsersResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DivisionLadder_LadderEntry proto.InternalMessageInfo
+var xxx_messageInfo_BulkCreateUsersResponse proto.InternalMessageInfo
 
-func (m *DivisionLadder_LadderEntry) GetTeam() *Team {
+func (m *BulkCreateUsersResponse) GetCreated() bool {
 	if m != nil {
-		return m.Team
+		return m.Created
 	}
-	return nil
+	return false
 }
 
-func (m *DivisionLadder_LadderEntry) GetRounds() []*DivisionLadder_LadderEntry_RoundAverage {
+func (m *BulkCreateUsersResponse) GetResults() []*BulkUserResult {
 	if m != nil {
-		return m.Rounds
+		return m.Results
 	}
 	return nil
 }
 
-func (m *DivisionLadder_LadderEntry) GetInterviewScore() float64 {
-	if m != nil {
-		return m.InterviewScore
-	}
-	return 0
+type ChangePasswordRequest struct {
+	CurrentPassword      string   `protobuf:"bytes,1,opt,name=current_password,json=currentPassword,proto3" json:"current_password,omitempty"`
+	NewPassword          string   `protobuf:"bytes,2,opt,name=new_password,json=newPassword,proto3" json:"new_password,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DivisionLadder_LadderEntry) GetBestRound() float64 {
-	if m != nil {
-		return m.BestRound
-	}
-	return 0
+func (m *ChangePasswordRequest) Reset()         { *m = ChangePasswordRequest{} }
+func (m *ChangePasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordRequest) ProtoMessage()    {}
+func (*ChangePasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{19}
 }
-
-func (m *DivisionLadder_LadderEntry) GetBestFinal() float64 {
-	if m != nil {
-		return m.BestFinal
-	}
-	return 0
+func (m *ChangePasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordRequest.Unmarshal(m, b)
+}
+func (m *ChangePasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordRequest.Marshal(b, m, deterministic)
+}
+func (dst *ChangePasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordRequest.Merge(dst, src)
+}
+func (m *ChangePasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordRequest.Size(m)
+}
+func (m *ChangePasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordRequest.DiscardUnknown(m)
 }
 
-func (m *DivisionLadder_LadderEntry) GetRoundTotal() float64 {
+var xxx_messageInfo_ChangePasswordRequest proto.InternalMessageInfo
+
+func (m *ChangePasswordRequest) GetCurrentPassword() string {
 	if m != nil {
-		return m.RoundTotal
+		return m.CurrentPassword
 	}
-	return 0
+	return ""
 }
 
-func (m *DivisionLadder_LadderEntry) GetFinalTotal() float64 {
+func (m *ChangePasswordRequest) GetNewPassword() string {
 	if m != nil {
-		return m.FinalTotal
+		return m.NewPassword
 	}
-	return 0
+	return ""
 }
 
-type DivisionLadder_LadderEntry_RoundAverage struct {
-	Round                int32    `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
-	Average              float64  `protobuf:"fixed64,2,opt,name=average,proto3" json:"average,omitempty"`
-	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+type ChangePasswordResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DivisionLadder_LadderEntry_RoundAverage) Reset() {
-	*m = DivisionLadder_LadderEntry_RoundAverage{}
-}
-func (m *DivisionLadder_LadderEntry_RoundAverage) String() string { return proto.CompactTextString(m) }
-func (*DivisionLadder_LadderEntry_RoundAverage) ProtoMessage()    {}
-func (*DivisionLadder_LadderEntry_RoundAverage) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{17, 0, 0}
+func (m *ChangePasswordResponse) Reset()         { *m = ChangePasswordResponse{} }
+func (m *ChangePasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ChangePasswordResponse) ProtoMessage()    {}
+func (*ChangePasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{20}
 }
-func (m *DivisionLadder_LadderEntry_RoundAverage) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage.Unmarshal(m, b)
+func (m *ChangePasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ChangePasswordResponse.Unmarshal(m, b)
 }
-func (m *DivisionLadder_LadderEntry_RoundAverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage.Marshal(b, m, deterministic)
+func (m *ChangePasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ChangePasswordResponse.Marshal(b, m, deterministic)
 }
-func (dst *DivisionLadder_LadderEntry_RoundAverage) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage.Merge(dst, src)
+func (dst *ChangePasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChangePasswordResponse.Merge(dst, src)
 }
-func (m *DivisionLadder_LadderEntry_RoundAverage) XXX_Size() int {
-	return xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage.Size(m)
+func (m *ChangePasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ChangePasswordResponse.Size(m)
 }
-func (m *DivisionLadder_LadderEntry_RoundAverage) XXX_DiscardUnknown() {
-	xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage.DiscardUnknown(m)
+func (m *ChangePasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChangePasswordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage proto.InternalMessageInfo
+var xxx_messageInfo_ChangePasswordResponse proto.InternalMessageInfo
 
-func (m *DivisionLadder_LadderEntry_RoundAverage) GetRound() int32 {
+func (m *ChangePasswordResponse) GetUser() *User {
 	if m != nil {
-		return m.Round
+		return m.User
 	}
-	return 0
+	return nil
 }
 
-func (m *DivisionLadder_LadderEntry_RoundAverage) GetAverage() float64 {
-	if m != nil {
-		return m.Average
-	}
-	return 0
+type ResetUserPasswordRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *DivisionLadder_LadderEntry_RoundAverage) GetCount() int32 {
+func (m *ResetUserPasswordRequest) Reset()         { *m = ResetUserPasswordRequest{} }
+func (m *ResetUserPasswordRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetUserPasswordRequest) ProtoMessage()    {}
+func (*ResetUserPasswordRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{21}
+}
+func (m *ResetUserPasswordRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetUserPasswordRequest.Unmarshal(m, b)
+}
+func (m *ResetUserPasswordRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetUserPasswordRequest.Marshal(b, m, deterministic)
+}
+func (dst *ResetUserPasswordRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetUserPasswordRequest.Merge(dst, src)
+}
+func (m *ResetUserPasswordRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetUserPasswordRequest.Size(m)
+}
+func (m *ResetUserPasswordRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetUserPasswordRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetUserPasswordRequest proto.InternalMessageInfo
+
+func (m *ResetUserPasswordRequest) GetUserId() string {
 	if m != nil {
-		return m.Count
+		return m.UserId
 	}
-	return 0
+	return ""
 }
 
-type GetDanceLadderRequest struct {
-	ShowAll              bool     `protobuf:"varint,1,opt,name=show_all,json=showAll,proto3" json:"show_all,omitempty"`
+type ResetUserPasswordResponse struct {
+	// temporary_password must be passed to the user, who will be asked to change it after logging in
+	TemporaryPassword    string   `protobuf:"bytes,1,opt,name=temporary_password,json=temporaryPassword,proto3" json:"temporary_password,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetDanceLadderRequest) Reset()         { *m = GetDanceLadderRequest{} }
-func (m *GetDanceLadderRequest) String() string { return proto.CompactTextString(m) }
-func (*GetDanceLadderRequest) ProtoMessage()    {}
-func (*GetDanceLadderRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{18}
+func (m *ResetUserPasswordResponse) Reset()         { *m = ResetUserPasswordResponse{} }
+func (m *ResetUserPasswordResponse) String() string { return proto.CompactTextString(m) }
+func (*ResetUserPasswordResponse) ProtoMessage()    {}
+func (*ResetUserPasswordResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{22}
 }
-func (m *GetDanceLadderRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDanceLadderRequest.Unmarshal(m, b)
+func (m *ResetUserPasswordResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetUserPasswordResponse.Unmarshal(m, b)
 }
-func (m *GetDanceLadderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDanceLadderRequest.Marshal(b, m, deterministic)
+func (m *ResetUserPasswordResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetUserPasswordResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetDanceLadderRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDanceLadderRequest.Merge(dst, src)
+func (dst *ResetUserPasswordResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetUserPasswordResponse.Merge(dst, src)
 }
-func (m *GetDanceLadderRequest) XXX_Size() int {
-	return xxx_messageInfo_GetDanceLadderRequest.Size(m)
+func (m *ResetUserPasswordResponse) XXX_Size() int {
+	return xxx_messageInfo_ResetUserPasswordResponse.Size(m)
 }
-func (m *GetDanceLadderRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDanceLadderRequest.DiscardUnknown(m)
+func (m *ResetUserPasswordResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetUserPasswordResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDanceLadderRequest proto.InternalMessageInfo
+var xxx_messageInfo_ResetUserPasswordResponse proto.InternalMessageInfo
 
-func (m *GetDanceLadderRequest) GetShowAll() bool {
+func (m *ResetUserPasswordResponse) GetTemporaryPassword() string {
 	if m != nil {
-		return m.ShowAll
+		return m.TemporaryPassword
 	}
-	return false
+	return ""
 }
 
-type GetDanceLadderResponse struct {
-	Divisions            []*DivisionLadder `protobuf:"bytes,1,rep,name=divisions,proto3" json:"divisions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
-	XXX_unrecognized     []byte            `json:"-"`
-	XXX_sizecache        int32             `json:"-"`
+// UserIdentity links an account at an LDAP or OpenID Connect provider to a local user.
+type UserIdentity struct {
+	// provider is the name of the login backend in the server config
+	Provider string `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	// subject permanently identifies the account within the provider, such as an LDAP entry DN
+	// or an OpenID Connect sub claim
+	Subject              string               `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
+	UserId               string               `protobuf:"bytes,3,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *GetDanceLadderResponse) Reset()         { *m = GetDanceLadderResponse{} }
-func (m *GetDanceLadderResponse) String() string { return proto.CompactTextString(m) }
-func (*GetDanceLadderResponse) ProtoMessage()    {}
-func (*GetDanceLadderResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{19}
+func (m *UserIdentity) Reset()         { *m = UserIdentity{} }
+func (m *UserIdentity) String() string { return proto.CompactTextString(m) }
+func (*UserIdentity) ProtoMessage()    {}
+func (*UserIdentity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{23}
 }
-func (m *GetDanceLadderResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDanceLadderResponse.Unmarshal(m, b)
+func (m *UserIdentity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UserIdentity.Unmarshal(m, b)
 }
-func (m *GetDanceLadderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDanceLadderResponse.Marshal(b, m, deterministic)
+func (m *UserIdentity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UserIdentity.Marshal(b, m, deterministic)
 }
-func (dst *GetDanceLadderResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDanceLadderResponse.Merge(dst, src)
+func (dst *UserIdentity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UserIdentity.Merge(dst, src)
 }
-func (m *GetDanceLadderResponse) XXX_Size() int {
-	return xxx_messageInfo_GetDanceLadderResponse.Size(m)
+func (m *UserIdentity) XXX_Size() int {
+	return xxx_messageInfo_UserIdentity.Size(m)
 }
-func (m *GetDanceLadderResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDanceLadderResponse.DiscardUnknown(m)
+func (m *UserIdentity) XXX_DiscardUnknown() {
+	xxx_messageInfo_UserIdentity.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDanceLadderResponse proto.InternalMessageInfo
+var xxx_messageInfo_UserIdentity proto.InternalMessageInfo
 
-func (m *GetDanceLadderResponse) GetDivisions() []*DivisionLadder {
+func (m *UserIdentity) GetProvider() string {
 	if m != nil {
-		return m.Divisions
+		return m.Provider
+	}
+	return ""
+}
+
+func (m *UserIdentity) GetSubject() string {
+	if m != nil {
+		return m.Subject
+	}
+	return ""
+}
+
+func (m *UserIdentity) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *UserIdentity) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
 	}
 	return nil
 }
 
-type GetDivisionRequest struct {
-	DivisionId           string   `protobuf:"bytes,1,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
-	IncludeTeams         bool     `protobuf:"varint,2,opt,name=include_teams,json=includeTeams,proto3" json:"include_teams,omitempty"`
-	IncludeTemplates     bool     `protobuf:"varint,3,opt,name=include_templates,json=includeTemplates,proto3" json:"include_templates,omitempty"`
+type GetUserIdentitiesRequest struct {
+	UserId               string   `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetDivisionRequest) Reset()         { *m = GetDivisionRequest{} }
-func (m *GetDivisionRequest) String() string { return proto.CompactTextString(m) }
-func (*GetDivisionRequest) ProtoMessage()    {}
-func (*GetDivisionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{20}
+func (m *GetUserIdentitiesRequest) Reset()         { *m = GetUserIdentitiesRequest{} }
+func (m *GetUserIdentitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetUserIdentitiesRequest) ProtoMessage()    {}
+func (*GetUserIdentitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{24}
 }
-func (m *GetDivisionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDivisionRequest.Unmarshal(m, b)
+func (m *GetUserIdentitiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserIdentitiesRequest.Unmarshal(m, b)
 }
-func (m *GetDivisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDivisionRequest.Marshal(b, m, deterministic)
+func (m *GetUserIdentitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserIdentitiesRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetDivisionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDivisionRequest.Merge(dst, src)
+func (dst *GetUserIdentitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserIdentitiesRequest.Merge(dst, src)
 }
-func (m *GetDivisionRequest) XXX_Size() int {
-	return xxx_messageInfo_GetDivisionRequest.Size(m)
+func (m *GetUserIdentitiesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetUserIdentitiesRequest.Size(m)
 }
-func (m *GetDivisionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDivisionRequest.DiscardUnknown(m)
+func (m *GetUserIdentitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserIdentitiesRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDivisionRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetUserIdentitiesRequest proto.InternalMessageInfo
 
-func (m *GetDivisionRequest) GetDivisionId() string {
+func (m *GetUserIdentitiesRequest) GetUserId() string {
 	if m != nil {
-		return m.DivisionId
+		return m.UserId
 	}
 	return ""
 }
 
-func (m *GetDivisionRequest) GetIncludeTeams() bool {
-	if m != nil {
-		return m.IncludeTeams
-	}
-	return false
+type GetUserIdentitiesResponse struct {
+	Identities           []*UserIdentity `protobuf:"bytes,1,rep,name=identities,proto3" json:"identities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *GetDivisionRequest) GetIncludeTemplates() bool {
+func (m *GetUserIdentitiesResponse) Reset()         { *m = GetUserIdentitiesResponse{} }
+func (m *GetUserIdentitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetUserIdentitiesResponse) ProtoMessage()    {}
+func (*GetUserIdentitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{25}
+}
+func (m *GetUserIdentitiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetUserIdentitiesResponse.Unmarshal(m, b)
+}
+func (m *GetUserIdentitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetUserIdentitiesResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetUserIdentitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetUserIdentitiesResponse.Merge(dst, src)
+}
+func (m *GetUserIdentitiesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetUserIdentitiesResponse.Size(m)
+}
+func (m *GetUserIdentitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetUserIdentitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetUserIdentitiesResponse proto.InternalMessageInfo
+
+func (m *GetUserIdentitiesResponse) GetIdentities() []*UserIdentity {
 	if m != nil {
-		return m.IncludeTemplates
+		return m.Identities
 	}
-	return false
+	return nil
 }
 
-type GetDivisionResponse struct {
-	Division             *Division             `protobuf:"bytes,1,opt,name=division,proto3" json:"division,omitempty"`
-	Teams                []*Team               `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
-	Templates            []*ScoreSheetTemplate `protobuf:"bytes,3,rep,name=templates,proto3" json:"templates,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
-	XXX_unrecognized     []byte                `json:"-"`
-	XXX_sizecache        int32                 `json:"-"`
+type LinkUserIdentityRequest struct {
+	Identity             *UserIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetDivisionResponse) Reset()         { *m = GetDivisionResponse{} }
-func (m *GetDivisionResponse) String() string { return proto.CompactTextString(m) }
-func (*GetDivisionResponse) ProtoMessage()    {}
-func (*GetDivisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{21}
+func (m *LinkUserIdentityRequest) Reset()         { *m = LinkUserIdentityRequest{} }
+func (m *LinkUserIdentityRequest) String() string { return proto.CompactTextString(m) }
+func (*LinkUserIdentityRequest) ProtoMessage()    {}
+func (*LinkUserIdentityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{26}
 }
-func (m *GetDivisionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetDivisionResponse.Unmarshal(m, b)
+func (m *LinkUserIdentityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkUserIdentityRequest.Unmarshal(m, b)
 }
-func (m *GetDivisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetDivisionResponse.Marshal(b, m, deterministic)
+func (m *LinkUserIdentityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkUserIdentityRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetDivisionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetDivisionResponse.Merge(dst, src)
+func (dst *LinkUserIdentityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkUserIdentityRequest.Merge(dst, src)
 }
-func (m *GetDivisionResponse) XXX_Size() int {
-	return xxx_messageInfo_GetDivisionResponse.Size(m)
+func (m *LinkUserIdentityRequest) XXX_Size() int {
+	return xxx_messageInfo_LinkUserIdentityRequest.Size(m)
 }
-func (m *GetDivisionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetDivisionResponse.DiscardUnknown(m)
+func (m *LinkUserIdentityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkUserIdentityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetDivisionResponse proto.InternalMessageInfo
+var xxx_messageInfo_LinkUserIdentityRequest proto.InternalMessageInfo
 
-func (m *GetDivisionResponse) GetDivision() *Division {
+func (m *LinkUserIdentityRequest) GetIdentity() *UserIdentity {
 	if m != nil {
-		return m.Division
+		return m.Identity
 	}
 	return nil
 }
 
-func (m *GetDivisionResponse) GetTeams() []*Team {
-	if m != nil {
-		return m.Teams
-	}
-	return nil
+type LinkUserIdentityResponse struct {
+	Identity             *UserIdentity `protobuf:"bytes,1,opt,name=identity,proto3" json:"identity,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GetDivisionResponse) GetTemplates() []*ScoreSheetTemplate {
+func (m *LinkUserIdentityResponse) Reset()         { *m = LinkUserIdentityResponse{} }
+func (m *LinkUserIdentityResponse) String() string { return proto.CompactTextString(m) }
+func (*LinkUserIdentityResponse) ProtoMessage()    {}
+func (*LinkUserIdentityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{27}
+}
+func (m *LinkUserIdentityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LinkUserIdentityResponse.Unmarshal(m, b)
+}
+func (m *LinkUserIdentityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LinkUserIdentityResponse.Marshal(b, m, deterministic)
+}
+func (dst *LinkUserIdentityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LinkUserIdentityResponse.Merge(dst, src)
+}
+func (m *LinkUserIdentityResponse) XXX_Size() int {
+	return xxx_messageInfo_LinkUserIdentityResponse.Size(m)
+}
+func (m *LinkUserIdentityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LinkUserIdentityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LinkUserIdentityResponse proto.InternalMessageInfo
+
+func (m *LinkUserIdentityResponse) GetIdentity() *UserIdentity {
 	if m != nil {
-		return m.Templates
+		return m.Identity
 	}
 	return nil
 }
 
-type ScoreSheetSection struct {
-	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
-	Description          string   `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
-	MaxValue             int32    `protobuf:"varint,4,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
-	Multiplier           int32    `protobuf:"varint,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
-	SectionId            string   `protobuf:"bytes,6,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
-	Value                float64  `protobuf:"fixed64,7,opt,name=value,proto3" json:"value,omitempty"`
+type UnlinkUserIdentityRequest struct {
+	Provider             string   `protobuf:"bytes,1,opt,name=provider,proto3" json:"provider,omitempty"`
+	Subject              string   `protobuf:"bytes,2,opt,name=subject,proto3" json:"subject,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ScoreSheetSection) Reset()         { *m = ScoreSheetSection{} }
-func (m *ScoreSheetSection) String() string { return proto.CompactTextString(m) }
-func (*ScoreSheetSection) ProtoMessage()    {}
-func (*ScoreSheetSection) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{22}
+func (m *UnlinkUserIdentityRequest) Reset()         { *m = UnlinkUserIdentityRequest{} }
+func (m *UnlinkUserIdentityRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlinkUserIdentityRequest) ProtoMessage()    {}
+func (*UnlinkUserIdentityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{28}
 }
-func (m *ScoreSheetSection) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScoreSheetSection.Unmarshal(m, b)
+func (m *UnlinkUserIdentityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlinkUserIdentityRequest.Unmarshal(m, b)
 }
-func (m *ScoreSheetSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScoreSheetSection.Marshal(b, m, deterministic)
+func (m *UnlinkUserIdentityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlinkUserIdentityRequest.Marshal(b, m, deterministic)
 }
-func (dst *ScoreSheetSection) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScoreSheetSection.Merge(dst, src)
+func (dst *UnlinkUserIdentityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlinkUserIdentityRequest.Merge(dst, src)
 }
-func (m *ScoreSheetSection) XXX_Size() int {
-	return xxx_messageInfo_ScoreSheetSection.Size(m)
+func (m *UnlinkUserIdentityRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlinkUserIdentityRequest.Size(m)
 }
-func (m *ScoreSheetSection) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScoreSheetSection.DiscardUnknown(m)
+func (m *UnlinkUserIdentityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlinkUserIdentityRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScoreSheetSection proto.InternalMessageInfo
+var xxx_messageInfo_UnlinkUserIdentityRequest proto.InternalMessageInfo
 
-func (m *ScoreSheetSection) GetId() string {
+func (m *UnlinkUserIdentityRequest) GetProvider() string {
 	if m != nil {
-		return m.Id
+		return m.Provider
 	}
 	return ""
 }
 
-func (m *ScoreSheetSection) GetTitle() string {
+func (m *UnlinkUserIdentityRequest) GetSubject() string {
 	if m != nil {
-		return m.Title
+		return m.Subject
 	}
 	return ""
 }
 
-func (m *ScoreSheetSection) GetDescription() string {
-	if m != nil {
-		return m.Description
-	}
-	return ""
+type UnlinkUserIdentityResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ScoreSheetSection) GetMaxValue() int32 {
-	if m != nil {
-		return m.MaxValue
-	}
-	return 0
+func (m *UnlinkUserIdentityResponse) Reset()         { *m = UnlinkUserIdentityResponse{} }
+func (m *UnlinkUserIdentityResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlinkUserIdentityResponse) ProtoMessage()    {}
+func (*UnlinkUserIdentityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{29}
 }
-
-func (m *ScoreSheetSection) GetMultiplier() int32 {
-	if m != nil {
-		return m.Multiplier
-	}
-	return 0
+func (m *UnlinkUserIdentityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlinkUserIdentityResponse.Unmarshal(m, b)
 }
-
-func (m *ScoreSheetSection) GetSectionId() string {
-	if m != nil {
-		return m.SectionId
-	}
-	return ""
+func (m *UnlinkUserIdentityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlinkUserIdentityResponse.Marshal(b, m, deterministic)
 }
-
-func (m *ScoreSheetSection) GetValue() float64 {
-	if m != nil {
-		return m.Value
-	}
-	return 0
+func (dst *UnlinkUserIdentityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlinkUserIdentityResponse.Merge(dst, src)
+}
+func (m *UnlinkUserIdentityResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlinkUserIdentityResponse.Size(m)
+}
+func (m *UnlinkUserIdentityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlinkUserIdentityResponse.DiscardUnknown(m)
 }
 
-type ScoreSheet struct {
-	Id                   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Comments             string                  `protobuf:"bytes,2,opt,name=comments,proto3" json:"comments,omitempty"`
-	Round                int32                   `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
-	Team                 *Team                   `protobuf:"bytes,4,opt,name=team,proto3" json:"team,omitempty"`
-	Author               *User                   `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
-	Sections             []*ScoreSheetSection    `protobuf:"bytes,6,rep,name=sections,proto3" json:"sections,omitempty"`
-	Timings              []*ScoreSheet_Timing    `protobuf:"bytes,7,rep,name=timings,proto3" json:"timings,omitempty"`
-	Type                 ScoreSheetTemplate_Type `protobuf:"varint,8,opt,name=type,proto3,enum=ScoreSheetTemplate_Type" json:"type,omitempty"`
-	ScoreSheetTemplateId string                  `protobuf:"bytes,9,opt,name=score_sheet_template_id,json=scoreSheetTemplateId,proto3" json:"score_sheet_template_id,omitempty"`
-	DivisionId           string                  `protobuf:"bytes,10,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
-	Total                float64                 `protobuf:"fixed64,11,opt,name=total,proto3" json:"total,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
-	XXX_unrecognized     []byte                  `json:"-"`
-	XXX_sizecache        int32                   `json:"-"`
+var xxx_messageInfo_UnlinkUserIdentityResponse proto.InternalMessageInfo
+
+type GetAuthProvidersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ScoreSheet) Reset()         { *m = ScoreSheet{} }
-func (m *ScoreSheet) String() string { return proto.CompactTextString(m) }
-func (*ScoreSheet) ProtoMessage()    {}
-func (*ScoreSheet) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{23}
+func (m *GetAuthProvidersRequest) Reset()         { *m = GetAuthProvidersRequest{} }
+func (m *GetAuthProvidersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuthProvidersRequest) ProtoMessage()    {}
+func (*GetAuthProvidersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{30}
 }
-func (m *ScoreSheet) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScoreSheet.Unmarshal(m, b)
+func (m *GetAuthProvidersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuthProvidersRequest.Unmarshal(m, b)
 }
-func (m *ScoreSheet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScoreSheet.Marshal(b, m, deterministic)
+func (m *GetAuthProvidersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuthProvidersRequest.Marshal(b, m, deterministic)
 }
-func (dst *ScoreSheet) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScoreSheet.Merge(dst, src)
+func (dst *GetAuthProvidersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuthProvidersRequest.Merge(dst, src)
 }
-func (m *ScoreSheet) XXX_Size() int {
-	return xxx_messageInfo_ScoreSheet.Size(m)
+func (m *GetAuthProvidersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAuthProvidersRequest.Size(m)
 }
-func (m *ScoreSheet) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScoreSheet.DiscardUnknown(m)
+func (m *GetAuthProvidersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuthProvidersRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScoreSheet proto.InternalMessageInfo
+var xxx_messageInfo_GetAuthProvidersRequest proto.InternalMessageInfo
 
-func (m *ScoreSheet) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+type GetAuthProvidersResponse struct {
+	Providers            []*AuthProvider `protobuf:"bytes,1,rep,name=providers,proto3" json:"providers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
 }
 
-func (m *ScoreSheet) GetComments() string {
-	if m != nil {
-		return m.Comments
-	}
-	return ""
+func (m *GetAuthProvidersResponse) Reset()         { *m = GetAuthProvidersResponse{} }
+func (m *GetAuthProvidersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuthProvidersResponse) ProtoMessage()    {}
+func (*GetAuthProvidersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{31}
 }
-
-func (m *ScoreSheet) GetRound() int32 {
-	if m != nil {
-		return m.Round
-	}
-	return 0
+func (m *GetAuthProvidersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuthProvidersResponse.Unmarshal(m, b)
 }
-
-func (m *ScoreSheet) GetTeam() *Team {
-	if m != nil {
-		return m.Team
-	}
-	return nil
+func (m *GetAuthProvidersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuthProvidersResponse.Marshal(b, m, deterministic)
 }
-
-func (m *ScoreSheet) GetAuthor() *User {
-	if m != nil {
-		return m.Author
-	}
-	return nil
+func (dst *GetAuthProvidersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuthProvidersResponse.Merge(dst, src)
 }
-
-func (m *ScoreSheet) GetSections() []*ScoreSheetSection {
-	if m != nil {
-		return m.Sections
-	}
-	return nil
+func (m *GetAuthProvidersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAuthProvidersResponse.Size(m)
+}
+func (m *GetAuthProvidersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuthProvidersResponse.DiscardUnknown(m)
 }
 
-func (m *ScoreSheet) GetTimings() []*ScoreSheet_Timing {
+var xxx_messageInfo_GetAuthProvidersResponse proto.InternalMessageInfo
+
+func (m *GetAuthProvidersResponse) GetProviders() []*AuthProvider {
 	if m != nil {
-		return m.Timings
+		return m.Providers
 	}
 	return nil
 }
 
-func (m *ScoreSheet) GetType() ScoreSheetTemplate_Type {
-	if m != nil {
-		return m.Type
-	}
-	return ScoreSheetTemplate_INTERVIEW
+type LoginResponse struct {
+	AuthenticatedUser    *User    `protobuf:"bytes,1,opt,name=authenticated_user,json=authenticatedUser,proto3" json:"authenticated_user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ScoreSheet) GetScoreSheetTemplateId() string {
-	if m != nil {
-		return m.ScoreSheetTemplateId
-	}
-	return ""
+func (m *LoginResponse) Reset()         { *m = LoginResponse{} }
+func (m *LoginResponse) String() string { return proto.CompactTextString(m) }
+func (*LoginResponse) ProtoMessage()    {}
+func (*LoginResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{32}
 }
-
-func (m *ScoreSheet) GetDivisionId() string {
-	if m != nil {
-		return m.DivisionId
-	}
-	return ""
+func (m *LoginResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginResponse.Unmarshal(m, b)
+}
+func (m *LoginResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginResponse.Marshal(b, m, deterministic)
+}
+func (dst *LoginResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginResponse.Merge(dst, src)
+}
+func (m *LoginResponse) XXX_Size() int {
+	return xxx_messageInfo_LoginResponse.Size(m)
+}
+func (m *LoginResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginResponse.DiscardUnknown(m)
 }
 
-func (m *ScoreSheet) GetTotal() float64 {
+var xxx_messageInfo_LoginResponse proto.InternalMessageInfo
+
+func (m *LoginResponse) GetAuthenticatedUser() *User {
 	if m != nil {
-		return m.Total
+		return m.AuthenticatedUser
 	}
-	return 0
+	return nil
 }
 
-type ScoreSheet_Timing struct {
-	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+type LogoutRequest struct {
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *ScoreSheet_Timing) Reset()         { *m = ScoreSheet_Timing{} }
-func (m *ScoreSheet_Timing) String() string { return proto.CompactTextString(m) }
-func (*ScoreSheet_Timing) ProtoMessage()    {}
-func (*ScoreSheet_Timing) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{23, 0}
+func (m *LogoutRequest) Reset()         { *m = LogoutRequest{} }
+func (m *LogoutRequest) String() string { return proto.CompactTextString(m) }
+func (*LogoutRequest) ProtoMessage()    {}
+func (*LogoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{33}
 }
-func (m *ScoreSheet_Timing) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_ScoreSheet_Timing.Unmarshal(m, b)
+func (m *LogoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutRequest.Unmarshal(m, b)
 }
-func (m *ScoreSheet_Timing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_ScoreSheet_Timing.Marshal(b, m, deterministic)
+func (m *LogoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutRequest.Marshal(b, m, deterministic)
 }
-func (dst *ScoreSheet_Timing) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_ScoreSheet_Timing.Merge(dst, src)
+func (dst *LogoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutRequest.Merge(dst, src)
 }
-func (m *ScoreSheet_Timing) XXX_Size() int {
-	return xxx_messageInfo_ScoreSheet_Timing.Size(m)
+func (m *LogoutRequest) XXX_Size() int {
+	return xxx_messageInfo_LogoutRequest.Size(m)
 }
-func (m *ScoreSheet_Timing) XXX_DiscardUnknown() {
-	xxx_messageInfo_ScoreSheet_Timing.DiscardUnknown(m)
+func (m *LogoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_ScoreSheet_Timing proto.InternalMessageInfo
+var xxx_messageInfo_LogoutRequest proto.InternalMessageInfo
 
-func (m *ScoreSheet_Timing) GetName() string {
-	if m != nil {
-		return m.Name
-	}
-	return ""
-}
-
-func (m *ScoreSheet_Timing) GetValue() string {
-	if m != nil {
-		return m.Value
-	}
-	return ""
-}
-
-type Checkin struct {
-	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
-	Team                 *Team                `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
-	Agent                *User                `protobuf:"bytes,3,opt,name=agent,proto3" json:"agent,omitempty"`
-	Comments             string               `protobuf:"bytes,4,opt,name=comments,proto3" json:"comments,omitempty"`
-	InTime               *timestamp.Timestamp `protobuf:"bytes,5,opt,name=in_time,json=inTime,proto3" json:"in_time,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
-	XXX_unrecognized     []byte               `json:"-"`
-	XXX_sizecache        int32                `json:"-"`
+type LogoutResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Checkin) Reset()         { *m = Checkin{} }
-func (m *Checkin) String() string { return proto.CompactTextString(m) }
-func (*Checkin) ProtoMessage()    {}
-func (*Checkin) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{24}
+func (m *LogoutResponse) Reset()         { *m = LogoutResponse{} }
+func (m *LogoutResponse) String() string { return proto.CompactTextString(m) }
+func (*LogoutResponse) ProtoMessage()    {}
+func (*LogoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{34}
 }
-func (m *Checkin) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_Checkin.Unmarshal(m, b)
+func (m *LogoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LogoutResponse.Unmarshal(m, b)
 }
-func (m *Checkin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_Checkin.Marshal(b, m, deterministic)
+func (m *LogoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LogoutResponse.Marshal(b, m, deterministic)
 }
-func (dst *Checkin) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_Checkin.Merge(dst, src)
+func (dst *LogoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LogoutResponse.Merge(dst, src)
 }
-func (m *Checkin) XXX_Size() int {
-	return xxx_messageInfo_Checkin.Size(m)
+func (m *LogoutResponse) XXX_Size() int {
+	return xxx_messageInfo_LogoutResponse.Size(m)
 }
-func (m *Checkin) XXX_DiscardUnknown() {
-	xxx_messageInfo_Checkin.DiscardUnknown(m)
+func (m *LogoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LogoutResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_Checkin proto.InternalMessageInfo
+var xxx_messageInfo_LogoutResponse proto.InternalMessageInfo
 
-func (m *Checkin) GetId() string {
-	if m != nil {
-		return m.Id
-	}
-	return ""
+type GetCurrentUserRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *Checkin) GetTeam() *Team {
-	if m != nil {
-		return m.Team
-	}
-	return nil
+func (m *GetCurrentUserRequest) Reset()         { *m = GetCurrentUserRequest{} }
+func (m *GetCurrentUserRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCurrentUserRequest) ProtoMessage()    {}
+func (*GetCurrentUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{35}
 }
-
-func (m *Checkin) GetAgent() *User {
-	if m != nil {
-		return m.Agent
-	}
-	return nil
+func (m *GetCurrentUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCurrentUserRequest.Unmarshal(m, b)
 }
-
-func (m *Checkin) GetComments() string {
-	if m != nil {
-		return m.Comments
-	}
-	return ""
+func (m *GetCurrentUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCurrentUserRequest.Marshal(b, m, deterministic)
 }
-
-func (m *Checkin) GetInTime() *timestamp.Timestamp {
-	if m != nil {
-		return m.InTime
-	}
-	return nil
+func (dst *GetCurrentUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCurrentUserRequest.Merge(dst, src)
+}
+func (m *GetCurrentUserRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCurrentUserRequest.Size(m)
+}
+func (m *GetCurrentUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCurrentUserRequest.DiscardUnknown(m)
 }
 
-type GetScoreSheetRequest struct {
-	ScoreSheetId         string   `protobuf:"bytes,1,opt,name=score_sheet_id,json=scoreSheetId,proto3" json:"score_sheet_id,omitempty"`
+var xxx_messageInfo_GetCurrentUserRequest proto.InternalMessageInfo
+
+type GetCurrentUserResponse struct {
+	AuthenticatedUser    *User    `protobuf:"bytes,1,opt,name=authenticated_user,json=authenticatedUser,proto3" json:"authenticated_user,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetScoreSheetRequest) Reset()         { *m = GetScoreSheetRequest{} }
-func (m *GetScoreSheetRequest) String() string { return proto.CompactTextString(m) }
-func (*GetScoreSheetRequest) ProtoMessage()    {}
-func (*GetScoreSheetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{25}
+func (m *GetCurrentUserResponse) Reset()         { *m = GetCurrentUserResponse{} }
+func (m *GetCurrentUserResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCurrentUserResponse) ProtoMessage()    {}
+func (*GetCurrentUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{36}
 }
-func (m *GetScoreSheetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetScoreSheetRequest.Unmarshal(m, b)
+func (m *GetCurrentUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCurrentUserResponse.Unmarshal(m, b)
 }
-func (m *GetScoreSheetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetScoreSheetRequest.Marshal(b, m, deterministic)
+func (m *GetCurrentUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCurrentUserResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetScoreSheetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetScoreSheetRequest.Merge(dst, src)
+func (dst *GetCurrentUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCurrentUserResponse.Merge(dst, src)
 }
-func (m *GetScoreSheetRequest) XXX_Size() int {
-	return xxx_messageInfo_GetScoreSheetRequest.Size(m)
+func (m *GetCurrentUserResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCurrentUserResponse.Size(m)
 }
-func (m *GetScoreSheetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetScoreSheetRequest.DiscardUnknown(m)
+func (m *GetCurrentUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCurrentUserResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetScoreSheetRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetCurrentUserResponse proto.InternalMessageInfo
 
-func (m *GetScoreSheetRequest) GetScoreSheetId() string {
+func (m *GetCurrentUserResponse) GetAuthenticatedUser() *User {
 	if m != nil {
-		return m.ScoreSheetId
+		return m.AuthenticatedUser
 	}
-	return ""
+	return nil
 }
 
-type GetScoreSheetResponse struct {
-	ScoreSheet           *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type DivisionLadder struct {
+	Division             *Division                     `protobuf:"bytes,1,opt,name=division,proto3" json:"division,omitempty"`
+	Ladder               []*DivisionLadder_LadderEntry `protobuf:"bytes,2,rep,name=ladder,proto3" json:"ladder,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
 }
 
-func (m *GetScoreSheetResponse) Reset()         { *m = GetScoreSheetResponse{} }
-func (m *GetScoreSheetResponse) String() string { return proto.CompactTextString(m) }
-func (*GetScoreSheetResponse) ProtoMessage()    {}
-func (*GetScoreSheetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{26}
+func (m *DivisionLadder) Reset()         { *m = DivisionLadder{} }
+func (m *DivisionLadder) String() string { return proto.CompactTextString(m) }
+func (*DivisionLadder) ProtoMessage()    {}
+func (*DivisionLadder) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{37}
 }
-func (m *GetScoreSheetResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetScoreSheetResponse.Unmarshal(m, b)
+func (m *DivisionLadder) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DivisionLadder.Unmarshal(m, b)
 }
-func (m *GetScoreSheetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetScoreSheetResponse.Marshal(b, m, deterministic)
+func (m *DivisionLadder) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DivisionLadder.Marshal(b, m, deterministic)
 }
-func (dst *GetScoreSheetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetScoreSheetResponse.Merge(dst, src)
+func (dst *DivisionLadder) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DivisionLadder.Merge(dst, src)
 }
-func (m *GetScoreSheetResponse) XXX_Size() int {
-	return xxx_messageInfo_GetScoreSheetResponse.Size(m)
+func (m *DivisionLadder) XXX_Size() int {
+	return xxx_messageInfo_DivisionLadder.Size(m)
 }
-func (m *GetScoreSheetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetScoreSheetResponse.DiscardUnknown(m)
+func (m *DivisionLadder) XXX_DiscardUnknown() {
+	xxx_messageInfo_DivisionLadder.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetScoreSheetResponse proto.InternalMessageInfo
+var xxx_messageInfo_DivisionLadder proto.InternalMessageInfo
 
-func (m *GetScoreSheetResponse) GetScoreSheet() *ScoreSheet {
+func (m *DivisionLadder) GetDivision() *Division {
 	if m != nil {
-		return m.ScoreSheet
+		return m.Division
 	}
 	return nil
 }
 
-type CreateScoreSheetRequest struct {
-	ScoreSheet           *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
-}
-
-func (m *CreateScoreSheetRequest) Reset()         { *m = CreateScoreSheetRequest{} }
-func (m *CreateScoreSheetRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateScoreSheetRequest) ProtoMessage()    {}
-func (*CreateScoreSheetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{27}
-}
-func (m *CreateScoreSheetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateScoreSheetRequest.Unmarshal(m, b)
-}
-func (m *CreateScoreSheetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateScoreSheetRequest.Marshal(b, m, deterministic)
-}
-func (dst *CreateScoreSheetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateScoreSheetRequest.Merge(dst, src)
-}
-func (m *CreateScoreSheetRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateScoreSheetRequest.Size(m)
-}
-func (m *CreateScoreSheetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateScoreSheetRequest.DiscardUnknown(m)
-}
-
-var xxx_messageInfo_CreateScoreSheetRequest proto.InternalMessageInfo
-
-func (m *CreateScoreSheetRequest) GetScoreSheet() *ScoreSheet {
+func (m *DivisionLadder) GetLadder() []*DivisionLadder_LadderEntry {
 	if m != nil {
-		return m.ScoreSheet
+		return m.Ladder
 	}
 	return nil
 }
 
-type CreateScoreSheetResponse struct {
-	ScoreSheet           *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+type DivisionLadder_LadderEntry struct {
+	Team                 *Team                                      `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Rounds               []*DivisionLadder_LadderEntry_RoundAverage `protobuf:"bytes,2,rep,name=rounds,proto3" json:"rounds,omitempty"`
+	InterviewScore       float64                                    `protobuf:"fixed64,3,opt,name=interview_score,json=interviewScore,proto3" json:"interview_score,omitempty"`
+	BestRound            float64                                    `protobuf:"fixed64,4,opt,name=best_round,json=bestRound,proto3" json:"best_round,omitempty"`
+	BestFinal            float64                                    `protobuf:"fixed64,5,opt,name=best_final,json=bestFinal,proto3" json:"best_final,omitempty"`
+	RoundTotal           float64                                    `protobuf:"fixed64,6,opt,name=round_total,json=roundTotal,proto3" json:"round_total,omitempty"`
+	FinalTotal           float64                                    `protobuf:"fixed64,7,opt,name=final_total,json=finalTotal,proto3" json:"final_total,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                                   `json:"-"`
+	XXX_unrecognized     []byte                                     `json:"-"`
+	XXX_sizecache        int32                                      `json:"-"`
 }
 
-func (m *CreateScoreSheetResponse) Reset()         { *m = CreateScoreSheetResponse{} }
-func (m *CreateScoreSheetResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateScoreSheetResponse) ProtoMessage()    {}
-func (*CreateScoreSheetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{28}
+func (m *DivisionLadder_LadderEntry) Reset()         { *m = DivisionLadder_LadderEntry{} }
+func (m *DivisionLadder_LadderEntry) String() string { return proto.CompactTextString(m) }
+func (*DivisionLadder_LadderEntry) ProtoMessage()    {}
+func (*DivisionLadder_LadderEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{37, 0}
 }
-func (m *CreateScoreSheetResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateScoreSheetResponse.Unmarshal(m, b)
+func (m *DivisionLadder_LadderEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DivisionLadder_LadderEntry.Unmarshal(m, b)
 }
-func (m *CreateScoreSheetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateScoreSheetResponse.Marshal(b, m, deterministic)
+func (m *DivisionLadder_LadderEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DivisionLadder_LadderEntry.Marshal(b, m, deterministic)
 }
-func (dst *CreateScoreSheetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateScoreSheetResponse.Merge(dst, src)
+func (dst *DivisionLadder_LadderEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DivisionLadder_LadderEntry.Merge(dst, src)
 }
-func (m *CreateScoreSheetResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateScoreSheetResponse.Size(m)
+func (m *DivisionLadder_LadderEntry) XXX_Size() int {
+	return xxx_messageInfo_DivisionLadder_LadderEntry.Size(m)
 }
-func (m *CreateScoreSheetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateScoreSheetResponse.DiscardUnknown(m)
+func (m *DivisionLadder_LadderEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_DivisionLadder_LadderEntry.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateScoreSheetResponse proto.InternalMessageInfo
+var xxx_messageInfo_DivisionLadder_LadderEntry proto.InternalMessageInfo
 
-func (m *CreateScoreSheetResponse) GetScoreSheet() *ScoreSheet {
+func (m *DivisionLadder_LadderEntry) GetTeam() *Team {
 	if m != nil {
-		return m.ScoreSheet
+		return m.Team
 	}
 	return nil
 }
 
-type UpdateScoreSheetRequest struct {
-	ScoreSheet           *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *DivisionLadder_LadderEntry) GetRounds() []*DivisionLadder_LadderEntry_RoundAverage {
+	if m != nil {
+		return m.Rounds
+	}
+	return nil
 }
 
-func (m *UpdateScoreSheetRequest) Reset()         { *m = UpdateScoreSheetRequest{} }
-func (m *UpdateScoreSheetRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateScoreSheetRequest) ProtoMessage()    {}
-func (*UpdateScoreSheetRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{29}
-}
-func (m *UpdateScoreSheetRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateScoreSheetRequest.Unmarshal(m, b)
-}
-func (m *UpdateScoreSheetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateScoreSheetRequest.Marshal(b, m, deterministic)
-}
-func (dst *UpdateScoreSheetRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateScoreSheetRequest.Merge(dst, src)
-}
-func (m *UpdateScoreSheetRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateScoreSheetRequest.Size(m)
-}
-func (m *UpdateScoreSheetRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateScoreSheetRequest.DiscardUnknown(m)
+func (m *DivisionLadder_LadderEntry) GetInterviewScore() float64 {
+	if m != nil {
+		return m.InterviewScore
+	}
+	return 0
 }
 
-var xxx_messageInfo_UpdateScoreSheetRequest proto.InternalMessageInfo
-
-func (m *UpdateScoreSheetRequest) GetScoreSheet() *ScoreSheet {
+func (m *DivisionLadder_LadderEntry) GetBestRound() float64 {
 	if m != nil {
-		return m.ScoreSheet
+		return m.BestRound
 	}
-	return nil
+	return 0
 }
 
-type UpdateScoreSheetResponse struct {
-	ScoreSheet           *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
-	XXX_unrecognized     []byte      `json:"-"`
-	XXX_sizecache        int32       `json:"-"`
+func (m *DivisionLadder_LadderEntry) GetBestFinal() float64 {
+	if m != nil {
+		return m.BestFinal
+	}
+	return 0
 }
 
-func (m *UpdateScoreSheetResponse) Reset()         { *m = UpdateScoreSheetResponse{} }
-func (m *UpdateScoreSheetResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateScoreSheetResponse) ProtoMessage()    {}
-func (*UpdateScoreSheetResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{30}
-}
-func (m *UpdateScoreSheetResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateScoreSheetResponse.Unmarshal(m, b)
-}
-func (m *UpdateScoreSheetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateScoreSheetResponse.Marshal(b, m, deterministic)
-}
-func (dst *UpdateScoreSheetResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateScoreSheetResponse.Merge(dst, src)
-}
-func (m *UpdateScoreSheetResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateScoreSheetResponse.Size(m)
-}
-func (m *UpdateScoreSheetResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateScoreSheetResponse.DiscardUnknown(m)
+func (m *DivisionLadder_LadderEntry) GetRoundTotal() float64 {
+	if m != nil {
+		return m.RoundTotal
+	}
+	return 0
 }
 
-var xxx_messageInfo_UpdateScoreSheetResponse proto.InternalMessageInfo
-
-func (m *UpdateScoreSheetResponse) GetScoreSheet() *ScoreSheet {
+func (m *DivisionLadder_LadderEntry) GetFinalTotal() float64 {
 	if m != nil {
-		return m.ScoreSheet
+		return m.FinalTotal
 	}
-	return nil
+	return 0
 }
 
-type GetTeamRequest struct {
-	TeamId               string   `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
-	IncludeScoreSheets   bool     `protobuf:"varint,2,opt,name=include_score_sheets,json=includeScoreSheets,proto3" json:"include_score_sheets,omitempty"`
+type DivisionLadder_LadderEntry_RoundAverage struct {
+	Round                int32    `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Average              float64  `protobuf:"fixed64,2,opt,name=average,proto3" json:"average,omitempty"`
+	Count                int32    `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetTeamRequest) Reset()         { *m = GetTeamRequest{} }
-func (m *GetTeamRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTeamRequest) ProtoMessage()    {}
-func (*GetTeamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{31}
+func (m *DivisionLadder_LadderEntry_RoundAverage) Reset() {
+	*m = DivisionLadder_LadderEntry_RoundAverage{}
 }
-func (m *GetTeamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTeamRequest.Unmarshal(m, b)
+func (m *DivisionLadder_LadderEntry_RoundAverage) String() string { return proto.CompactTextString(m) }
+func (*DivisionLadder_LadderEntry_RoundAverage) ProtoMessage()    {}
+func (*DivisionLadder_LadderEntry_RoundAverage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{37, 0, 0}
 }
-func (m *GetTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTeamRequest.Marshal(b, m, deterministic)
+func (m *DivisionLadder_LadderEntry_RoundAverage) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage.Unmarshal(m, b)
 }
-func (dst *GetTeamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTeamRequest.Merge(dst, src)
+func (m *DivisionLadder_LadderEntry_RoundAverage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage.Marshal(b, m, deterministic)
 }
-func (m *GetTeamRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTeamRequest.Size(m)
+func (dst *DivisionLadder_LadderEntry_RoundAverage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage.Merge(dst, src)
 }
-func (m *GetTeamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTeamRequest.DiscardUnknown(m)
+func (m *DivisionLadder_LadderEntry_RoundAverage) XXX_Size() int {
+	return xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage.Size(m)
+}
+func (m *DivisionLadder_LadderEntry_RoundAverage) XXX_DiscardUnknown() {
+	xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetTeamRequest proto.InternalMessageInfo
+var xxx_messageInfo_DivisionLadder_LadderEntry_RoundAverage proto.InternalMessageInfo
 
-func (m *GetTeamRequest) GetTeamId() string {
+func (m *DivisionLadder_LadderEntry_RoundAverage) GetRound() int32 {
 	if m != nil {
-		return m.TeamId
+		return m.Round
 	}
-	return ""
+	return 0
 }
 
-func (m *GetTeamRequest) GetIncludeScoreSheets() bool {
+func (m *DivisionLadder_LadderEntry_RoundAverage) GetAverage() float64 {
 	if m != nil {
-		return m.IncludeScoreSheets
+		return m.Average
 	}
-	return false
+	return 0
 }
 
-type GetTeamResponse struct {
-	Team                 *Team         `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
-	Division             *Division     `protobuf:"bytes,2,opt,name=division,proto3" json:"division,omitempty"`
-	ScoreSheets          []*ScoreSheet `protobuf:"bytes,3,rep,name=score_sheets,json=scoreSheets,proto3" json:"score_sheets,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *DivisionLadder_LadderEntry_RoundAverage) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
 }
 
-func (m *GetTeamResponse) Reset()         { *m = GetTeamResponse{} }
-func (m *GetTeamResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTeamResponse) ProtoMessage()    {}
-func (*GetTeamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{32}
+type GetDanceLadderRequest struct {
+	ShowAll              bool     `protobuf:"varint,1,opt,name=show_all,json=showAll,proto3" json:"show_all,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GetTeamResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTeamResponse.Unmarshal(m, b)
+
+func (m *GetDanceLadderRequest) Reset()         { *m = GetDanceLadderRequest{} }
+func (m *GetDanceLadderRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDanceLadderRequest) ProtoMessage()    {}
+func (*GetDanceLadderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{38}
 }
-func (m *GetTeamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTeamResponse.Marshal(b, m, deterministic)
+func (m *GetDanceLadderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDanceLadderRequest.Unmarshal(m, b)
 }
-func (dst *GetTeamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTeamResponse.Merge(dst, src)
+func (m *GetDanceLadderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDanceLadderRequest.Marshal(b, m, deterministic)
 }
-func (m *GetTeamResponse) XXX_Size() int {
-	return xxx_messageInfo_GetTeamResponse.Size(m)
+func (dst *GetDanceLadderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDanceLadderRequest.Merge(dst, src)
 }
-func (m *GetTeamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTeamResponse.DiscardUnknown(m)
+func (m *GetDanceLadderRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDanceLadderRequest.Size(m)
 }
-
-var xxx_messageInfo_GetTeamResponse proto.InternalMessageInfo
-
-func (m *GetTeamResponse) GetTeam() *Team {
-	if m != nil {
-		return m.Team
-	}
-	return nil
+func (m *GetDanceLadderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDanceLadderRequest.DiscardUnknown(m)
 }
 
-func (m *GetTeamResponse) GetDivision() *Division {
-	if m != nil {
-		return m.Division
-	}
-	return nil
-}
+var xxx_messageInfo_GetDanceLadderRequest proto.InternalMessageInfo
 
-func (m *GetTeamResponse) GetScoreSheets() []*ScoreSheet {
+func (m *GetDanceLadderRequest) GetShowAll() bool {
 	if m != nil {
-		return m.ScoreSheets
+		return m.ShowAll
 	}
-	return nil
+	return false
 }
 
-type CreateTeamRequest struct {
-	Team                 *Team    `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetDanceLadderResponse struct {
+	Divisions            []*DivisionLadder `protobuf:"bytes,1,rep,name=divisions,proto3" json:"divisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *CreateTeamRequest) Reset()         { *m = CreateTeamRequest{} }
-func (m *CreateTeamRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateTeamRequest) ProtoMessage()    {}
-func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{33}
+func (m *GetDanceLadderResponse) Reset()         { *m = GetDanceLadderResponse{} }
+func (m *GetDanceLadderResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDanceLadderResponse) ProtoMessage()    {}
+func (*GetDanceLadderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{39}
 }
-func (m *CreateTeamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateTeamRequest.Unmarshal(m, b)
+func (m *GetDanceLadderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDanceLadderResponse.Unmarshal(m, b)
 }
-func (m *CreateTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateTeamRequest.Marshal(b, m, deterministic)
+func (m *GetDanceLadderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDanceLadderResponse.Marshal(b, m, deterministic)
 }
-func (dst *CreateTeamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTeamRequest.Merge(dst, src)
+func (dst *GetDanceLadderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDanceLadderResponse.Merge(dst, src)
 }
-func (m *CreateTeamRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateTeamRequest.Size(m)
+func (m *GetDanceLadderResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDanceLadderResponse.Size(m)
 }
-func (m *CreateTeamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTeamRequest.DiscardUnknown(m)
+func (m *GetDanceLadderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDanceLadderResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTeamRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetDanceLadderResponse proto.InternalMessageInfo
 
-func (m *CreateTeamRequest) GetTeam() *Team {
+func (m *GetDanceLadderResponse) GetDivisions() []*DivisionLadder {
 	if m != nil {
-		return m.Team
+		return m.Divisions
 	}
 	return nil
 }
 
-type CreateTeamResponse struct {
-	Team                 *Team    `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+type GetDivisionRequest struct {
+	DivisionId           string   `protobuf:"bytes,1,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	IncludeTeams         bool     `protobuf:"varint,2,opt,name=include_teams,json=includeTeams,proto3" json:"include_teams,omitempty"`
+	IncludeTemplates     bool     `protobuf:"varint,3,opt,name=include_templates,json=includeTemplates,proto3" json:"include_templates,omitempty"`
+	AssignedOnly         bool     `protobuf:"varint,4,opt,name=assigned_only,json=assignedOnly,proto3" json:"assigned_only,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateTeamResponse) Reset()         { *m = CreateTeamResponse{} }
-func (m *CreateTeamResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateTeamResponse) ProtoMessage()    {}
-func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{34}
+func (m *GetDivisionRequest) Reset()         { *m = GetDivisionRequest{} }
+func (m *GetDivisionRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDivisionRequest) ProtoMessage()    {}
+func (*GetDivisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{40}
 }
-func (m *CreateTeamResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateTeamResponse.Unmarshal(m, b)
+func (m *GetDivisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDivisionRequest.Unmarshal(m, b)
 }
-func (m *CreateTeamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateTeamResponse.Marshal(b, m, deterministic)
+func (m *GetDivisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDivisionRequest.Marshal(b, m, deterministic)
 }
-func (dst *CreateTeamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateTeamResponse.Merge(dst, src)
+func (dst *GetDivisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDivisionRequest.Merge(dst, src)
 }
-func (m *CreateTeamResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateTeamResponse.Size(m)
+func (m *GetDivisionRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDivisionRequest.Size(m)
 }
-func (m *CreateTeamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateTeamResponse.DiscardUnknown(m)
+func (m *GetDivisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDivisionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateTeamResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetDivisionRequest proto.InternalMessageInfo
 
-func (m *CreateTeamResponse) GetTeam() *Team {
+func (m *GetDivisionRequest) GetDivisionId() string {
 	if m != nil {
-		return m.Team
+		return m.DivisionId
 	}
-	return nil
+	return ""
 }
 
-type GetInstitutionsRequest struct {
-	SearchString         string   `protobuf:"bytes,1,opt,name=search_string,json=searchString,proto3" json:"search_string,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetDivisionRequest) GetIncludeTeams() bool {
+	if m != nil {
+		return m.IncludeTeams
+	}
+	return false
 }
 
-func (m *GetInstitutionsRequest) Reset()         { *m = GetInstitutionsRequest{} }
-func (m *GetInstitutionsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetInstitutionsRequest) ProtoMessage()    {}
-func (*GetInstitutionsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{35}
-}
-func (m *GetInstitutionsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetInstitutionsRequest.Unmarshal(m, b)
-}
-func (m *GetInstitutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetInstitutionsRequest.Marshal(b, m, deterministic)
-}
-func (dst *GetInstitutionsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetInstitutionsRequest.Merge(dst, src)
-}
-func (m *GetInstitutionsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetInstitutionsRequest.Size(m)
-}
-func (m *GetInstitutionsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetInstitutionsRequest.DiscardUnknown(m)
+func (m *GetDivisionRequest) GetIncludeTemplates() bool {
+	if m != nil {
+		return m.IncludeTemplates
+	}
+	return false
 }
 
-var xxx_messageInfo_GetInstitutionsRequest proto.InternalMessageInfo
-
-func (m *GetInstitutionsRequest) GetSearchString() string {
+func (m *GetDivisionRequest) GetAssignedOnly() bool {
 	if m != nil {
-		return m.SearchString
+		return m.AssignedOnly
 	}
-	return ""
+	return false
 }
 
-type GetInstitutionsResponse struct {
-	Institutions         []*Institution `protobuf:"bytes,1,rep,name=institutions,proto3" json:"institutions,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
-	XXX_unrecognized     []byte         `json:"-"`
-	XXX_sizecache        int32          `json:"-"`
+type GetDivisionResponse struct {
+	Division             *Division             `protobuf:"bytes,1,opt,name=division,proto3" json:"division,omitempty"`
+	Teams                []*Team               `protobuf:"bytes,2,rep,name=teams,proto3" json:"teams,omitempty"`
+	Templates            []*ScoreSheetTemplate `protobuf:"bytes,3,rep,name=templates,proto3" json:"templates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
 
-func (m *GetInstitutionsResponse) Reset()         { *m = GetInstitutionsResponse{} }
-func (m *GetInstitutionsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetInstitutionsResponse) ProtoMessage()    {}
-func (*GetInstitutionsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{36}
+func (m *GetDivisionResponse) Reset()         { *m = GetDivisionResponse{} }
+func (m *GetDivisionResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDivisionResponse) ProtoMessage()    {}
+func (*GetDivisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{41}
 }
-func (m *GetInstitutionsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetInstitutionsResponse.Unmarshal(m, b)
+func (m *GetDivisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDivisionResponse.Unmarshal(m, b)
 }
-func (m *GetInstitutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetInstitutionsResponse.Marshal(b, m, deterministic)
+func (m *GetDivisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDivisionResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetInstitutionsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetInstitutionsResponse.Merge(dst, src)
+func (dst *GetDivisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDivisionResponse.Merge(dst, src)
 }
-func (m *GetInstitutionsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetInstitutionsResponse.Size(m)
+func (m *GetDivisionResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDivisionResponse.Size(m)
 }
-func (m *GetInstitutionsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetInstitutionsResponse.DiscardUnknown(m)
+func (m *GetDivisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDivisionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetInstitutionsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetDivisionResponse proto.InternalMessageInfo
 
-func (m *GetInstitutionsResponse) GetInstitutions() []*Institution {
+func (m *GetDivisionResponse) GetDivision() *Division {
 	if m != nil {
-		return m.Institutions
+		return m.Division
 	}
 	return nil
 }
 
-type UpdateTeamRequest struct {
-	Team                 *Team    `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
-
-func (m *UpdateTeamRequest) Reset()         { *m = UpdateTeamRequest{} }
-func (m *UpdateTeamRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateTeamRequest) ProtoMessage()    {}
-func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{37}
-}
-func (m *UpdateTeamRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateTeamRequest.Unmarshal(m, b)
-}
-func (m *UpdateTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateTeamRequest.Marshal(b, m, deterministic)
-}
-func (dst *UpdateTeamRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateTeamRequest.Merge(dst, src)
-}
-func (m *UpdateTeamRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateTeamRequest.Size(m)
-}
-func (m *UpdateTeamRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateTeamRequest.DiscardUnknown(m)
+func (m *GetDivisionResponse) GetTeams() []*Team {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
 }
 
-var xxx_messageInfo_UpdateTeamRequest proto.InternalMessageInfo
-
-func (m *UpdateTeamRequest) GetTeam() *Team {
+func (m *GetDivisionResponse) GetTemplates() []*ScoreSheetTemplate {
 	if m != nil {
-		return m.Team
+		return m.Templates
 	}
 	return nil
 }
 
-type UpdateTeamResponse struct {
-	Team                 *Team    `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ScoreSheetSection struct {
+	Id                   string                             `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Title                string                             `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	Description          string                             `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	MaxValue             int32                              `protobuf:"varint,4,opt,name=max_value,json=maxValue,proto3" json:"max_value,omitempty"`
+	Multiplier           int32                              `protobuf:"varint,5,opt,name=multiplier,proto3" json:"multiplier,omitempty"`
+	SectionId            string                             `protobuf:"bytes,6,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Value                float64                            `protobuf:"fixed64,7,opt,name=value,proto3" json:"value,omitempty"`
+	Kind                 ScoreSheetTemplateSection_Kind     `protobuf:"varint,8,opt,name=kind,proto3,enum=ScoreSheetTemplateSection_Kind" json:"kind,omitempty"`
+	Step                 float64                            `protobuf:"fixed64,9,opt,name=step,proto3" json:"step,omitempty"`
+	Levels               []*ScoreSheetTemplateSection_Level `protobuf:"bytes,10,rep,name=levels,proto3" json:"levels,omitempty"`
+	Points               float64                            `protobuf:"fixed64,11,opt,name=points,proto3" json:"points,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                           `json:"-"`
+	XXX_unrecognized     []byte                             `json:"-"`
+	XXX_sizecache        int32                              `json:"-"`
 }
 
-func (m *UpdateTeamResponse) Reset()         { *m = UpdateTeamResponse{} }
-func (m *UpdateTeamResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateTeamResponse) ProtoMessage()    {}
-func (*UpdateTeamResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{38}
+func (m *ScoreSheetSection) Reset()         { *m = ScoreSheetSection{} }
+func (m *ScoreSheetSection) String() string { return proto.CompactTextString(m) }
+func (*ScoreSheetSection) ProtoMessage()    {}
+func (*ScoreSheetSection) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{42}
 }
-func (m *UpdateTeamResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateTeamResponse.Unmarshal(m, b)
+func (m *ScoreSheetSection) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoreSheetSection.Unmarshal(m, b)
 }
-func (m *UpdateTeamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateTeamResponse.Marshal(b, m, deterministic)
+func (m *ScoreSheetSection) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoreSheetSection.Marshal(b, m, deterministic)
 }
-func (dst *UpdateTeamResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateTeamResponse.Merge(dst, src)
+func (dst *ScoreSheetSection) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreSheetSection.Merge(dst, src)
 }
-func (m *UpdateTeamResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateTeamResponse.Size(m)
+func (m *ScoreSheetSection) XXX_Size() int {
+	return xxx_messageInfo_ScoreSheetSection.Size(m)
 }
-func (m *UpdateTeamResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateTeamResponse.DiscardUnknown(m)
+func (m *ScoreSheetSection) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreSheetSection.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateTeamResponse proto.InternalMessageInfo
+var xxx_messageInfo_ScoreSheetSection proto.InternalMessageInfo
 
-func (m *UpdateTeamResponse) GetTeam() *Team {
+func (m *ScoreSheetSection) GetId() string {
 	if m != nil {
-		return m.Team
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-type GetTeamsRequest struct {
-	PopulateMembers      bool     `protobuf:"varint,1,opt,name=populate_members,json=populateMembers,proto3" json:"populate_members,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ScoreSheetSection) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
 
-func (m *GetTeamsRequest) Reset()         { *m = GetTeamsRequest{} }
-func (m *GetTeamsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetTeamsRequest) ProtoMessage()    {}
-func (*GetTeamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{39}
-}
-func (m *GetTeamsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTeamsRequest.Unmarshal(m, b)
-}
-func (m *GetTeamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTeamsRequest.Marshal(b, m, deterministic)
+func (m *ScoreSheetSection) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
 }
-func (dst *GetTeamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTeamsRequest.Merge(dst, src)
+
+func (m *ScoreSheetSection) GetMaxValue() int32 {
+	if m != nil {
+		return m.MaxValue
+	}
+	return 0
 }
-func (m *GetTeamsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetTeamsRequest.Size(m)
+
+func (m *ScoreSheetSection) GetMultiplier() int32 {
+	if m != nil {
+		return m.Multiplier
+	}
+	return 0
 }
-func (m *GetTeamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTeamsRequest.DiscardUnknown(m)
+
+func (m *ScoreSheetSection) GetSectionId() string {
+	if m != nil {
+		return m.SectionId
+	}
+	return ""
 }
 
-var xxx_messageInfo_GetTeamsRequest proto.InternalMessageInfo
+func (m *ScoreSheetSection) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
 
-func (m *GetTeamsRequest) GetPopulateMembers() bool {
+func (m *ScoreSheetSection) GetKind() ScoreSheetTemplateSection_Kind {
 	if m != nil {
-		return m.PopulateMembers
+		return m.Kind
 	}
-	return false
+	return ScoreSheetTemplateSection_NUMERIC
 }
 
-type GetTeamsResponse struct {
-	Teams                []*Team  `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ScoreSheetSection) GetStep() float64 {
+	if m != nil {
+		return m.Step
+	}
+	return 0
 }
 
-func (m *GetTeamsResponse) Reset()         { *m = GetTeamsResponse{} }
-func (m *GetTeamsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetTeamsResponse) ProtoMessage()    {}
-func (*GetTeamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{40}
-}
-func (m *GetTeamsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetTeamsResponse.Unmarshal(m, b)
-}
-func (m *GetTeamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetTeamsResponse.Marshal(b, m, deterministic)
-}
-func (dst *GetTeamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetTeamsResponse.Merge(dst, src)
-}
-func (m *GetTeamsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetTeamsResponse.Size(m)
-}
-func (m *GetTeamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetTeamsResponse.DiscardUnknown(m)
+func (m *ScoreSheetSection) GetLevels() []*ScoreSheetTemplateSection_Level {
+	if m != nil {
+		return m.Levels
+	}
+	return nil
 }
 
-var xxx_messageInfo_GetTeamsResponse proto.InternalMessageInfo
-
-func (m *GetTeamsResponse) GetTeams() []*Team {
+func (m *ScoreSheetSection) GetPoints() float64 {
 	if m != nil {
-		return m.Teams
+		return m.Points
 	}
-	return nil
+	return 0
 }
 
-type CreateDivisionRequest struct {
-	Division             *Division `protobuf:"bytes,1,opt,name=division,proto3" json:"division,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+type ScoreSheet struct {
+	Id                   string                  `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Comments             string                  `protobuf:"bytes,2,opt,name=comments,proto3" json:"comments,omitempty"`
+	Round                int32                   `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	Team                 *Team                   `protobuf:"bytes,4,opt,name=team,proto3" json:"team,omitempty"`
+	Author               *User                   `protobuf:"bytes,5,opt,name=author,proto3" json:"author,omitempty"`
+	Sections             []*ScoreSheetSection    `protobuf:"bytes,6,rep,name=sections,proto3" json:"sections,omitempty"`
+	Timings              []*ScoreSheet_Timing    `protobuf:"bytes,7,rep,name=timings,proto3" json:"timings,omitempty"`
+	Type                 ScoreSheetTemplate_Type `protobuf:"varint,8,opt,name=type,proto3,enum=ScoreSheetTemplate_Type" json:"type,omitempty"`
+	ScoreSheetTemplateId string                  `protobuf:"bytes,9,opt,name=score_sheet_template_id,json=scoreSheetTemplateId,proto3" json:"score_sheet_template_id,omitempty"`
+	DivisionId           string                  `protobuf:"bytes,10,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	Total                float64                 `protobuf:"fixed64,11,opt,name=total,proto3" json:"total,omitempty"`
+	TemplateVersion      int32                   `protobuf:"varint,12,opt,name=template_version,json=templateVersion,proto3" json:"template_version,omitempty"`
+	Status               ScoreSheet_Status       `protobuf:"varint,13,opt,name=status,proto3,enum=ScoreSheet_Status" json:"status,omitempty"`
+	// version is incremented on every update. Updates must send the version they were read at.
+	Version int32 `protobuf:"varint,14,opt,name=version,proto3" json:"version,omitempty"`
+	// edited_at is when the sheet was last edited on the judge's device, which may be well before
+	// it reached the server.
+	EditedAt             *timestamp.Timestamp `protobuf:"bytes,15,opt,name=edited_at,json=editedAt,proto3" json:"edited_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (m *CreateDivisionRequest) Reset()         { *m = CreateDivisionRequest{} }
-func (m *CreateDivisionRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateDivisionRequest) ProtoMessage()    {}
-func (*CreateDivisionRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{41}
+func (m *ScoreSheet) Reset()         { *m = ScoreSheet{} }
+func (m *ScoreSheet) String() string { return proto.CompactTextString(m) }
+func (*ScoreSheet) ProtoMessage()    {}
+func (*ScoreSheet) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{43}
 }
-func (m *CreateDivisionRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateDivisionRequest.Unmarshal(m, b)
+func (m *ScoreSheet) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoreSheet.Unmarshal(m, b)
 }
-func (m *CreateDivisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateDivisionRequest.Marshal(b, m, deterministic)
+func (m *ScoreSheet) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoreSheet.Marshal(b, m, deterministic)
 }
-func (dst *CreateDivisionRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateDivisionRequest.Merge(dst, src)
+func (dst *ScoreSheet) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreSheet.Merge(dst, src)
 }
-func (m *CreateDivisionRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateDivisionRequest.Size(m)
+func (m *ScoreSheet) XXX_Size() int {
+	return xxx_messageInfo_ScoreSheet.Size(m)
 }
-func (m *CreateDivisionRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateDivisionRequest.DiscardUnknown(m)
+func (m *ScoreSheet) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreSheet.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateDivisionRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScoreSheet proto.InternalMessageInfo
 
-func (m *CreateDivisionRequest) GetDivision() *Division {
+func (m *ScoreSheet) GetId() string {
 	if m != nil {
-		return m.Division
+		return m.Id
 	}
-	return nil
+	return ""
 }
 
-type CreateDivisionResponse struct {
-	Division             *Division `protobuf:"bytes,1,opt,name=division,proto3" json:"division,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
-	XXX_unrecognized     []byte    `json:"-"`
-	XXX_sizecache        int32     `json:"-"`
+func (m *ScoreSheet) GetComments() string {
+	if m != nil {
+		return m.Comments
+	}
+	return ""
 }
 
-func (m *CreateDivisionResponse) Reset()         { *m = CreateDivisionResponse{} }
-func (m *CreateDivisionResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateDivisionResponse) ProtoMessage()    {}
-func (*CreateDivisionResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{42}
-}
-func (m *CreateDivisionResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateDivisionResponse.Unmarshal(m, b)
-}
-func (m *CreateDivisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateDivisionResponse.Marshal(b, m, deterministic)
-}
-func (dst *CreateDivisionResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateDivisionResponse.Merge(dst, src)
-}
-func (m *CreateDivisionResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateDivisionResponse.Size(m)
-}
-func (m *CreateDivisionResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateDivisionResponse.DiscardUnknown(m)
+func (m *ScoreSheet) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
 }
 
-var xxx_messageInfo_CreateDivisionResponse proto.InternalMessageInfo
-
-func (m *CreateDivisionResponse) GetDivision() *Division {
+func (m *ScoreSheet) GetTeam() *Team {
 	if m != nil {
-		return m.Division
+		return m.Team
 	}
 	return nil
 }
 
-type CreateScoreSheetTemplateRequest struct {
-	ScoreSheetTemplate   *ScoreSheetTemplate `protobuf:"bytes,1,opt,name=score_sheet_template,json=scoreSheetTemplate,proto3" json:"score_sheet_template,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *ScoreSheet) GetAuthor() *User {
+	if m != nil {
+		return m.Author
+	}
+	return nil
 }
 
-func (m *CreateScoreSheetTemplateRequest) Reset()         { *m = CreateScoreSheetTemplateRequest{} }
-func (m *CreateScoreSheetTemplateRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateScoreSheetTemplateRequest) ProtoMessage()    {}
-func (*CreateScoreSheetTemplateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{43}
-}
-func (m *CreateScoreSheetTemplateRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateScoreSheetTemplateRequest.Unmarshal(m, b)
-}
-func (m *CreateScoreSheetTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateScoreSheetTemplateRequest.Marshal(b, m, deterministic)
-}
-func (dst *CreateScoreSheetTemplateRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateScoreSheetTemplateRequest.Merge(dst, src)
-}
-func (m *CreateScoreSheetTemplateRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateScoreSheetTemplateRequest.Size(m)
-}
-func (m *CreateScoreSheetTemplateRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateScoreSheetTemplateRequest.DiscardUnknown(m)
+func (m *ScoreSheet) GetSections() []*ScoreSheetSection {
+	if m != nil {
+		return m.Sections
+	}
+	return nil
 }
 
-var xxx_messageInfo_CreateScoreSheetTemplateRequest proto.InternalMessageInfo
-
-func (m *CreateScoreSheetTemplateRequest) GetScoreSheetTemplate() *ScoreSheetTemplate {
+func (m *ScoreSheet) GetTimings() []*ScoreSheet_Timing {
 	if m != nil {
-		return m.ScoreSheetTemplate
+		return m.Timings
 	}
 	return nil
 }
 
-type CreateScoreSheetTemplateResponse struct {
-	ScoreSheetTemplate   *ScoreSheetTemplate `protobuf:"bytes,1,opt,name=score_sheet_template,json=scoreSheetTemplate,proto3" json:"score_sheet_template,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+func (m *ScoreSheet) GetType() ScoreSheetTemplate_Type {
+	if m != nil {
+		return m.Type
+	}
+	return ScoreSheetTemplate_INTERVIEW
 }
 
-func (m *CreateScoreSheetTemplateResponse) Reset()         { *m = CreateScoreSheetTemplateResponse{} }
-func (m *CreateScoreSheetTemplateResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateScoreSheetTemplateResponse) ProtoMessage()    {}
-func (*CreateScoreSheetTemplateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{44}
-}
-func (m *CreateScoreSheetTemplateResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateScoreSheetTemplateResponse.Unmarshal(m, b)
+func (m *ScoreSheet) GetScoreSheetTemplateId() string {
+	if m != nil {
+		return m.ScoreSheetTemplateId
+	}
+	return ""
 }
-func (m *CreateScoreSheetTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateScoreSheetTemplateResponse.Marshal(b, m, deterministic)
+
+func (m *ScoreSheet) GetDivisionId() string {
+	if m != nil {
+		return m.DivisionId
+	}
+	return ""
 }
-func (dst *CreateScoreSheetTemplateResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateScoreSheetTemplateResponse.Merge(dst, src)
+
+func (m *ScoreSheet) GetTotal() float64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
 }
-func (m *CreateScoreSheetTemplateResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateScoreSheetTemplateResponse.Size(m)
+
+func (m *ScoreSheet) GetTemplateVersion() int32 {
+	if m != nil {
+		return m.TemplateVersion
+	}
+	return 0
 }
-func (m *CreateScoreSheetTemplateResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateScoreSheetTemplateResponse.DiscardUnknown(m)
+
+func (m *ScoreSheet) GetStatus() ScoreSheet_Status {
+	if m != nil {
+		return m.Status
+	}
+	return ScoreSheet_DRAFT
 }
 
-var xxx_messageInfo_CreateScoreSheetTemplateResponse proto.InternalMessageInfo
+func (m *ScoreSheet) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
+}
 
-func (m *CreateScoreSheetTemplateResponse) GetScoreSheetTemplate() *ScoreSheetTemplate {
+func (m *ScoreSheet) GetEditedAt() *timestamp.Timestamp {
 	if m != nil {
-		return m.ScoreSheetTemplate
+		return m.EditedAt
 	}
 	return nil
 }
 
-type CreateUserRequest struct {
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+type ScoreSheet_Timing struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Value                string   `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
-func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateUserRequest) ProtoMessage()    {}
-func (*CreateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{45}
-}
-func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateUserRequest.Unmarshal(m, b)
+func (m *ScoreSheet_Timing) Reset()         { *m = ScoreSheet_Timing{} }
+func (m *ScoreSheet_Timing) String() string { return proto.CompactTextString(m) }
+func (*ScoreSheet_Timing) ProtoMessage()    {}
+func (*ScoreSheet_Timing) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{43, 0}
 }
-func (m *CreateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateUserRequest.Marshal(b, m, deterministic)
+func (m *ScoreSheet_Timing) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoreSheet_Timing.Unmarshal(m, b)
 }
-func (dst *CreateUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateUserRequest.Merge(dst, src)
+func (m *ScoreSheet_Timing) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoreSheet_Timing.Marshal(b, m, deterministic)
 }
-func (m *CreateUserRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateUserRequest.Size(m)
+func (dst *ScoreSheet_Timing) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreSheet_Timing.Merge(dst, src)
 }
-func (m *CreateUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateUserRequest.DiscardUnknown(m)
+func (m *ScoreSheet_Timing) XXX_Size() int {
+	return xxx_messageInfo_ScoreSheet_Timing.Size(m)
+}
+func (m *ScoreSheet_Timing) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreSheet_Timing.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScoreSheet_Timing proto.InternalMessageInfo
 
-func (m *CreateUserRequest) GetUser() *User {
+func (m *ScoreSheet_Timing) GetName() string {
 	if m != nil {
-		return m.User
+		return m.Name
 	}
-	return nil
+	return ""
 }
 
-type CreateUserResponse struct {
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ScoreSheet_Timing) GetValue() string {
+	if m != nil {
+		return m.Value
+	}
+	return ""
 }
 
-func (m *CreateUserResponse) Reset()         { *m = CreateUserResponse{} }
-func (m *CreateUserResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateUserResponse) ProtoMessage()    {}
-func (*CreateUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{46}
+type RoundLock struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	DivisionId           string               `protobuf:"bytes,2,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	Round                int32                `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	LockedBy             *User                `protobuf:"bytes,4,opt,name=locked_by,json=lockedBy,proto3" json:"locked_by,omitempty"`
+	LockedAt             *timestamp.Timestamp `protobuf:"bytes,5,opt,name=locked_at,json=lockedAt,proto3" json:"locked_at,omitempty"`
+	UnlockedBy           *User                `protobuf:"bytes,6,opt,name=unlocked_by,json=unlockedBy,proto3" json:"unlocked_by,omitempty"`
+	UnlockedAt           *timestamp.Timestamp `protobuf:"bytes,7,opt,name=unlocked_at,json=unlockedAt,proto3" json:"unlocked_at,omitempty"`
+	UnlockReason         string               `protobuf:"bytes,8,opt,name=unlock_reason,json=unlockReason,proto3" json:"unlock_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
-func (m *CreateUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateUserResponse.Unmarshal(m, b)
+
+func (m *RoundLock) Reset()         { *m = RoundLock{} }
+func (m *RoundLock) String() string { return proto.CompactTextString(m) }
+func (*RoundLock) ProtoMessage()    {}
+func (*RoundLock) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{44}
 }
-func (m *CreateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateUserResponse.Marshal(b, m, deterministic)
+func (m *RoundLock) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RoundLock.Unmarshal(m, b)
 }
-func (dst *CreateUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateUserResponse.Merge(dst, src)
+func (m *RoundLock) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RoundLock.Marshal(b, m, deterministic)
 }
-func (m *CreateUserResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateUserResponse.Size(m)
+func (dst *RoundLock) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoundLock.Merge(dst, src)
 }
-func (m *CreateUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateUserResponse.DiscardUnknown(m)
+func (m *RoundLock) XXX_Size() int {
+	return xxx_messageInfo_RoundLock.Size(m)
+}
+func (m *RoundLock) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoundLock.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_RoundLock proto.InternalMessageInfo
 
-func (m *CreateUserResponse) GetUser() *User {
+func (m *RoundLock) GetId() string {
 	if m != nil {
-		return m.User
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RoundLock) GetDivisionId() string {
+	if m != nil {
+		return m.DivisionId
+	}
+	return ""
+}
+
+func (m *RoundLock) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *RoundLock) GetLockedBy() *User {
+	if m != nil {
+		return m.LockedBy
 	}
 	return nil
 }
 
-type UpdateUserRequest struct {
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+func (m *RoundLock) GetLockedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LockedAt
+	}
+	return nil
+}
+
+func (m *RoundLock) GetUnlockedBy() *User {
+	if m != nil {
+		return m.UnlockedBy
+	}
+	return nil
+}
+
+func (m *RoundLock) GetUnlockedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.UnlockedAt
+	}
+	return nil
+}
+
+func (m *RoundLock) GetUnlockReason() string {
+	if m != nil {
+		return m.UnlockReason
+	}
+	return ""
+}
+
+type LockRoundRequest struct {
+	DivisionId           string   `protobuf:"bytes,1,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	Round                int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *UpdateUserRequest) Reset()         { *m = UpdateUserRequest{} }
-func (m *UpdateUserRequest) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserRequest) ProtoMessage()    {}
-func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{47}
+func (m *LockRoundRequest) Reset()         { *m = LockRoundRequest{} }
+func (m *LockRoundRequest) String() string { return proto.CompactTextString(m) }
+func (*LockRoundRequest) ProtoMessage()    {}
+func (*LockRoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{45}
 }
-func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserRequest.Unmarshal(m, b)
+func (m *LockRoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockRoundRequest.Unmarshal(m, b)
 }
-func (m *UpdateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserRequest.Marshal(b, m, deterministic)
+func (m *LockRoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockRoundRequest.Marshal(b, m, deterministic)
 }
-func (dst *UpdateUserRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserRequest.Merge(dst, src)
+func (dst *LockRoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRoundRequest.Merge(dst, src)
 }
-func (m *UpdateUserRequest) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserRequest.Size(m)
+func (m *LockRoundRequest) XXX_Size() int {
+	return xxx_messageInfo_LockRoundRequest.Size(m)
 }
-func (m *UpdateUserRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserRequest.DiscardUnknown(m)
+func (m *LockRoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRoundRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserRequest proto.InternalMessageInfo
+var xxx_messageInfo_LockRoundRequest proto.InternalMessageInfo
 
-func (m *UpdateUserRequest) GetUser() *User {
+func (m *LockRoundRequest) GetDivisionId() string {
 	if m != nil {
-		return m.User
+		return m.DivisionId
 	}
-	return nil
+	return ""
 }
 
-type UpdateUserResponse struct {
-	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *LockRoundRequest) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
 }
 
-func (m *UpdateUserResponse) Reset()         { *m = UpdateUserResponse{} }
-func (m *UpdateUserResponse) String() string { return proto.CompactTextString(m) }
-func (*UpdateUserResponse) ProtoMessage()    {}
-func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{48}
+type LockRoundResponse struct {
+	RoundLock            *RoundLock `protobuf:"bytes,1,opt,name=round_lock,json=roundLock,proto3" json:"round_lock,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
 }
-func (m *UpdateUserResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_UpdateUserResponse.Unmarshal(m, b)
+
+func (m *LockRoundResponse) Reset()         { *m = LockRoundResponse{} }
+func (m *LockRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*LockRoundResponse) ProtoMessage()    {}
+func (*LockRoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{46}
 }
-func (m *UpdateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_UpdateUserResponse.Marshal(b, m, deterministic)
+func (m *LockRoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LockRoundResponse.Unmarshal(m, b)
 }
-func (dst *UpdateUserResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_UpdateUserResponse.Merge(dst, src)
+func (m *LockRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LockRoundResponse.Marshal(b, m, deterministic)
 }
-func (m *UpdateUserResponse) XXX_Size() int {
-	return xxx_messageInfo_UpdateUserResponse.Size(m)
+func (dst *LockRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LockRoundResponse.Merge(dst, src)
 }
-func (m *UpdateUserResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_UpdateUserResponse.DiscardUnknown(m)
+func (m *LockRoundResponse) XXX_Size() int {
+	return xxx_messageInfo_LockRoundResponse.Size(m)
+}
+func (m *LockRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LockRoundResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_UpdateUserResponse proto.InternalMessageInfo
+var xxx_messageInfo_LockRoundResponse proto.InternalMessageInfo
 
-func (m *UpdateUserResponse) GetUser() *User {
+func (m *LockRoundResponse) GetRoundLock() *RoundLock {
 	if m != nil {
-		return m.User
+		return m.RoundLock
 	}
 	return nil
 }
 
-type GetCheckinsRequest struct {
+type UnlockRoundRequest struct {
+	DivisionId           string   `protobuf:"bytes,1,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	Round                int32    `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	Reason               string   `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetCheckinsRequest) Reset()         { *m = GetCheckinsRequest{} }
-func (m *GetCheckinsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetCheckinsRequest) ProtoMessage()    {}
-func (*GetCheckinsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{49}
+func (m *UnlockRoundRequest) Reset()         { *m = UnlockRoundRequest{} }
+func (m *UnlockRoundRequest) String() string { return proto.CompactTextString(m) }
+func (*UnlockRoundRequest) ProtoMessage()    {}
+func (*UnlockRoundRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{47}
 }
-func (m *GetCheckinsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCheckinsRequest.Unmarshal(m, b)
+func (m *UnlockRoundRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockRoundRequest.Unmarshal(m, b)
 }
-func (m *GetCheckinsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCheckinsRequest.Marshal(b, m, deterministic)
+func (m *UnlockRoundRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockRoundRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetCheckinsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCheckinsRequest.Merge(dst, src)
+func (dst *UnlockRoundRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockRoundRequest.Merge(dst, src)
 }
-func (m *GetCheckinsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetCheckinsRequest.Size(m)
+func (m *UnlockRoundRequest) XXX_Size() int {
+	return xxx_messageInfo_UnlockRoundRequest.Size(m)
 }
-func (m *GetCheckinsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCheckinsRequest.DiscardUnknown(m)
+func (m *UnlockRoundRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockRoundRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCheckinsRequest proto.InternalMessageInfo
+var xxx_messageInfo_UnlockRoundRequest proto.InternalMessageInfo
 
-type GetCheckinsResponse struct {
-	CheckIns             []*Checkin `protobuf:"bytes,1,rep,name=check_ins,json=checkIns,proto3" json:"check_ins,omitempty"`
+func (m *UnlockRoundRequest) GetDivisionId() string {
+	if m != nil {
+		return m.DivisionId
+	}
+	return ""
+}
+
+func (m *UnlockRoundRequest) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *UnlockRoundRequest) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+type UnlockRoundResponse struct {
+	RoundLock            *RoundLock `protobuf:"bytes,1,opt,name=round_lock,json=roundLock,proto3" json:"round_lock,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
 	XXX_unrecognized     []byte     `json:"-"`
 	XXX_sizecache        int32      `json:"-"`
 }
 
-func (m *GetCheckinsResponse) Reset()         { *m = GetCheckinsResponse{} }
-func (m *GetCheckinsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetCheckinsResponse) ProtoMessage()    {}
-func (*GetCheckinsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{50}
+func (m *UnlockRoundResponse) Reset()         { *m = UnlockRoundResponse{} }
+func (m *UnlockRoundResponse) String() string { return proto.CompactTextString(m) }
+func (*UnlockRoundResponse) ProtoMessage()    {}
+func (*UnlockRoundResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{48}
 }
-func (m *GetCheckinsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetCheckinsResponse.Unmarshal(m, b)
+func (m *UnlockRoundResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UnlockRoundResponse.Unmarshal(m, b)
 }
-func (m *GetCheckinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetCheckinsResponse.Marshal(b, m, deterministic)
+func (m *UnlockRoundResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UnlockRoundResponse.Marshal(b, m, deterministic)
 }
-func (dst *GetCheckinsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetCheckinsResponse.Merge(dst, src)
+func (dst *UnlockRoundResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UnlockRoundResponse.Merge(dst, src)
 }
-func (m *GetCheckinsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetCheckinsResponse.Size(m)
+func (m *UnlockRoundResponse) XXX_Size() int {
+	return xxx_messageInfo_UnlockRoundResponse.Size(m)
 }
-func (m *GetCheckinsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetCheckinsResponse.DiscardUnknown(m)
+func (m *UnlockRoundResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UnlockRoundResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetCheckinsResponse proto.InternalMessageInfo
+var xxx_messageInfo_UnlockRoundResponse proto.InternalMessageInfo
 
-func (m *GetCheckinsResponse) GetCheckIns() []*Checkin {
+func (m *UnlockRoundResponse) GetRoundLock() *RoundLock {
 	if m != nil {
-		return m.CheckIns
+		return m.RoundLock
 	}
 	return nil
 }
 
-type CreateCheckinRequest struct {
-	CheckIn              *Checkin `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+type GetRoundLocksRequest struct {
+	DivisionId           string   `protobuf:"bytes,1,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *CreateCheckinRequest) Reset()         { *m = CreateCheckinRequest{} }
-func (m *CreateCheckinRequest) String() string { return proto.CompactTextString(m) }
-func (*CreateCheckinRequest) ProtoMessage()    {}
-func (*CreateCheckinRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{51}
+func (m *GetRoundLocksRequest) Reset()         { *m = GetRoundLocksRequest{} }
+func (m *GetRoundLocksRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRoundLocksRequest) ProtoMessage()    {}
+func (*GetRoundLocksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{49}
 }
-func (m *CreateCheckinRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCheckinRequest.Unmarshal(m, b)
+func (m *GetRoundLocksRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRoundLocksRequest.Unmarshal(m, b)
 }
-func (m *CreateCheckinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCheckinRequest.Marshal(b, m, deterministic)
+func (m *GetRoundLocksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRoundLocksRequest.Marshal(b, m, deterministic)
 }
-func (dst *CreateCheckinRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCheckinRequest.Merge(dst, src)
+func (dst *GetRoundLocksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoundLocksRequest.Merge(dst, src)
 }
-func (m *CreateCheckinRequest) XXX_Size() int {
-	return xxx_messageInfo_CreateCheckinRequest.Size(m)
+func (m *GetRoundLocksRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRoundLocksRequest.Size(m)
 }
-func (m *CreateCheckinRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCheckinRequest.DiscardUnknown(m)
+func (m *GetRoundLocksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoundLocksRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCheckinRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetRoundLocksRequest proto.InternalMessageInfo
 
-func (m *CreateCheckinRequest) GetCheckIn() *Checkin {
+func (m *GetRoundLocksRequest) GetDivisionId() string {
 	if m != nil {
-		return m.CheckIn
+		return m.DivisionId
 	}
-	return nil
+	return ""
 }
 
-type CreateCheckinResponse struct {
-	CheckIn              *Checkin `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetRoundLocksResponse struct {
+	RoundLocks           []*RoundLock `protobuf:"bytes,1,rep,name=round_locks,json=roundLocks,proto3" json:"round_locks,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
 }
 
-func (m *CreateCheckinResponse) Reset()         { *m = CreateCheckinResponse{} }
-func (m *CreateCheckinResponse) String() string { return proto.CompactTextString(m) }
-func (*CreateCheckinResponse) ProtoMessage()    {}
-func (*CreateCheckinResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{52}
+func (m *GetRoundLocksResponse) Reset()         { *m = GetRoundLocksResponse{} }
+func (m *GetRoundLocksResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRoundLocksResponse) ProtoMessage()    {}
+func (*GetRoundLocksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{50}
 }
-func (m *CreateCheckinResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_CreateCheckinResponse.Unmarshal(m, b)
+func (m *GetRoundLocksResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRoundLocksResponse.Unmarshal(m, b)
 }
-func (m *CreateCheckinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_CreateCheckinResponse.Marshal(b, m, deterministic)
+func (m *GetRoundLocksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRoundLocksResponse.Marshal(b, m, deterministic)
 }
-func (dst *CreateCheckinResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_CreateCheckinResponse.Merge(dst, src)
+func (dst *GetRoundLocksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRoundLocksResponse.Merge(dst, src)
 }
-func (m *CreateCheckinResponse) XXX_Size() int {
-	return xxx_messageInfo_CreateCheckinResponse.Size(m)
+func (m *GetRoundLocksResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRoundLocksResponse.Size(m)
 }
-func (m *CreateCheckinResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_CreateCheckinResponse.DiscardUnknown(m)
+func (m *GetRoundLocksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRoundLocksResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_CreateCheckinResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetRoundLocksResponse proto.InternalMessageInfo
 
-func (m *CreateCheckinResponse) GetCheckIn() *Checkin {
+func (m *GetRoundLocksResponse) GetRoundLocks() []*RoundLock {
 	if m != nil {
-		return m.CheckIn
+		return m.RoundLocks
 	}
 	return nil
 }
 
-type GetScoreSheetsRequest struct {
-	CurrentUser          bool     `protobuf:"varint,1,opt,name=current_user,json=currentUser,proto3" json:"current_user,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+// ScoreSheetRevision is a score sheet as it was saved. Changes are relative to the previous
+// revision of the sheet.
+type ScoreSheetRevision struct {
+	Id                   string                              `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ScoreSheetId         string                              `protobuf:"bytes,2,opt,name=score_sheet_id,json=scoreSheetId,proto3" json:"score_sheet_id,omitempty"`
+	Version              int32                               `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
+	SavedBy              *User                               `protobuf:"bytes,4,opt,name=saved_by,json=savedBy,proto3" json:"saved_by,omitempty"`
+	SavedAt              *timestamp.Timestamp                `protobuf:"bytes,5,opt,name=saved_at,json=savedAt,proto3" json:"saved_at,omitempty"`
+	ScoreSheet           *ScoreSheet                         `protobuf:"bytes,6,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
+	SectionChanges       []*ScoreSheetRevision_SectionChange `protobuf:"bytes,7,rep,name=section_changes,json=sectionChanges,proto3" json:"section_changes,omitempty"`
+	CommentsChanged      bool                                `protobuf:"varint,8,opt,name=comments_changed,json=commentsChanged,proto3" json:"comments_changed,omitempty"`
+	TimingsChanged       bool                                `protobuf:"varint,9,opt,name=timings_changed,json=timingsChanged,proto3" json:"timings_changed,omitempty"`
+	TeamChanged          bool                                `protobuf:"varint,10,opt,name=team_changed,json=teamChanged,proto3" json:"team_changed,omitempty"`
+	StatusChanged        bool                                `protobuf:"varint,11,opt,name=status_changed,json=statusChanged,proto3" json:"status_changed,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                            `json:"-"`
+	XXX_unrecognized     []byte                              `json:"-"`
+	XXX_sizecache        int32                               `json:"-"`
 }
 
-func (m *GetScoreSheetsRequest) Reset()         { *m = GetScoreSheetsRequest{} }
-func (m *GetScoreSheetsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetScoreSheetsRequest) ProtoMessage()    {}
-func (*GetScoreSheetsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{53}
+func (m *ScoreSheetRevision) Reset()         { *m = ScoreSheetRevision{} }
+func (m *ScoreSheetRevision) String() string { return proto.CompactTextString(m) }
+func (*ScoreSheetRevision) ProtoMessage()    {}
+func (*ScoreSheetRevision) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{51}
 }
-func (m *GetScoreSheetsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetScoreSheetsRequest.Unmarshal(m, b)
+func (m *ScoreSheetRevision) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoreSheetRevision.Unmarshal(m, b)
 }
-func (m *GetScoreSheetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetScoreSheetsRequest.Marshal(b, m, deterministic)
+func (m *ScoreSheetRevision) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoreSheetRevision.Marshal(b, m, deterministic)
 }
-func (dst *GetScoreSheetsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetScoreSheetsRequest.Merge(dst, src)
+func (dst *ScoreSheetRevision) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreSheetRevision.Merge(dst, src)
 }
-func (m *GetScoreSheetsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetScoreSheetsRequest.Size(m)
+func (m *ScoreSheetRevision) XXX_Size() int {
+	return xxx_messageInfo_ScoreSheetRevision.Size(m)
 }
-func (m *GetScoreSheetsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetScoreSheetsRequest.DiscardUnknown(m)
+func (m *ScoreSheetRevision) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreSheetRevision.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetScoreSheetsRequest proto.InternalMessageInfo
+var xxx_messageInfo_ScoreSheetRevision proto.InternalMessageInfo
 
-func (m *GetScoreSheetsRequest) GetCurrentUser() bool {
+func (m *ScoreSheetRevision) GetId() string {
 	if m != nil {
-		return m.CurrentUser
+		return m.Id
 	}
-	return false
+	return ""
 }
 
-type GetScoreSheetsResponse struct {
-	ScoreSheets          []*ScoreSheet `protobuf:"bytes,1,rep,name=score_sheets,json=scoreSheets,proto3" json:"score_sheets,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
-	XXX_unrecognized     []byte        `json:"-"`
-	XXX_sizecache        int32         `json:"-"`
+func (m *ScoreSheetRevision) GetScoreSheetId() string {
+	if m != nil {
+		return m.ScoreSheetId
+	}
+	return ""
 }
 
-func (m *GetScoreSheetsResponse) Reset()         { *m = GetScoreSheetsResponse{} }
-func (m *GetScoreSheetsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetScoreSheetsResponse) ProtoMessage()    {}
-func (*GetScoreSheetsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{54}
-}
-func (m *GetScoreSheetsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetScoreSheetsResponse.Unmarshal(m, b)
-}
-func (m *GetScoreSheetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetScoreSheetsResponse.Marshal(b, m, deterministic)
-}
-func (dst *GetScoreSheetsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetScoreSheetsResponse.Merge(dst, src)
-}
-func (m *GetScoreSheetsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetScoreSheetsResponse.Size(m)
-}
-func (m *GetScoreSheetsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetScoreSheetsResponse.DiscardUnknown(m)
+func (m *ScoreSheetRevision) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
 
-var xxx_messageInfo_GetScoreSheetsResponse proto.InternalMessageInfo
-
-func (m *GetScoreSheetsResponse) GetScoreSheets() []*ScoreSheet {
+func (m *ScoreSheetRevision) GetSavedBy() *User {
 	if m != nil {
-		return m.ScoreSheets
+		return m.SavedBy
 	}
 	return nil
 }
 
-type GetSheetTeamsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ScoreSheetRevision) GetSavedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.SavedAt
+	}
+	return nil
 }
 
-func (m *GetSheetTeamsRequest) Reset()         { *m = GetSheetTeamsRequest{} }
-func (m *GetSheetTeamsRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSheetTeamsRequest) ProtoMessage()    {}
-func (*GetSheetTeamsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{55}
-}
-func (m *GetSheetTeamsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSheetTeamsRequest.Unmarshal(m, b)
+func (m *ScoreSheetRevision) GetScoreSheet() *ScoreSheet {
+	if m != nil {
+		return m.ScoreSheet
+	}
+	return nil
 }
-func (m *GetSheetTeamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSheetTeamsRequest.Marshal(b, m, deterministic)
+
+func (m *ScoreSheetRevision) GetSectionChanges() []*ScoreSheetRevision_SectionChange {
+	if m != nil {
+		return m.SectionChanges
+	}
+	return nil
 }
-func (dst *GetSheetTeamsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSheetTeamsRequest.Merge(dst, src)
+
+func (m *ScoreSheetRevision) GetCommentsChanged() bool {
+	if m != nil {
+		return m.CommentsChanged
+	}
+	return false
 }
-func (m *GetSheetTeamsRequest) XXX_Size() int {
-	return xxx_messageInfo_GetSheetTeamsRequest.Size(m)
+
+func (m *ScoreSheetRevision) GetTimingsChanged() bool {
+	if m != nil {
+		return m.TimingsChanged
+	}
+	return false
 }
-func (m *GetSheetTeamsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSheetTeamsRequest.DiscardUnknown(m)
+
+func (m *ScoreSheetRevision) GetTeamChanged() bool {
+	if m != nil {
+		return m.TeamChanged
+	}
+	return false
 }
 
-var xxx_messageInfo_GetSheetTeamsRequest proto.InternalMessageInfo
+func (m *ScoreSheetRevision) GetStatusChanged() bool {
+	if m != nil {
+		return m.StatusChanged
+	}
+	return false
+}
 
-type GetSheetTeamsResponse struct {
-	Teams                []*Team  `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+type ScoreSheetRevision_SectionChange struct {
+	SectionId            string   `protobuf:"bytes,1,opt,name=section_id,json=sectionId,proto3" json:"section_id,omitempty"`
+	Title                string   `protobuf:"bytes,2,opt,name=title,proto3" json:"title,omitempty"`
+	PreviousValue        float64  `protobuf:"fixed64,3,opt,name=previous_value,json=previousValue,proto3" json:"previous_value,omitempty"`
+	Value                float64  `protobuf:"fixed64,4,opt,name=value,proto3" json:"value,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSheetTeamsResponse) Reset()         { *m = GetSheetTeamsResponse{} }
-func (m *GetSheetTeamsResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSheetTeamsResponse) ProtoMessage()    {}
-func (*GetSheetTeamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{56}
+func (m *ScoreSheetRevision_SectionChange) Reset()         { *m = ScoreSheetRevision_SectionChange{} }
+func (m *ScoreSheetRevision_SectionChange) String() string { return proto.CompactTextString(m) }
+func (*ScoreSheetRevision_SectionChange) ProtoMessage()    {}
+func (*ScoreSheetRevision_SectionChange) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{51, 0}
 }
-func (m *GetSheetTeamsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSheetTeamsResponse.Unmarshal(m, b)
+func (m *ScoreSheetRevision_SectionChange) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoreSheetRevision_SectionChange.Unmarshal(m, b)
 }
-func (m *GetSheetTeamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSheetTeamsResponse.Marshal(b, m, deterministic)
+func (m *ScoreSheetRevision_SectionChange) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoreSheetRevision_SectionChange.Marshal(b, m, deterministic)
 }
-func (dst *GetSheetTeamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSheetTeamsResponse.Merge(dst, src)
+func (dst *ScoreSheetRevision_SectionChange) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreSheetRevision_SectionChange.Merge(dst, src)
 }
-func (m *GetSheetTeamsResponse) XXX_Size() int {
-	return xxx_messageInfo_GetSheetTeamsResponse.Size(m)
+func (m *ScoreSheetRevision_SectionChange) XXX_Size() int {
+	return xxx_messageInfo_ScoreSheetRevision_SectionChange.Size(m)
 }
-func (m *GetSheetTeamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSheetTeamsResponse.DiscardUnknown(m)
+func (m *ScoreSheetRevision_SectionChange) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreSheetRevision_SectionChange.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSheetTeamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_ScoreSheetRevision_SectionChange proto.InternalMessageInfo
 
-func (m *GetSheetTeamsResponse) GetTeams() []*Team {
+func (m *ScoreSheetRevision_SectionChange) GetSectionId() string {
 	if m != nil {
-		return m.Teams
+		return m.SectionId
 	}
-	return nil
+	return ""
 }
 
-type SyncCheckinsRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *ScoreSheetRevision_SectionChange) GetTitle() string {
+	if m != nil {
+		return m.Title
+	}
+	return ""
 }
 
-func (m *SyncCheckinsRequest) Reset()         { *m = SyncCheckinsRequest{} }
-func (m *SyncCheckinsRequest) String() string { return proto.CompactTextString(m) }
-func (*SyncCheckinsRequest) ProtoMessage()    {}
-func (*SyncCheckinsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{57}
-}
-func (m *SyncCheckinsRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SyncCheckinsRequest.Unmarshal(m, b)
-}
-func (m *SyncCheckinsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SyncCheckinsRequest.Marshal(b, m, deterministic)
-}
-func (dst *SyncCheckinsRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncCheckinsRequest.Merge(dst, src)
-}
-func (m *SyncCheckinsRequest) XXX_Size() int {
-	return xxx_messageInfo_SyncCheckinsRequest.Size(m)
-}
-func (m *SyncCheckinsRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncCheckinsRequest.DiscardUnknown(m)
+func (m *ScoreSheetRevision_SectionChange) GetPreviousValue() float64 {
+	if m != nil {
+		return m.PreviousValue
+	}
+	return 0
 }
 
-var xxx_messageInfo_SyncCheckinsRequest proto.InternalMessageInfo
+func (m *ScoreSheetRevision_SectionChange) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
 
-type SyncCheckinsResponse struct {
+type GetScoreSheetHistoryRequest struct {
+	ScoreSheetId         string   `protobuf:"bytes,1,opt,name=score_sheet_id,json=scoreSheetId,proto3" json:"score_sheet_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SyncCheckinsResponse) Reset()         { *m = SyncCheckinsResponse{} }
-func (m *SyncCheckinsResponse) String() string { return proto.CompactTextString(m) }
-func (*SyncCheckinsResponse) ProtoMessage()    {}
-func (*SyncCheckinsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{58}
+func (m *GetScoreSheetHistoryRequest) Reset()         { *m = GetScoreSheetHistoryRequest{} }
+func (m *GetScoreSheetHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*GetScoreSheetHistoryRequest) ProtoMessage()    {}
+func (*GetScoreSheetHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{52}
 }
-func (m *SyncCheckinsResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SyncCheckinsResponse.Unmarshal(m, b)
+func (m *GetScoreSheetHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScoreSheetHistoryRequest.Unmarshal(m, b)
 }
-func (m *SyncCheckinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SyncCheckinsResponse.Marshal(b, m, deterministic)
+func (m *GetScoreSheetHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScoreSheetHistoryRequest.Marshal(b, m, deterministic)
 }
-func (dst *SyncCheckinsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SyncCheckinsResponse.Merge(dst, src)
+func (dst *GetScoreSheetHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScoreSheetHistoryRequest.Merge(dst, src)
 }
-func (m *SyncCheckinsResponse) XXX_Size() int {
-	return xxx_messageInfo_SyncCheckinsResponse.Size(m)
+func (m *GetScoreSheetHistoryRequest) XXX_Size() int {
+	return xxx_messageInfo_GetScoreSheetHistoryRequest.Size(m)
 }
-func (m *SyncCheckinsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SyncCheckinsResponse.DiscardUnknown(m)
+func (m *GetScoreSheetHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScoreSheetHistoryRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SyncCheckinsResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetScoreSheetHistoryRequest proto.InternalMessageInfo
 
-type GetSheetAuthUrlRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetScoreSheetHistoryRequest) GetScoreSheetId() string {
+	if m != nil {
+		return m.ScoreSheetId
+	}
+	return ""
 }
 
-func (m *GetSheetAuthUrlRequest) Reset()         { *m = GetSheetAuthUrlRequest{} }
-func (m *GetSheetAuthUrlRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSheetAuthUrlRequest) ProtoMessage()    {}
-func (*GetSheetAuthUrlRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{59}
+type GetScoreSheetHistoryResponse struct {
+	Revisions            []*ScoreSheetRevision `protobuf:"bytes,1,rep,name=revisions,proto3" json:"revisions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
 }
-func (m *GetSheetAuthUrlRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSheetAuthUrlRequest.Unmarshal(m, b)
+
+func (m *GetScoreSheetHistoryResponse) Reset()         { *m = GetScoreSheetHistoryResponse{} }
+func (m *GetScoreSheetHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*GetScoreSheetHistoryResponse) ProtoMessage()    {}
+func (*GetScoreSheetHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{53}
 }
-func (m *GetSheetAuthUrlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSheetAuthUrlRequest.Marshal(b, m, deterministic)
+func (m *GetScoreSheetHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScoreSheetHistoryResponse.Unmarshal(m, b)
 }
-func (dst *GetSheetAuthUrlRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSheetAuthUrlRequest.Merge(dst, src)
+func (m *GetScoreSheetHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScoreSheetHistoryResponse.Marshal(b, m, deterministic)
 }
-func (m *GetSheetAuthUrlRequest) XXX_Size() int {
-	return xxx_messageInfo_GetSheetAuthUrlRequest.Size(m)
+func (dst *GetScoreSheetHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScoreSheetHistoryResponse.Merge(dst, src)
 }
-func (m *GetSheetAuthUrlRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSheetAuthUrlRequest.DiscardUnknown(m)
+func (m *GetScoreSheetHistoryResponse) XXX_Size() int {
+	return xxx_messageInfo_GetScoreSheetHistoryResponse.Size(m)
+}
+func (m *GetScoreSheetHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScoreSheetHistoryResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSheetAuthUrlRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetScoreSheetHistoryResponse proto.InternalMessageInfo
 
-type GetSheetAuthUrlResponse struct {
-	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+func (m *GetScoreSheetHistoryResponse) GetRevisions() []*ScoreSheetRevision {
+	if m != nil {
+		return m.Revisions
+	}
+	return nil
+}
+
+type RestoreScoreSheetRevisionRequest struct {
+	ScoreSheetId string `protobuf:"bytes,1,opt,name=score_sheet_id,json=scoreSheetId,proto3" json:"score_sheet_id,omitempty"`
+	RevisionId   string `protobuf:"bytes,2,opt,name=revision_id,json=revisionId,proto3" json:"revision_id,omitempty"`
+	// version is the version of the score sheet the restore was requested against.
+	Version              int32    `protobuf:"varint,3,opt,name=version,proto3" json:"version,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *GetSheetAuthUrlResponse) Reset()         { *m = GetSheetAuthUrlResponse{} }
-func (m *GetSheetAuthUrlResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSheetAuthUrlResponse) ProtoMessage()    {}
-func (*GetSheetAuthUrlResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{60}
+func (m *RestoreScoreSheetRevisionRequest) Reset()         { *m = RestoreScoreSheetRevisionRequest{} }
+func (m *RestoreScoreSheetRevisionRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreScoreSheetRevisionRequest) ProtoMessage()    {}
+func (*RestoreScoreSheetRevisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{54}
 }
-func (m *GetSheetAuthUrlResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSheetAuthUrlResponse.Unmarshal(m, b)
+func (m *RestoreScoreSheetRevisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreScoreSheetRevisionRequest.Unmarshal(m, b)
 }
-func (m *GetSheetAuthUrlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSheetAuthUrlResponse.Marshal(b, m, deterministic)
+func (m *RestoreScoreSheetRevisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreScoreSheetRevisionRequest.Marshal(b, m, deterministic)
 }
-func (dst *GetSheetAuthUrlResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSheetAuthUrlResponse.Merge(dst, src)
+func (dst *RestoreScoreSheetRevisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreScoreSheetRevisionRequest.Merge(dst, src)
 }
-func (m *GetSheetAuthUrlResponse) XXX_Size() int {
-	return xxx_messageInfo_GetSheetAuthUrlResponse.Size(m)
+func (m *RestoreScoreSheetRevisionRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreScoreSheetRevisionRequest.Size(m)
 }
-func (m *GetSheetAuthUrlResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSheetAuthUrlResponse.DiscardUnknown(m)
+func (m *RestoreScoreSheetRevisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreScoreSheetRevisionRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSheetAuthUrlResponse proto.InternalMessageInfo
+var xxx_messageInfo_RestoreScoreSheetRevisionRequest proto.InternalMessageInfo
 
-func (m *GetSheetAuthUrlResponse) GetUrl() string {
+func (m *RestoreScoreSheetRevisionRequest) GetScoreSheetId() string {
 	if m != nil {
-		return m.Url
+		return m.ScoreSheetId
 	}
 	return ""
 }
 
-type GetSheetConfigRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *RestoreScoreSheetRevisionRequest) GetRevisionId() string {
+	if m != nil {
+		return m.RevisionId
+	}
+	return ""
 }
 
-func (m *GetSheetConfigRequest) Reset()         { *m = GetSheetConfigRequest{} }
-func (m *GetSheetConfigRequest) String() string { return proto.CompactTextString(m) }
-func (*GetSheetConfigRequest) ProtoMessage()    {}
-func (*GetSheetConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{61}
+func (m *RestoreScoreSheetRevisionRequest) GetVersion() int32 {
+	if m != nil {
+		return m.Version
+	}
+	return 0
 }
-func (m *GetSheetConfigRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSheetConfigRequest.Unmarshal(m, b)
+
+type RestoreScoreSheetRevisionResponse struct {
+	ScoreSheet           *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
-func (m *GetSheetConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSheetConfigRequest.Marshal(b, m, deterministic)
+
+func (m *RestoreScoreSheetRevisionResponse) Reset()         { *m = RestoreScoreSheetRevisionResponse{} }
+func (m *RestoreScoreSheetRevisionResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreScoreSheetRevisionResponse) ProtoMessage()    {}
+func (*RestoreScoreSheetRevisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{55}
 }
-func (dst *GetSheetConfigRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSheetConfigRequest.Merge(dst, src)
+func (m *RestoreScoreSheetRevisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreScoreSheetRevisionResponse.Unmarshal(m, b)
 }
-func (m *GetSheetConfigRequest) XXX_Size() int {
-	return xxx_messageInfo_GetSheetConfigRequest.Size(m)
+func (m *RestoreScoreSheetRevisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreScoreSheetRevisionResponse.Marshal(b, m, deterministic)
 }
-func (m *GetSheetConfigRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSheetConfigRequest.DiscardUnknown(m)
+func (dst *RestoreScoreSheetRevisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreScoreSheetRevisionResponse.Merge(dst, src)
+}
+func (m *RestoreScoreSheetRevisionResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreScoreSheetRevisionResponse.Size(m)
+}
+func (m *RestoreScoreSheetRevisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreScoreSheetRevisionResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSheetConfigRequest proto.InternalMessageInfo
+var xxx_messageInfo_RestoreScoreSheetRevisionResponse proto.InternalMessageInfo
 
-type GetSheetConfigResponse struct {
-	IngestionSpreadsheetId string   `protobuf:"bytes,1,opt,name=ingestion_spreadsheet_id,json=ingestionSpreadsheetId,proto3" json:"ingestion_spreadsheet_id,omitempty"`
-	PushSpreadsheetId      string   `protobuf:"bytes,2,opt,name=push_spreadsheet_id,json=pushSpreadsheetId,proto3" json:"push_spreadsheet_id,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
+func (m *RestoreScoreSheetRevisionResponse) GetScoreSheet() *ScoreSheet {
+	if m != nil {
+		return m.ScoreSheet
+	}
+	return nil
 }
 
-func (m *GetSheetConfigResponse) Reset()         { *m = GetSheetConfigResponse{} }
-func (m *GetSheetConfigResponse) String() string { return proto.CompactTextString(m) }
-func (*GetSheetConfigResponse) ProtoMessage()    {}
-func (*GetSheetConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{62}
+// DuplicateScoreSheets is a set of live sheets by the same author for the same team, round and
+// template.
+type DuplicateScoreSheets struct {
+	Author               *User         `protobuf:"bytes,1,opt,name=author,proto3" json:"author,omitempty"`
+	Team                 *Team         `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	Round                int32         `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	ScoreSheetTemplateId string        `protobuf:"bytes,4,opt,name=score_sheet_template_id,json=scoreSheetTemplateId,proto3" json:"score_sheet_template_id,omitempty"`
+	ScoreSheets          []*ScoreSheet `protobuf:"bytes,5,rep,name=score_sheets,json=scoreSheets,proto3" json:"score_sheets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
-func (m *GetSheetConfigResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GetSheetConfigResponse.Unmarshal(m, b)
+
+func (m *DuplicateScoreSheets) Reset()         { *m = DuplicateScoreSheets{} }
+func (m *DuplicateScoreSheets) String() string { return proto.CompactTextString(m) }
+func (*DuplicateScoreSheets) ProtoMessage()    {}
+func (*DuplicateScoreSheets) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{56}
 }
-func (m *GetSheetConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GetSheetConfigResponse.Marshal(b, m, deterministic)
+func (m *DuplicateScoreSheets) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DuplicateScoreSheets.Unmarshal(m, b)
 }
-func (dst *GetSheetConfigResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GetSheetConfigResponse.Merge(dst, src)
+func (m *DuplicateScoreSheets) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DuplicateScoreSheets.Marshal(b, m, deterministic)
 }
-func (m *GetSheetConfigResponse) XXX_Size() int {
-	return xxx_messageInfo_GetSheetConfigResponse.Size(m)
+func (dst *DuplicateScoreSheets) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DuplicateScoreSheets.Merge(dst, src)
 }
-func (m *GetSheetConfigResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GetSheetConfigResponse.DiscardUnknown(m)
+func (m *DuplicateScoreSheets) XXX_Size() int {
+	return xxx_messageInfo_DuplicateScoreSheets.Size(m)
+}
+func (m *DuplicateScoreSheets) XXX_DiscardUnknown() {
+	xxx_messageInfo_DuplicateScoreSheets.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GetSheetConfigResponse proto.InternalMessageInfo
+var xxx_messageInfo_DuplicateScoreSheets proto.InternalMessageInfo
 
-func (m *GetSheetConfigResponse) GetIngestionSpreadsheetId() string {
+func (m *DuplicateScoreSheets) GetAuthor() *User {
 	if m != nil {
-		return m.IngestionSpreadsheetId
+		return m.Author
 	}
-	return ""
+	return nil
 }
 
-func (m *GetSheetConfigResponse) GetPushSpreadsheetId() string {
+func (m *DuplicateScoreSheets) GetTeam() *Team {
 	if m != nil {
-		return m.PushSpreadsheetId
+		return m.Team
 	}
-	return ""
-}
-
-type SubmitSheetConfigRequest struct {
-	Code                   string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
-	IngestionSpreadsheetId string   `protobuf:"bytes,2,opt,name=ingestion_spreadsheet_id,json=ingestionSpreadsheetId,proto3" json:"ingestion_spreadsheet_id,omitempty"`
-	PushSpreadsheetId      string   `protobuf:"bytes,3,opt,name=push_spreadsheet_id,json=pushSpreadsheetId,proto3" json:"push_spreadsheet_id,omitempty"`
-	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
-	XXX_unrecognized       []byte   `json:"-"`
-	XXX_sizecache          int32    `json:"-"`
-}
-
-func (m *SubmitSheetConfigRequest) Reset()         { *m = SubmitSheetConfigRequest{} }
-func (m *SubmitSheetConfigRequest) String() string { return proto.CompactTextString(m) }
-func (*SubmitSheetConfigRequest) ProtoMessage()    {}
-func (*SubmitSheetConfigRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{63}
-}
-func (m *SubmitSheetConfigRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubmitSheetConfigRequest.Unmarshal(m, b)
-}
-func (m *SubmitSheetConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubmitSheetConfigRequest.Marshal(b, m, deterministic)
-}
-func (dst *SubmitSheetConfigRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubmitSheetConfigRequest.Merge(dst, src)
-}
-func (m *SubmitSheetConfigRequest) XXX_Size() int {
-	return xxx_messageInfo_SubmitSheetConfigRequest.Size(m)
-}
-func (m *SubmitSheetConfigRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubmitSheetConfigRequest.DiscardUnknown(m)
+	return nil
 }
 
-var xxx_messageInfo_SubmitSheetConfigRequest proto.InternalMessageInfo
-
-func (m *SubmitSheetConfigRequest) GetCode() string {
+func (m *DuplicateScoreSheets) GetRound() int32 {
 	if m != nil {
-		return m.Code
+		return m.Round
 	}
-	return ""
+	return 0
 }
 
-func (m *SubmitSheetConfigRequest) GetIngestionSpreadsheetId() string {
+func (m *DuplicateScoreSheets) GetScoreSheetTemplateId() string {
 	if m != nil {
-		return m.IngestionSpreadsheetId
+		return m.ScoreSheetTemplateId
 	}
 	return ""
 }
 
-func (m *SubmitSheetConfigRequest) GetPushSpreadsheetId() string {
+func (m *DuplicateScoreSheets) GetScoreSheets() []*ScoreSheet {
 	if m != nil {
-		return m.PushSpreadsheetId
+		return m.ScoreSheets
 	}
-	return ""
+	return nil
 }
 
-type SubmitSheetConfigResponse struct {
+type GetDuplicateScoreSheetsRequest struct {
+	DivisionId           string   `protobuf:"bytes,1,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *SubmitSheetConfigResponse) Reset()         { *m = SubmitSheetConfigResponse{} }
-func (m *SubmitSheetConfigResponse) String() string { return proto.CompactTextString(m) }
-func (*SubmitSheetConfigResponse) ProtoMessage()    {}
-func (*SubmitSheetConfigResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_robocup_83f7fc6d7f938968, []int{64}
+func (m *GetDuplicateScoreSheetsRequest) Reset()         { *m = GetDuplicateScoreSheetsRequest{} }
+func (m *GetDuplicateScoreSheetsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDuplicateScoreSheetsRequest) ProtoMessage()    {}
+func (*GetDuplicateScoreSheetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{57}
 }
-func (m *SubmitSheetConfigResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_SubmitSheetConfigResponse.Unmarshal(m, b)
+func (m *GetDuplicateScoreSheetsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDuplicateScoreSheetsRequest.Unmarshal(m, b)
 }
-func (m *SubmitSheetConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_SubmitSheetConfigResponse.Marshal(b, m, deterministic)
+func (m *GetDuplicateScoreSheetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDuplicateScoreSheetsRequest.Marshal(b, m, deterministic)
 }
-func (dst *SubmitSheetConfigResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_SubmitSheetConfigResponse.Merge(dst, src)
+func (dst *GetDuplicateScoreSheetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDuplicateScoreSheetsRequest.Merge(dst, src)
 }
-func (m *SubmitSheetConfigResponse) XXX_Size() int {
-	return xxx_messageInfo_SubmitSheetConfigResponse.Size(m)
+func (m *GetDuplicateScoreSheetsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDuplicateScoreSheetsRequest.Size(m)
 }
-func (m *SubmitSheetConfigResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_SubmitSheetConfigResponse.DiscardUnknown(m)
+func (m *GetDuplicateScoreSheetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDuplicateScoreSheetsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_SubmitSheetConfigResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetDuplicateScoreSheetsRequest proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*Division)(nil), "Division")
-	proto.RegisterType((*Institution)(nil), "Institution")
-	proto.RegisterType((*Member)(nil), "Member")
-	proto.RegisterType((*Team)(nil), "Team")
-	proto.RegisterType((*GetDivisionsRequest)(nil), "GetDivisionsRequest")
-	proto.RegisterType((*GetDivisionsResponse)(nil), "GetDivisionsResponse")
-	proto.RegisterType((*User)(nil), "User")
-	proto.RegisterType((*GetUsersRequest)(nil), "GetUsersRequest")
-	proto.RegisterType((*GetUsersResponse)(nil), "GetUsersResponse")
-	proto.RegisterType((*ScoreSheetTemplateSection)(nil), "ScoreSheetTemplateSection")
-	proto.RegisterType((*ScoreSheetTemplate)(nil), "ScoreSheetTemplate")
-	proto.RegisterType((*GetScoreSheetTemplatesRequest)(nil), "GetScoreSheetTemplatesRequest")
-	proto.RegisterType((*GetScoreSheetTemplatesRequest_QueryParameters)(nil), "GetScoreSheetTemplatesRequest.QueryParameters")
-	proto.RegisterType((*GetScoreSheetTemplatesResponse)(nil), "GetScoreSheetTemplatesResponse")
-	proto.RegisterType((*LoginRequest)(nil), "LoginRequest")
-	proto.RegisterType((*LoginResponse)(nil), "LoginResponse")
-	proto.RegisterType((*GetCurrentUserRequest)(nil), "GetCurrentUserRequest")
-	proto.RegisterType((*GetCurrentUserResponse)(nil), "GetCurrentUserResponse")
-	proto.RegisterType((*DivisionLadder)(nil), "DivisionLadder")
-	proto.RegisterType((*DivisionLadder_LadderEntry)(nil), "DivisionLadder.LadderEntry")
-	proto.RegisterType((*DivisionLadder_LadderEntry_RoundAverage)(nil), "DivisionLadder.LadderEntry.RoundAverage")
-	proto.RegisterType((*GetDanceLadderRequest)(nil), "GetDanceLadderRequest")
-	proto.RegisterType((*GetDanceLadderResponse)(nil), "GetDanceLadderResponse")
-	proto.RegisterType((*GetDivisionRequest)(nil), "GetDivisionRequest")
-	proto.RegisterType((*GetDivisionResponse)(nil), "GetDivisionResponse")
-	proto.RegisterType((*ScoreSheetSection)(nil), "ScoreSheetSection")
-	proto.RegisterType((*ScoreSheet)(nil), "ScoreSheet")
-	proto.RegisterType((*ScoreSheet_Timing)(nil), "ScoreSheet.Timing")
-	proto.RegisterType((*Checkin)(nil), "Checkin")
-	proto.RegisterType((*GetScoreSheetRequest)(nil), "GetScoreSheetRequest")
-	proto.RegisterType((*GetScoreSheetResponse)(nil), "GetScoreSheetResponse")
-	proto.RegisterType((*CreateScoreSheetRequest)(nil), "CreateScoreSheetRequest")
-	proto.RegisterType((*CreateScoreSheetResponse)(nil), "CreateScoreSheetResponse")
-	proto.RegisterType((*UpdateScoreSheetRequest)(nil), "UpdateScoreSheetRequest")
-	proto.RegisterType((*UpdateScoreSheetResponse)(nil), "UpdateScoreSheetResponse")
-	proto.RegisterType((*GetTeamRequest)(nil), "GetTeamRequest")
-	proto.RegisterType((*GetTeamResponse)(nil), "GetTeamResponse")
-	proto.RegisterType((*CreateTeamRequest)(nil), "CreateTeamRequest")
-	proto.RegisterType((*CreateTeamResponse)(nil), "CreateTeamResponse")
-	proto.RegisterType((*GetInstitutionsRequest)(nil), "GetInstitutionsRequest")
-	proto.RegisterType((*GetInstitutionsResponse)(nil), "GetInstitutionsResponse")
-	proto.RegisterType((*UpdateTeamRequest)(nil), "UpdateTeamRequest")
-	proto.RegisterType((*UpdateTeamResponse)(nil), "UpdateTeamResponse")
-	proto.RegisterType((*GetTeamsRequest)(nil), "GetTeamsRequest")
-	proto.RegisterType((*GetTeamsResponse)(nil), "GetTeamsResponse")
-	proto.RegisterType((*CreateDivisionRequest)(nil), "CreateDivisionRequest")
-	proto.RegisterType((*CreateDivisionResponse)(nil), "CreateDivisionResponse")
-	proto.RegisterType((*CreateScoreSheetTemplateRequest)(nil), "CreateScoreSheetTemplateRequest")
-	proto.RegisterType((*CreateScoreSheetTemplateResponse)(nil), "CreateScoreSheetTemplateResponse")
-	proto.RegisterType((*CreateUserRequest)(nil), "CreateUserRequest")
-	proto.RegisterType((*CreateUserResponse)(nil), "CreateUserResponse")
-	proto.RegisterType((*UpdateUserRequest)(nil), "UpdateUserRequest")
-	proto.RegisterType((*UpdateUserResponse)(nil), "UpdateUserResponse")
-	proto.RegisterType((*GetCheckinsRequest)(nil), "GetCheckinsRequest")
-	proto.RegisterType((*GetCheckinsResponse)(nil), "GetCheckinsResponse")
-	proto.RegisterType((*CreateCheckinRequest)(nil), "CreateCheckinRequest")
-	proto.RegisterType((*CreateCheckinResponse)(nil), "CreateCheckinResponse")
-	proto.RegisterType((*GetScoreSheetsRequest)(nil), "GetScoreSheetsRequest")
-	proto.RegisterType((*GetScoreSheetsResponse)(nil), "GetScoreSheetsResponse")
-	proto.RegisterType((*GetSheetTeamsRequest)(nil), "GetSheetTeamsRequest")
-	proto.RegisterType((*GetSheetTeamsResponse)(nil), "GetSheetTeamsResponse")
-	proto.RegisterType((*SyncCheckinsRequest)(nil), "SyncCheckinsRequest")
-	proto.RegisterType((*SyncCheckinsResponse)(nil), "SyncCheckinsResponse")
-	proto.RegisterType((*GetSheetAuthUrlRequest)(nil), "GetSheetAuthUrlRequest")
-	proto.RegisterType((*GetSheetAuthUrlResponse)(nil), "GetSheetAuthUrlResponse")
-	proto.RegisterType((*GetSheetConfigRequest)(nil), "GetSheetConfigRequest")
-	proto.RegisterType((*GetSheetConfigResponse)(nil), "GetSheetConfigResponse")
-	proto.RegisterType((*SubmitSheetConfigRequest)(nil), "SubmitSheetConfigRequest")
-	proto.RegisterType((*SubmitSheetConfigResponse)(nil), "SubmitSheetConfigResponse")
-	proto.RegisterEnum("Division_League", Division_League_name, Division_League_value)
-	proto.RegisterEnum("Member_Gender", Member_Gender_name, Member_Gender_value)
-	proto.RegisterEnum("ScoreSheetTemplate_Type", ScoreSheetTemplate_Type_name, ScoreSheetTemplate_Type_value)
+func (m *GetDuplicateScoreSheetsRequest) GetDivisionId() string {
+	if m != nil {
+		return m.DivisionId
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+type GetDuplicateScoreSheetsResponse struct {
+	Duplicates           []*DuplicateScoreSheets `protobuf:"bytes,1,rep,name=duplicates,proto3" json:"duplicates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
 
-// RobocupClient is the client API for Robocup service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type RobocupClient interface {
-	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
-	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error)
-	GetDanceLadder(ctx context.Context, in *GetDanceLadderRequest, opts ...grpc.CallOption) (*GetDanceLadderResponse, error)
-	GetDivision(ctx context.Context, in *GetDivisionRequest, opts ...grpc.CallOption) (*GetDivisionResponse, error)
-	GetScoreSheet(ctx context.Context, in *GetScoreSheetRequest, opts ...grpc.CallOption) (*GetScoreSheetResponse, error)
-	CreateScoreSheet(ctx context.Context, in *CreateScoreSheetRequest, opts ...grpc.CallOption) (*CreateScoreSheetResponse, error)
-	UpdateScoreSheet(ctx context.Context, in *UpdateScoreSheetRequest, opts ...grpc.CallOption) (*UpdateScoreSheetResponse, error)
-	GetDivisions(ctx context.Context, in *GetDivisionsRequest, opts ...grpc.CallOption) (*GetDivisionsResponse, error)
-	GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error)
-	GetScoreSheetTemplates(ctx context.Context, in *GetScoreSheetTemplatesRequest, opts ...grpc.CallOption) (*GetScoreSheetTemplatesResponse, error)
-	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error)
-	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error)
-	GetInstitutions(ctx context.Context, in *GetInstitutionsRequest, opts ...grpc.CallOption) (*GetInstitutionsResponse, error)
-	UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*UpdateTeamResponse, error)
-	GetTeams(ctx context.Context, in *GetTeamsRequest, opts ...grpc.CallOption) (*GetTeamsResponse, error)
-	CreateDivision(ctx context.Context, in *CreateDivisionRequest, opts ...grpc.CallOption) (*CreateDivisionResponse, error)
-	CreateScoreSheetTemplate(ctx context.Context, in *CreateScoreSheetTemplateRequest, opts ...grpc.CallOption) (*CreateScoreSheetTemplateResponse, error)
-	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
-	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
-	GetCheckins(ctx context.Context, in *GetCheckinsRequest, opts ...grpc.CallOption) (*GetCheckinsResponse, error)
-	CreateCheckin(ctx context.Context, in *CreateCheckinRequest, opts ...grpc.CallOption) (*CreateCheckinResponse, error)
-	GetScoreSheets(ctx context.Context, in *GetScoreSheetsRequest, opts ...grpc.CallOption) (*GetScoreSheetsResponse, error)
-	GetSheetTeams(ctx context.Context, in *GetSheetTeamsRequest, opts ...grpc.CallOption) (*GetSheetTeamsResponse, error)
-	SyncCheckins(ctx context.Context, in *SyncCheckinsRequest, opts ...grpc.CallOption) (*SyncCheckinsResponse, error)
-	GetSheetAuthUrl(ctx context.Context, in *GetSheetAuthUrlRequest, opts ...grpc.CallOption) (*GetSheetAuthUrlResponse, error)
-	GetSheetConfig(ctx context.Context, in *GetSheetConfigRequest, opts ...grpc.CallOption) (*GetSheetConfigResponse, error)
-	SubmitSheetConfig(ctx context.Context, in *SubmitSheetConfigRequest, opts ...grpc.CallOption) (*SubmitSheetConfigResponse, error)
+func (m *GetDuplicateScoreSheetsResponse) Reset()         { *m = GetDuplicateScoreSheetsResponse{} }
+func (m *GetDuplicateScoreSheetsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDuplicateScoreSheetsResponse) ProtoMessage()    {}
+func (*GetDuplicateScoreSheetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{58}
+}
+func (m *GetDuplicateScoreSheetsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDuplicateScoreSheetsResponse.Unmarshal(m, b)
+}
+func (m *GetDuplicateScoreSheetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDuplicateScoreSheetsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetDuplicateScoreSheetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDuplicateScoreSheetsResponse.Merge(dst, src)
+}
+func (m *GetDuplicateScoreSheetsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDuplicateScoreSheetsResponse.Size(m)
+}
+func (m *GetDuplicateScoreSheetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDuplicateScoreSheetsResponse.DiscardUnknown(m)
 }
 
-type robocupClient struct {
-	cc *grpc.ClientConn
+var xxx_messageInfo_GetDuplicateScoreSheetsResponse proto.InternalMessageInfo
+
+func (m *GetDuplicateScoreSheetsResponse) GetDuplicates() []*DuplicateScoreSheets {
+	if m != nil {
+		return m.Duplicates
+	}
+	return nil
 }
 
-func NewRobocupClient(cc *grpc.ClientConn) RobocupClient {
-	return &robocupClient{cc}
+type SyncScoreSheetsRequest struct {
+	// cursor is the cursor returned by the previous sync, or empty on a device's first sync.
+	Cursor string `protobuf:"bytes,1,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	// score_sheets are sheets edited on the device. Each has a client generated UUID as its id and
+	// the version it was last synced at, or 0 if it has never reached the server.
+	ScoreSheets          []*ScoreSheet `protobuf:"bytes,2,rep,name=score_sheets,json=scoreSheets,proto3" json:"score_sheets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *robocupClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
-	out := new(LoginResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/Login", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SyncScoreSheetsRequest) Reset()         { *m = SyncScoreSheetsRequest{} }
+func (m *SyncScoreSheetsRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncScoreSheetsRequest) ProtoMessage()    {}
+func (*SyncScoreSheetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{59}
+}
+func (m *SyncScoreSheetsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncScoreSheetsRequest.Unmarshal(m, b)
+}
+func (m *SyncScoreSheetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncScoreSheetsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SyncScoreSheetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncScoreSheetsRequest.Merge(dst, src)
+}
+func (m *SyncScoreSheetsRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncScoreSheetsRequest.Size(m)
+}
+func (m *SyncScoreSheetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncScoreSheetsRequest.DiscardUnknown(m)
 }
 
-func (c *robocupClient) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error) {
-	out := new(GetCurrentUserResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetCurrentUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SyncScoreSheetsRequest proto.InternalMessageInfo
+
+func (m *SyncScoreSheetsRequest) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *robocupClient) GetDanceLadder(ctx context.Context, in *GetDanceLadderRequest, opts ...grpc.CallOption) (*GetDanceLadderResponse, error) {
-	out := new(GetDanceLadderResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetDanceLadder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SyncScoreSheetsRequest) GetScoreSheets() []*ScoreSheet {
+	if m != nil {
+		return m.ScoreSheets
 	}
-	return out, nil
+	return nil
 }
 
-func (c *robocupClient) GetDivision(ctx context.Context, in *GetDivisionRequest, opts ...grpc.CallOption) (*GetDivisionResponse, error) {
-	out := new(GetDivisionResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetDivision", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type ScoreSheetSyncResult struct {
+	ScoreSheetId string                       `protobuf:"bytes,1,opt,name=score_sheet_id,json=scoreSheetId,proto3" json:"score_sheet_id,omitempty"`
+	Outcome      ScoreSheetSyncResult_Outcome `protobuf:"varint,2,opt,name=outcome,proto3,enum=ScoreSheetSyncResult_Outcome" json:"outcome,omitempty"`
+	// reason explains why the sheet was rejected or conflicted.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// score_sheet is the sheet as saved when accepted, and the server's copy when conflicted.
+	ScoreSheet           *ScoreSheet `protobuf:"bytes,4,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
 }
 
-func (c *robocupClient) GetScoreSheet(ctx context.Context, in *GetScoreSheetRequest, opts ...grpc.CallOption) (*GetScoreSheetResponse, error) {
-	out := new(GetScoreSheetResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetScoreSheet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ScoreSheetSyncResult) Reset()         { *m = ScoreSheetSyncResult{} }
+func (m *ScoreSheetSyncResult) String() string { return proto.CompactTextString(m) }
+func (*ScoreSheetSyncResult) ProtoMessage()    {}
+func (*ScoreSheetSyncResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{60}
 }
-
-func (c *robocupClient) CreateScoreSheet(ctx context.Context, in *CreateScoreSheetRequest, opts ...grpc.CallOption) (*CreateScoreSheetResponse, error) {
-	out := new(CreateScoreSheetResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/CreateScoreSheet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ScoreSheetSyncResult) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoreSheetSyncResult.Unmarshal(m, b)
 }
-
-func (c *robocupClient) UpdateScoreSheet(ctx context.Context, in *UpdateScoreSheetRequest, opts ...grpc.CallOption) (*UpdateScoreSheetResponse, error) {
-	out := new(UpdateScoreSheetResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/UpdateScoreSheet", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ScoreSheetSyncResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoreSheetSyncResult.Marshal(b, m, deterministic)
 }
-
-func (c *robocupClient) GetDivisions(ctx context.Context, in *GetDivisionsRequest, opts ...grpc.CallOption) (*GetDivisionsResponse, error) {
-	out := new(GetDivisionsResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetDivisions", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (dst *ScoreSheetSyncResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreSheetSyncResult.Merge(dst, src)
 }
-
-func (c *robocupClient) GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error) {
-	out := new(GetUsersResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetUsers", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ScoreSheetSyncResult) XXX_Size() int {
+	return xxx_messageInfo_ScoreSheetSyncResult.Size(m)
+}
+func (m *ScoreSheetSyncResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreSheetSyncResult.DiscardUnknown(m)
 }
 
-func (c *robocupClient) GetScoreSheetTemplates(ctx context.Context, in *GetScoreSheetTemplatesRequest, opts ...grpc.CallOption) (*GetScoreSheetTemplatesResponse, error) {
-	out := new(GetScoreSheetTemplatesResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetScoreSheetTemplates", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ScoreSheetSyncResult proto.InternalMessageInfo
+
+func (m *ScoreSheetSyncResult) GetScoreSheetId() string {
+	if m != nil {
+		return m.ScoreSheetId
 	}
-	return out, nil
+	return ""
 }
 
-func (c *robocupClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error) {
-	out := new(GetTeamResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetTeam", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScoreSheetSyncResult) GetOutcome() ScoreSheetSyncResult_Outcome {
+	if m != nil {
+		return m.Outcome
 	}
-	return out, nil
+	return ScoreSheetSyncResult_ACCEPTED
 }
 
-func (c *robocupClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error) {
-	out := new(CreateTeamResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/CreateTeam", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScoreSheetSyncResult) GetReason() string {
+	if m != nil {
+		return m.Reason
 	}
-	return out, nil
+	return ""
 }
 
-func (c *robocupClient) GetInstitutions(ctx context.Context, in *GetInstitutionsRequest, opts ...grpc.CallOption) (*GetInstitutionsResponse, error) {
-	out := new(GetInstitutionsResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetInstitutions", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ScoreSheetSyncResult) GetScoreSheet() *ScoreSheet {
+	if m != nil {
+		return m.ScoreSheet
 	}
-	return out, nil
+	return nil
 }
 
-func (c *robocupClient) UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*UpdateTeamResponse, error) {
-	out := new(UpdateTeamResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/UpdateTeam", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type SyncScoreSheetsResponse struct {
+	Results []*ScoreSheetSyncResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
+	// changes are the user's sheets changed on the server since the cursor. A sheet may be sent
+	// again in a later sync; clients should keep the copy with the highest version.
+	Changes              []*ScoreSheet `protobuf:"bytes,2,rep,name=changes,proto3" json:"changes,omitempty"`
+	DeletedScoreSheetIds []string      `protobuf:"bytes,3,rep,name=deleted_score_sheet_ids,json=deletedScoreSheetIds,proto3" json:"deleted_score_sheet_ids,omitempty"`
+	Cursor               string        `protobuf:"bytes,4,opt,name=cursor,proto3" json:"cursor,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (c *robocupClient) GetTeams(ctx context.Context, in *GetTeamsRequest, opts ...grpc.CallOption) (*GetTeamsResponse, error) {
-	out := new(GetTeamsResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetTeams", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *SyncScoreSheetsResponse) Reset()         { *m = SyncScoreSheetsResponse{} }
+func (m *SyncScoreSheetsResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncScoreSheetsResponse) ProtoMessage()    {}
+func (*SyncScoreSheetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{61}
+}
+func (m *SyncScoreSheetsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncScoreSheetsResponse.Unmarshal(m, b)
+}
+func (m *SyncScoreSheetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncScoreSheetsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SyncScoreSheetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncScoreSheetsResponse.Merge(dst, src)
+}
+func (m *SyncScoreSheetsResponse) XXX_Size() int {
+	return xxx_messageInfo_SyncScoreSheetsResponse.Size(m)
+}
+func (m *SyncScoreSheetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncScoreSheetsResponse.DiscardUnknown(m)
 }
 
-func (c *robocupClient) CreateDivision(ctx context.Context, in *CreateDivisionRequest, opts ...grpc.CallOption) (*CreateDivisionResponse, error) {
-	out := new(CreateDivisionResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/CreateDivision", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_SyncScoreSheetsResponse proto.InternalMessageInfo
+
+func (m *SyncScoreSheetsResponse) GetResults() []*ScoreSheetSyncResult {
+	if m != nil {
+		return m.Results
 	}
-	return out, nil
+	return nil
 }
 
-func (c *robocupClient) CreateScoreSheetTemplate(ctx context.Context, in *CreateScoreSheetTemplateRequest, opts ...grpc.CallOption) (*CreateScoreSheetTemplateResponse, error) {
-	out := new(CreateScoreSheetTemplateResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/CreateScoreSheetTemplate", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SyncScoreSheetsResponse) GetChanges() []*ScoreSheet {
+	if m != nil {
+		return m.Changes
 	}
-	return out, nil
+	return nil
 }
 
-func (c *robocupClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
-	out := new(CreateUserResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/CreateUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SyncScoreSheetsResponse) GetDeletedScoreSheetIds() []string {
+	if m != nil {
+		return m.DeletedScoreSheetIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *robocupClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
-	out := new(UpdateUserResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/UpdateUser", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *SyncScoreSheetsResponse) GetCursor() string {
+	if m != nil {
+		return m.Cursor
 	}
-	return out, nil
+	return ""
 }
 
-func (c *robocupClient) GetCheckins(ctx context.Context, in *GetCheckinsRequest, opts ...grpc.CallOption) (*GetCheckinsResponse, error) {
-	out := new(GetCheckinsResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetCheckins", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type Checkin struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Team                 *Team                `protobuf:"bytes,2,opt,name=team,proto3" json:"team,omitempty"`
+	Agent                *User                `protobuf:"bytes,3,opt,name=agent,proto3" json:"agent,omitempty"`
+	Comments             string               `protobuf:"bytes,4,opt,name=comments,proto3" json:"comments,omitempty"`
+	InTime               *timestamp.Timestamp `protobuf:"bytes,5,opt,name=in_time,json=inTime,proto3" json:"in_time,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
 }
 
-func (c *robocupClient) CreateCheckin(ctx context.Context, in *CreateCheckinRequest, opts ...grpc.CallOption) (*CreateCheckinResponse, error) {
-	out := new(CreateCheckinResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/CreateCheckin", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *Checkin) Reset()         { *m = Checkin{} }
+func (m *Checkin) String() string { return proto.CompactTextString(m) }
+func (*Checkin) ProtoMessage()    {}
+func (*Checkin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{62}
+}
+func (m *Checkin) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Checkin.Unmarshal(m, b)
+}
+func (m *Checkin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Checkin.Marshal(b, m, deterministic)
+}
+func (dst *Checkin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Checkin.Merge(dst, src)
+}
+func (m *Checkin) XXX_Size() int {
+	return xxx_messageInfo_Checkin.Size(m)
+}
+func (m *Checkin) XXX_DiscardUnknown() {
+	xxx_messageInfo_Checkin.DiscardUnknown(m)
 }
 
-func (c *robocupClient) GetScoreSheets(ctx context.Context, in *GetScoreSheetsRequest, opts ...grpc.CallOption) (*GetScoreSheetsResponse, error) {
-	out := new(GetScoreSheetsResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetScoreSheets", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_Checkin proto.InternalMessageInfo
+
+func (m *Checkin) GetId() string {
+	if m != nil {
+		return m.Id
 	}
-	return out, nil
+	return ""
 }
 
-func (c *robocupClient) GetSheetTeams(ctx context.Context, in *GetSheetTeamsRequest, opts ...grpc.CallOption) (*GetSheetTeamsResponse, error) {
-	out := new(GetSheetTeamsResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetSheetTeams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Checkin) GetTeam() *Team {
+	if m != nil {
+		return m.Team
 	}
-	return out, nil
+	return nil
 }
 
-func (c *robocupClient) SyncCheckins(ctx context.Context, in *SyncCheckinsRequest, opts ...grpc.CallOption) (*SyncCheckinsResponse, error) {
-	out := new(SyncCheckinsResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/SyncCheckins", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Checkin) GetAgent() *User {
+	if m != nil {
+		return m.Agent
 	}
-	return out, nil
+	return nil
 }
 
-func (c *robocupClient) GetSheetAuthUrl(ctx context.Context, in *GetSheetAuthUrlRequest, opts ...grpc.CallOption) (*GetSheetAuthUrlResponse, error) {
-	out := new(GetSheetAuthUrlResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetSheetAuthUrl", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Checkin) GetComments() string {
+	if m != nil {
+		return m.Comments
 	}
-	return out, nil
+	return ""
 }
 
-func (c *robocupClient) GetSheetConfig(ctx context.Context, in *GetSheetConfigRequest, opts ...grpc.CallOption) (*GetSheetConfigResponse, error) {
-	out := new(GetSheetConfigResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/GetSheetConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *Checkin) GetInTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.InTime
 	}
-	return out, nil
+	return nil
 }
 
-func (c *robocupClient) SubmitSheetConfig(ctx context.Context, in *SubmitSheetConfigRequest, opts ...grpc.CallOption) (*SubmitSheetConfigResponse, error) {
-	out := new(SubmitSheetConfigResponse)
-	err := c.cc.Invoke(ctx, "/Robocup/SubmitSheetConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetScoreSheetRequest struct {
+	ScoreSheetId         string   `protobuf:"bytes,1,opt,name=score_sheet_id,json=scoreSheetId,proto3" json:"score_sheet_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// RobocupServer is the server API for Robocup service.
-type RobocupServer interface {
+func (m *GetScoreSheetRequest) Reset()         { *m = GetScoreSheetRequest{} }
+func (m *GetScoreSheetRequest) String() string { return proto.CompactTextString(m) }
+func (*GetScoreSheetRequest) ProtoMessage()    {}
+func (*GetScoreSheetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{63}
+}
+func (m *GetScoreSheetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScoreSheetRequest.Unmarshal(m, b)
+}
+func (m *GetScoreSheetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScoreSheetRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetScoreSheetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScoreSheetRequest.Merge(dst, src)
+}
+func (m *GetScoreSheetRequest) XXX_Size() int {
+	return xxx_messageInfo_GetScoreSheetRequest.Size(m)
+}
+func (m *GetScoreSheetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScoreSheetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetScoreSheetRequest proto.InternalMessageInfo
+
+func (m *GetScoreSheetRequest) GetScoreSheetId() string {
+	if m != nil {
+		return m.ScoreSheetId
+	}
+	return ""
+}
+
+type GetScoreSheetResponse struct {
+	ScoreSheet           *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetScoreSheetResponse) Reset()         { *m = GetScoreSheetResponse{} }
+func (m *GetScoreSheetResponse) String() string { return proto.CompactTextString(m) }
+func (*GetScoreSheetResponse) ProtoMessage()    {}
+func (*GetScoreSheetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{64}
+}
+func (m *GetScoreSheetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScoreSheetResponse.Unmarshal(m, b)
+}
+func (m *GetScoreSheetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScoreSheetResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetScoreSheetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScoreSheetResponse.Merge(dst, src)
+}
+func (m *GetScoreSheetResponse) XXX_Size() int {
+	return xxx_messageInfo_GetScoreSheetResponse.Size(m)
+}
+func (m *GetScoreSheetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScoreSheetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetScoreSheetResponse proto.InternalMessageInfo
+
+func (m *GetScoreSheetResponse) GetScoreSheet() *ScoreSheet {
+	if m != nil {
+		return m.ScoreSheet
+	}
+	return nil
+}
+
+type CreateScoreSheetRequest struct {
+	ScoreSheet *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
+	// allow_duplicate lets an admin create a sheet even though the author has already scored the
+	// team in the same round with the same template.
+	AllowDuplicate bool `protobuf:"varint,2,opt,name=allow_duplicate,json=allowDuplicate,proto3" json:"allow_duplicate,omitempty"`
+	// idempotency_key identifies the creation so a retried request returns the originally created
+	// sheet instead of creating another. It may also be sent as idempotency-key metadata.
+	IdempotencyKey       string   `protobuf:"bytes,3,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateScoreSheetRequest) Reset()         { *m = CreateScoreSheetRequest{} }
+func (m *CreateScoreSheetRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateScoreSheetRequest) ProtoMessage()    {}
+func (*CreateScoreSheetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{65}
+}
+func (m *CreateScoreSheetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateScoreSheetRequest.Unmarshal(m, b)
+}
+func (m *CreateScoreSheetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateScoreSheetRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateScoreSheetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScoreSheetRequest.Merge(dst, src)
+}
+func (m *CreateScoreSheetRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateScoreSheetRequest.Size(m)
+}
+func (m *CreateScoreSheetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScoreSheetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateScoreSheetRequest proto.InternalMessageInfo
+
+func (m *CreateScoreSheetRequest) GetScoreSheet() *ScoreSheet {
+	if m != nil {
+		return m.ScoreSheet
+	}
+	return nil
+}
+
+func (m *CreateScoreSheetRequest) GetAllowDuplicate() bool {
+	if m != nil {
+		return m.AllowDuplicate
+	}
+	return false
+}
+
+func (m *CreateScoreSheetRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+type CreateScoreSheetResponse struct {
+	ScoreSheet           *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *CreateScoreSheetResponse) Reset()         { *m = CreateScoreSheetResponse{} }
+func (m *CreateScoreSheetResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateScoreSheetResponse) ProtoMessage()    {}
+func (*CreateScoreSheetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{66}
+}
+func (m *CreateScoreSheetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateScoreSheetResponse.Unmarshal(m, b)
+}
+func (m *CreateScoreSheetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateScoreSheetResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateScoreSheetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScoreSheetResponse.Merge(dst, src)
+}
+func (m *CreateScoreSheetResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateScoreSheetResponse.Size(m)
+}
+func (m *CreateScoreSheetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScoreSheetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateScoreSheetResponse proto.InternalMessageInfo
+
+func (m *CreateScoreSheetResponse) GetScoreSheet() *ScoreSheet {
+	if m != nil {
+		return m.ScoreSheet
+	}
+	return nil
+}
+
+type UpdateScoreSheetRequest struct {
+	ScoreSheet           *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *UpdateScoreSheetRequest) Reset()         { *m = UpdateScoreSheetRequest{} }
+func (m *UpdateScoreSheetRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateScoreSheetRequest) ProtoMessage()    {}
+func (*UpdateScoreSheetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{67}
+}
+func (m *UpdateScoreSheetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateScoreSheetRequest.Unmarshal(m, b)
+}
+func (m *UpdateScoreSheetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateScoreSheetRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateScoreSheetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateScoreSheetRequest.Merge(dst, src)
+}
+func (m *UpdateScoreSheetRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateScoreSheetRequest.Size(m)
+}
+func (m *UpdateScoreSheetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateScoreSheetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateScoreSheetRequest proto.InternalMessageInfo
+
+func (m *UpdateScoreSheetRequest) GetScoreSheet() *ScoreSheet {
+	if m != nil {
+		return m.ScoreSheet
+	}
+	return nil
+}
+
+type UpdateScoreSheetResponse struct {
+	ScoreSheet           *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *UpdateScoreSheetResponse) Reset()         { *m = UpdateScoreSheetResponse{} }
+func (m *UpdateScoreSheetResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateScoreSheetResponse) ProtoMessage()    {}
+func (*UpdateScoreSheetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{68}
+}
+func (m *UpdateScoreSheetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateScoreSheetResponse.Unmarshal(m, b)
+}
+func (m *UpdateScoreSheetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateScoreSheetResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateScoreSheetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateScoreSheetResponse.Merge(dst, src)
+}
+func (m *UpdateScoreSheetResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateScoreSheetResponse.Size(m)
+}
+func (m *UpdateScoreSheetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateScoreSheetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateScoreSheetResponse proto.InternalMessageInfo
+
+func (m *UpdateScoreSheetResponse) GetScoreSheet() *ScoreSheet {
+	if m != nil {
+		return m.ScoreSheet
+	}
+	return nil
+}
+
+type GetTeamRequest struct {
+	TeamId               string   `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	IncludeScoreSheets   bool     `protobuf:"varint,2,opt,name=include_score_sheets,json=includeScoreSheets,proto3" json:"include_score_sheets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTeamRequest) Reset()         { *m = GetTeamRequest{} }
+func (m *GetTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTeamRequest) ProtoMessage()    {}
+func (*GetTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{69}
+}
+func (m *GetTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTeamRequest.Unmarshal(m, b)
+}
+func (m *GetTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTeamRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTeamRequest.Merge(dst, src)
+}
+func (m *GetTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTeamRequest.Size(m)
+}
+func (m *GetTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTeamRequest proto.InternalMessageInfo
+
+func (m *GetTeamRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *GetTeamRequest) GetIncludeScoreSheets() bool {
+	if m != nil {
+		return m.IncludeScoreSheets
+	}
+	return false
+}
+
+type GetTeamResponse struct {
+	Team                 *Team         `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	Division             *Division     `protobuf:"bytes,2,opt,name=division,proto3" json:"division,omitempty"`
+	ScoreSheets          []*ScoreSheet `protobuf:"bytes,3,rep,name=score_sheets,json=scoreSheets,proto3" json:"score_sheets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetTeamResponse) Reset()         { *m = GetTeamResponse{} }
+func (m *GetTeamResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTeamResponse) ProtoMessage()    {}
+func (*GetTeamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{70}
+}
+func (m *GetTeamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTeamResponse.Unmarshal(m, b)
+}
+func (m *GetTeamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTeamResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetTeamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTeamResponse.Merge(dst, src)
+}
+func (m *GetTeamResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTeamResponse.Size(m)
+}
+func (m *GetTeamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTeamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTeamResponse proto.InternalMessageInfo
+
+func (m *GetTeamResponse) GetTeam() *Team {
+	if m != nil {
+		return m.Team
+	}
+	return nil
+}
+
+func (m *GetTeamResponse) GetDivision() *Division {
+	if m != nil {
+		return m.Division
+	}
+	return nil
+}
+
+func (m *GetTeamResponse) GetScoreSheets() []*ScoreSheet {
+	if m != nil {
+		return m.ScoreSheets
+	}
+	return nil
+}
+
+type CreateTeamRequest struct {
+	Team *Team `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	// idempotency_key identifies the creation so a retried request returns the originally created
+	// team instead of creating another. It may also be sent as idempotency-key metadata.
+	IdempotencyKey       string   `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTeamRequest) Reset()         { *m = CreateTeamRequest{} }
+func (m *CreateTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateTeamRequest) ProtoMessage()    {}
+func (*CreateTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{71}
+}
+func (m *CreateTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTeamRequest.Unmarshal(m, b)
+}
+func (m *CreateTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTeamRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTeamRequest.Merge(dst, src)
+}
+func (m *CreateTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateTeamRequest.Size(m)
+}
+func (m *CreateTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTeamRequest proto.InternalMessageInfo
+
+func (m *CreateTeamRequest) GetTeam() *Team {
+	if m != nil {
+		return m.Team
+	}
+	return nil
+}
+
+func (m *CreateTeamRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+type CreateTeamResponse struct {
+	Team                 *Team    `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateTeamResponse) Reset()         { *m = CreateTeamResponse{} }
+func (m *CreateTeamResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateTeamResponse) ProtoMessage()    {}
+func (*CreateTeamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{72}
+}
+func (m *CreateTeamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateTeamResponse.Unmarshal(m, b)
+}
+func (m *CreateTeamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateTeamResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateTeamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateTeamResponse.Merge(dst, src)
+}
+func (m *CreateTeamResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateTeamResponse.Size(m)
+}
+func (m *CreateTeamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateTeamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateTeamResponse proto.InternalMessageInfo
+
+func (m *CreateTeamResponse) GetTeam() *Team {
+	if m != nil {
+		return m.Team
+	}
+	return nil
+}
+
+type GetInstitutionsRequest struct {
+	SearchString         string   `protobuf:"bytes,1,opt,name=search_string,json=searchString,proto3" json:"search_string,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetInstitutionsRequest) Reset()         { *m = GetInstitutionsRequest{} }
+func (m *GetInstitutionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetInstitutionsRequest) ProtoMessage()    {}
+func (*GetInstitutionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{73}
+}
+func (m *GetInstitutionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInstitutionsRequest.Unmarshal(m, b)
+}
+func (m *GetInstitutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInstitutionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetInstitutionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInstitutionsRequest.Merge(dst, src)
+}
+func (m *GetInstitutionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetInstitutionsRequest.Size(m)
+}
+func (m *GetInstitutionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInstitutionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInstitutionsRequest proto.InternalMessageInfo
+
+func (m *GetInstitutionsRequest) GetSearchString() string {
+	if m != nil {
+		return m.SearchString
+	}
+	return ""
+}
+
+type GetInstitutionsResponse struct {
+	Institutions         []*Institution `protobuf:"bytes,1,rep,name=institutions,proto3" json:"institutions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *GetInstitutionsResponse) Reset()         { *m = GetInstitutionsResponse{} }
+func (m *GetInstitutionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetInstitutionsResponse) ProtoMessage()    {}
+func (*GetInstitutionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{74}
+}
+func (m *GetInstitutionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetInstitutionsResponse.Unmarshal(m, b)
+}
+func (m *GetInstitutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetInstitutionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetInstitutionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetInstitutionsResponse.Merge(dst, src)
+}
+func (m *GetInstitutionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetInstitutionsResponse.Size(m)
+}
+func (m *GetInstitutionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetInstitutionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetInstitutionsResponse proto.InternalMessageInfo
+
+func (m *GetInstitutionsResponse) GetInstitutions() []*Institution {
+	if m != nil {
+		return m.Institutions
+	}
+	return nil
+}
+
+type UpdateTeamRequest struct {
+	Team                 *Team    `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateTeamRequest) Reset()         { *m = UpdateTeamRequest{} }
+func (m *UpdateTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateTeamRequest) ProtoMessage()    {}
+func (*UpdateTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{75}
+}
+func (m *UpdateTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTeamRequest.Unmarshal(m, b)
+}
+func (m *UpdateTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTeamRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTeamRequest.Merge(dst, src)
+}
+func (m *UpdateTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateTeamRequest.Size(m)
+}
+func (m *UpdateTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTeamRequest proto.InternalMessageInfo
+
+func (m *UpdateTeamRequest) GetTeam() *Team {
+	if m != nil {
+		return m.Team
+	}
+	return nil
+}
+
+type UpdateTeamResponse struct {
+	Team                 *Team    `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateTeamResponse) Reset()         { *m = UpdateTeamResponse{} }
+func (m *UpdateTeamResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateTeamResponse) ProtoMessage()    {}
+func (*UpdateTeamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{76}
+}
+func (m *UpdateTeamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateTeamResponse.Unmarshal(m, b)
+}
+func (m *UpdateTeamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateTeamResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateTeamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateTeamResponse.Merge(dst, src)
+}
+func (m *UpdateTeamResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateTeamResponse.Size(m)
+}
+func (m *UpdateTeamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateTeamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateTeamResponse proto.InternalMessageInfo
+
+func (m *UpdateTeamResponse) GetTeam() *Team {
+	if m != nil {
+		return m.Team
+	}
+	return nil
+}
+
+type GetTeamsRequest struct {
+	PopulateMembers      bool     `protobuf:"varint,1,opt,name=populate_members,json=populateMembers,proto3" json:"populate_members,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTeamsRequest) Reset()         { *m = GetTeamsRequest{} }
+func (m *GetTeamsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTeamsRequest) ProtoMessage()    {}
+func (*GetTeamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{77}
+}
+func (m *GetTeamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTeamsRequest.Unmarshal(m, b)
+}
+func (m *GetTeamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTeamsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetTeamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTeamsRequest.Merge(dst, src)
+}
+func (m *GetTeamsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTeamsRequest.Size(m)
+}
+func (m *GetTeamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTeamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTeamsRequest proto.InternalMessageInfo
+
+func (m *GetTeamsRequest) GetPopulateMembers() bool {
+	if m != nil {
+		return m.PopulateMembers
+	}
+	return false
+}
+
+type GetTeamsResponse struct {
+	Teams                []*Team  `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTeamsResponse) Reset()         { *m = GetTeamsResponse{} }
+func (m *GetTeamsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTeamsResponse) ProtoMessage()    {}
+func (*GetTeamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{78}
+}
+func (m *GetTeamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTeamsResponse.Unmarshal(m, b)
+}
+func (m *GetTeamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTeamsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetTeamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTeamsResponse.Merge(dst, src)
+}
+func (m *GetTeamsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTeamsResponse.Size(m)
+}
+func (m *GetTeamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTeamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTeamsResponse proto.InternalMessageInfo
+
+func (m *GetTeamsResponse) GetTeams() []*Team {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+type CreateDivisionRequest struct {
+	Division             *Division `protobuf:"bytes,1,opt,name=division,proto3" json:"division,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateDivisionRequest) Reset()         { *m = CreateDivisionRequest{} }
+func (m *CreateDivisionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateDivisionRequest) ProtoMessage()    {}
+func (*CreateDivisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{79}
+}
+func (m *CreateDivisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDivisionRequest.Unmarshal(m, b)
+}
+func (m *CreateDivisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDivisionRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateDivisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDivisionRequest.Merge(dst, src)
+}
+func (m *CreateDivisionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateDivisionRequest.Size(m)
+}
+func (m *CreateDivisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDivisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDivisionRequest proto.InternalMessageInfo
+
+func (m *CreateDivisionRequest) GetDivision() *Division {
+	if m != nil {
+		return m.Division
+	}
+	return nil
+}
+
+type CreateDivisionResponse struct {
+	Division             *Division `protobuf:"bytes,1,opt,name=division,proto3" json:"division,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *CreateDivisionResponse) Reset()         { *m = CreateDivisionResponse{} }
+func (m *CreateDivisionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateDivisionResponse) ProtoMessage()    {}
+func (*CreateDivisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{80}
+}
+func (m *CreateDivisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateDivisionResponse.Unmarshal(m, b)
+}
+func (m *CreateDivisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateDivisionResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateDivisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateDivisionResponse.Merge(dst, src)
+}
+func (m *CreateDivisionResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateDivisionResponse.Size(m)
+}
+func (m *CreateDivisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateDivisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateDivisionResponse proto.InternalMessageInfo
+
+func (m *CreateDivisionResponse) GetDivision() *Division {
+	if m != nil {
+		return m.Division
+	}
+	return nil
+}
+
+type CreateScoreSheetTemplateRequest struct {
+	ScoreSheetTemplate   *ScoreSheetTemplate `protobuf:"bytes,1,opt,name=score_sheet_template,json=scoreSheetTemplate,proto3" json:"score_sheet_template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CreateScoreSheetTemplateRequest) Reset()         { *m = CreateScoreSheetTemplateRequest{} }
+func (m *CreateScoreSheetTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateScoreSheetTemplateRequest) ProtoMessage()    {}
+func (*CreateScoreSheetTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{81}
+}
+func (m *CreateScoreSheetTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateScoreSheetTemplateRequest.Unmarshal(m, b)
+}
+func (m *CreateScoreSheetTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateScoreSheetTemplateRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateScoreSheetTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScoreSheetTemplateRequest.Merge(dst, src)
+}
+func (m *CreateScoreSheetTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateScoreSheetTemplateRequest.Size(m)
+}
+func (m *CreateScoreSheetTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScoreSheetTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateScoreSheetTemplateRequest proto.InternalMessageInfo
+
+func (m *CreateScoreSheetTemplateRequest) GetScoreSheetTemplate() *ScoreSheetTemplate {
+	if m != nil {
+		return m.ScoreSheetTemplate
+	}
+	return nil
+}
+
+type CreateScoreSheetTemplateResponse struct {
+	ScoreSheetTemplate   *ScoreSheetTemplate `protobuf:"bytes,1,opt,name=score_sheet_template,json=scoreSheetTemplate,proto3" json:"score_sheet_template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *CreateScoreSheetTemplateResponse) Reset()         { *m = CreateScoreSheetTemplateResponse{} }
+func (m *CreateScoreSheetTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateScoreSheetTemplateResponse) ProtoMessage()    {}
+func (*CreateScoreSheetTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{82}
+}
+func (m *CreateScoreSheetTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateScoreSheetTemplateResponse.Unmarshal(m, b)
+}
+func (m *CreateScoreSheetTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateScoreSheetTemplateResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateScoreSheetTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateScoreSheetTemplateResponse.Merge(dst, src)
+}
+func (m *CreateScoreSheetTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateScoreSheetTemplateResponse.Size(m)
+}
+func (m *CreateScoreSheetTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateScoreSheetTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateScoreSheetTemplateResponse proto.InternalMessageInfo
+
+func (m *CreateScoreSheetTemplateResponse) GetScoreSheetTemplate() *ScoreSheetTemplate {
+	if m != nil {
+		return m.ScoreSheetTemplate
+	}
+	return nil
+}
+
+type UpdateDivisionRequest struct {
+	Division             *Division `protobuf:"bytes,1,opt,name=division,proto3" json:"division,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *UpdateDivisionRequest) Reset()         { *m = UpdateDivisionRequest{} }
+func (m *UpdateDivisionRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateDivisionRequest) ProtoMessage()    {}
+func (*UpdateDivisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{83}
+}
+func (m *UpdateDivisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDivisionRequest.Unmarshal(m, b)
+}
+func (m *UpdateDivisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDivisionRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateDivisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDivisionRequest.Merge(dst, src)
+}
+func (m *UpdateDivisionRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateDivisionRequest.Size(m)
+}
+func (m *UpdateDivisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDivisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDivisionRequest proto.InternalMessageInfo
+
+func (m *UpdateDivisionRequest) GetDivision() *Division {
+	if m != nil {
+		return m.Division
+	}
+	return nil
+}
+
+type UpdateDivisionResponse struct {
+	Division             *Division `protobuf:"bytes,1,opt,name=division,proto3" json:"division,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *UpdateDivisionResponse) Reset()         { *m = UpdateDivisionResponse{} }
+func (m *UpdateDivisionResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateDivisionResponse) ProtoMessage()    {}
+func (*UpdateDivisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{84}
+}
+func (m *UpdateDivisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateDivisionResponse.Unmarshal(m, b)
+}
+func (m *UpdateDivisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateDivisionResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateDivisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateDivisionResponse.Merge(dst, src)
+}
+func (m *UpdateDivisionResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateDivisionResponse.Size(m)
+}
+func (m *UpdateDivisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateDivisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateDivisionResponse proto.InternalMessageInfo
+
+func (m *UpdateDivisionResponse) GetDivision() *Division {
+	if m != nil {
+		return m.Division
+	}
+	return nil
+}
+
+type UpdateScoreSheetTemplateRequest struct {
+	ScoreSheetTemplate   *ScoreSheetTemplate `protobuf:"bytes,1,opt,name=score_sheet_template,json=scoreSheetTemplate,proto3" json:"score_sheet_template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UpdateScoreSheetTemplateRequest) Reset()         { *m = UpdateScoreSheetTemplateRequest{} }
+func (m *UpdateScoreSheetTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateScoreSheetTemplateRequest) ProtoMessage()    {}
+func (*UpdateScoreSheetTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{85}
+}
+func (m *UpdateScoreSheetTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateScoreSheetTemplateRequest.Unmarshal(m, b)
+}
+func (m *UpdateScoreSheetTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateScoreSheetTemplateRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateScoreSheetTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateScoreSheetTemplateRequest.Merge(dst, src)
+}
+func (m *UpdateScoreSheetTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateScoreSheetTemplateRequest.Size(m)
+}
+func (m *UpdateScoreSheetTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateScoreSheetTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateScoreSheetTemplateRequest proto.InternalMessageInfo
+
+func (m *UpdateScoreSheetTemplateRequest) GetScoreSheetTemplate() *ScoreSheetTemplate {
+	if m != nil {
+		return m.ScoreSheetTemplate
+	}
+	return nil
+}
+
+type UpdateScoreSheetTemplateResponse struct {
+	ScoreSheetTemplate   *ScoreSheetTemplate `protobuf:"bytes,1,opt,name=score_sheet_template,json=scoreSheetTemplate,proto3" json:"score_sheet_template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *UpdateScoreSheetTemplateResponse) Reset()         { *m = UpdateScoreSheetTemplateResponse{} }
+func (m *UpdateScoreSheetTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateScoreSheetTemplateResponse) ProtoMessage()    {}
+func (*UpdateScoreSheetTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{86}
+}
+func (m *UpdateScoreSheetTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateScoreSheetTemplateResponse.Unmarshal(m, b)
+}
+func (m *UpdateScoreSheetTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateScoreSheetTemplateResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateScoreSheetTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateScoreSheetTemplateResponse.Merge(dst, src)
+}
+func (m *UpdateScoreSheetTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateScoreSheetTemplateResponse.Size(m)
+}
+func (m *UpdateScoreSheetTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateScoreSheetTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateScoreSheetTemplateResponse proto.InternalMessageInfo
+
+func (m *UpdateScoreSheetTemplateResponse) GetScoreSheetTemplate() *ScoreSheetTemplate {
+	if m != nil {
+		return m.ScoreSheetTemplate
+	}
+	return nil
+}
+
+type ExportScoreSheetTemplateRequest struct {
+	ScoreSheetTemplateId string         `protobuf:"bytes,1,opt,name=score_sheet_template_id,json=scoreSheetTemplateId,proto3" json:"score_sheet_template_id,omitempty"`
+	Format               TemplateFormat `protobuf:"varint,2,opt,name=format,proto3,enum=TemplateFormat" json:"format,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ExportScoreSheetTemplateRequest) Reset()         { *m = ExportScoreSheetTemplateRequest{} }
+func (m *ExportScoreSheetTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*ExportScoreSheetTemplateRequest) ProtoMessage()    {}
+func (*ExportScoreSheetTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{87}
+}
+func (m *ExportScoreSheetTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportScoreSheetTemplateRequest.Unmarshal(m, b)
+}
+func (m *ExportScoreSheetTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportScoreSheetTemplateRequest.Marshal(b, m, deterministic)
+}
+func (dst *ExportScoreSheetTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportScoreSheetTemplateRequest.Merge(dst, src)
+}
+func (m *ExportScoreSheetTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_ExportScoreSheetTemplateRequest.Size(m)
+}
+func (m *ExportScoreSheetTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportScoreSheetTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportScoreSheetTemplateRequest proto.InternalMessageInfo
+
+func (m *ExportScoreSheetTemplateRequest) GetScoreSheetTemplateId() string {
+	if m != nil {
+		return m.ScoreSheetTemplateId
+	}
+	return ""
+}
+
+func (m *ExportScoreSheetTemplateRequest) GetFormat() TemplateFormat {
+	if m != nil {
+		return m.Format
+	}
+	return TemplateFormat_JSON
+}
+
+type ExportScoreSheetTemplateResponse struct {
+	Document             string   `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	ContentType          string   `protobuf:"bytes,2,opt,name=content_type,json=contentType,proto3" json:"content_type,omitempty"`
+	Filename             string   `protobuf:"bytes,3,opt,name=filename,proto3" json:"filename,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ExportScoreSheetTemplateResponse) Reset()         { *m = ExportScoreSheetTemplateResponse{} }
+func (m *ExportScoreSheetTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*ExportScoreSheetTemplateResponse) ProtoMessage()    {}
+func (*ExportScoreSheetTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{88}
+}
+func (m *ExportScoreSheetTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExportScoreSheetTemplateResponse.Unmarshal(m, b)
+}
+func (m *ExportScoreSheetTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExportScoreSheetTemplateResponse.Marshal(b, m, deterministic)
+}
+func (dst *ExportScoreSheetTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExportScoreSheetTemplateResponse.Merge(dst, src)
+}
+func (m *ExportScoreSheetTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_ExportScoreSheetTemplateResponse.Size(m)
+}
+func (m *ExportScoreSheetTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExportScoreSheetTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ExportScoreSheetTemplateResponse proto.InternalMessageInfo
+
+func (m *ExportScoreSheetTemplateResponse) GetDocument() string {
+	if m != nil {
+		return m.Document
+	}
+	return ""
+}
+
+func (m *ExportScoreSheetTemplateResponse) GetContentType() string {
+	if m != nil {
+		return m.ContentType
+	}
+	return ""
+}
+
+func (m *ExportScoreSheetTemplateResponse) GetFilename() string {
+	if m != nil {
+		return m.Filename
+	}
+	return ""
+}
+
+type ImportScoreSheetTemplateRequest struct {
+	Format   TemplateFormat `protobuf:"varint,1,opt,name=format,proto3,enum=TemplateFormat" json:"format,omitempty"`
+	Document string         `protobuf:"bytes,2,opt,name=document,proto3" json:"document,omitempty"`
+	// Imports a copy of an existing template instead of a document
+	SourceScoreSheetTemplateId string `protobuf:"bytes,3,opt,name=source_score_sheet_template_id,json=sourceScoreSheetTemplateId,proto3" json:"source_score_sheet_template_id,omitempty"`
+	// Replaces the name given in the document or source template
+	Name                 string   `protobuf:"bytes,4,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ImportScoreSheetTemplateRequest) Reset()         { *m = ImportScoreSheetTemplateRequest{} }
+func (m *ImportScoreSheetTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*ImportScoreSheetTemplateRequest) ProtoMessage()    {}
+func (*ImportScoreSheetTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{89}
+}
+func (m *ImportScoreSheetTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportScoreSheetTemplateRequest.Unmarshal(m, b)
+}
+func (m *ImportScoreSheetTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportScoreSheetTemplateRequest.Marshal(b, m, deterministic)
+}
+func (dst *ImportScoreSheetTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportScoreSheetTemplateRequest.Merge(dst, src)
+}
+func (m *ImportScoreSheetTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_ImportScoreSheetTemplateRequest.Size(m)
+}
+func (m *ImportScoreSheetTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportScoreSheetTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportScoreSheetTemplateRequest proto.InternalMessageInfo
+
+func (m *ImportScoreSheetTemplateRequest) GetFormat() TemplateFormat {
+	if m != nil {
+		return m.Format
+	}
+	return TemplateFormat_JSON
+}
+
+func (m *ImportScoreSheetTemplateRequest) GetDocument() string {
+	if m != nil {
+		return m.Document
+	}
+	return ""
+}
+
+func (m *ImportScoreSheetTemplateRequest) GetSourceScoreSheetTemplateId() string {
+	if m != nil {
+		return m.SourceScoreSheetTemplateId
+	}
+	return ""
+}
+
+func (m *ImportScoreSheetTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ImportScoreSheetTemplateResponse struct {
+	ScoreSheetTemplate   *ScoreSheetTemplate `protobuf:"bytes,1,opt,name=score_sheet_template,json=scoreSheetTemplate,proto3" json:"score_sheet_template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ImportScoreSheetTemplateResponse) Reset()         { *m = ImportScoreSheetTemplateResponse{} }
+func (m *ImportScoreSheetTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*ImportScoreSheetTemplateResponse) ProtoMessage()    {}
+func (*ImportScoreSheetTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{90}
+}
+func (m *ImportScoreSheetTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ImportScoreSheetTemplateResponse.Unmarshal(m, b)
+}
+func (m *ImportScoreSheetTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ImportScoreSheetTemplateResponse.Marshal(b, m, deterministic)
+}
+func (dst *ImportScoreSheetTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ImportScoreSheetTemplateResponse.Merge(dst, src)
+}
+func (m *ImportScoreSheetTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_ImportScoreSheetTemplateResponse.Size(m)
+}
+func (m *ImportScoreSheetTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ImportScoreSheetTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ImportScoreSheetTemplateResponse proto.InternalMessageInfo
+
+func (m *ImportScoreSheetTemplateResponse) GetScoreSheetTemplate() *ScoreSheetTemplate {
+	if m != nil {
+		return m.ScoreSheetTemplate
+	}
+	return nil
+}
+
+type ScoreSheetMigration struct {
+	ScoreSheetId    string   `protobuf:"bytes,1,opt,name=score_sheet_id,json=scoreSheetId,proto3" json:"score_sheet_id,omitempty"`
+	TeamId          string   `protobuf:"bytes,2,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	Round           int32    `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+	FromVersion     int32    `protobuf:"varint,4,opt,name=from_version,json=fromVersion,proto3" json:"from_version,omitempty"`
+	ToVersion       int32    `protobuf:"varint,5,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	TotalBefore     float64  `protobuf:"fixed64,6,opt,name=total_before,json=totalBefore,proto3" json:"total_before,omitempty"`
+	TotalAfter      float64  `protobuf:"fixed64,7,opt,name=total_after,json=totalAfter,proto3" json:"total_after,omitempty"`
+	DroppedSections []string `protobuf:"bytes,8,rep,name=dropped_sections,json=droppedSections,proto3" json:"dropped_sections,omitempty"`
+	ClampedSections []string `protobuf:"bytes,9,rep,name=clamped_sections,json=clampedSections,proto3" json:"clamped_sections,omitempty"`
+	AddedSections   []string `protobuf:"bytes,10,rep,name=added_sections,json=addedSections,proto3" json:"added_sections,omitempty"`
+	// skipped_reason is set when the sheet is left on its current version because it or its round
+	// is locked.
+	SkippedReason        string   `protobuf:"bytes,11,opt,name=skipped_reason,json=skippedReason,proto3" json:"skipped_reason,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ScoreSheetMigration) Reset()         { *m = ScoreSheetMigration{} }
+func (m *ScoreSheetMigration) String() string { return proto.CompactTextString(m) }
+func (*ScoreSheetMigration) ProtoMessage()    {}
+func (*ScoreSheetMigration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{91}
+}
+func (m *ScoreSheetMigration) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ScoreSheetMigration.Unmarshal(m, b)
+}
+func (m *ScoreSheetMigration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ScoreSheetMigration.Marshal(b, m, deterministic)
+}
+func (dst *ScoreSheetMigration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScoreSheetMigration.Merge(dst, src)
+}
+func (m *ScoreSheetMigration) XXX_Size() int {
+	return xxx_messageInfo_ScoreSheetMigration.Size(m)
+}
+func (m *ScoreSheetMigration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScoreSheetMigration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScoreSheetMigration proto.InternalMessageInfo
+
+func (m *ScoreSheetMigration) GetScoreSheetId() string {
+	if m != nil {
+		return m.ScoreSheetId
+	}
+	return ""
+}
+
+func (m *ScoreSheetMigration) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *ScoreSheetMigration) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *ScoreSheetMigration) GetFromVersion() int32 {
+	if m != nil {
+		return m.FromVersion
+	}
+	return 0
+}
+
+func (m *ScoreSheetMigration) GetToVersion() int32 {
+	if m != nil {
+		return m.ToVersion
+	}
+	return 0
+}
+
+func (m *ScoreSheetMigration) GetTotalBefore() float64 {
+	if m != nil {
+		return m.TotalBefore
+	}
+	return 0
+}
+
+func (m *ScoreSheetMigration) GetTotalAfter() float64 {
+	if m != nil {
+		return m.TotalAfter
+	}
+	return 0
+}
+
+func (m *ScoreSheetMigration) GetDroppedSections() []string {
+	if m != nil {
+		return m.DroppedSections
+	}
+	return nil
+}
+
+func (m *ScoreSheetMigration) GetClampedSections() []string {
+	if m != nil {
+		return m.ClampedSections
+	}
+	return nil
+}
+
+func (m *ScoreSheetMigration) GetAddedSections() []string {
+	if m != nil {
+		return m.AddedSections
+	}
+	return nil
+}
+
+func (m *ScoreSheetMigration) GetSkippedReason() string {
+	if m != nil {
+		return m.SkippedReason
+	}
+	return ""
+}
+
+type MigrateScoreSheetsRequest struct {
+	ScoreSheetTemplateId string `protobuf:"bytes,1,opt,name=score_sheet_template_id,json=scoreSheetTemplateId,proto3" json:"score_sheet_template_id,omitempty"`
+	// Defaults to the current version of the template
+	ToVersion int32 `protobuf:"varint,2,opt,name=to_version,json=toVersion,proto3" json:"to_version,omitempty"`
+	// Limits the migration to these sheets, otherwise every older sheet is migrated
+	ScoreSheetIds        []string `protobuf:"bytes,3,rep,name=score_sheet_ids,json=scoreSheetIds,proto3" json:"score_sheet_ids,omitempty"`
+	Preview              bool     `protobuf:"varint,4,opt,name=preview,proto3" json:"preview,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *MigrateScoreSheetsRequest) Reset()         { *m = MigrateScoreSheetsRequest{} }
+func (m *MigrateScoreSheetsRequest) String() string { return proto.CompactTextString(m) }
+func (*MigrateScoreSheetsRequest) ProtoMessage()    {}
+func (*MigrateScoreSheetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{92}
+}
+func (m *MigrateScoreSheetsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateScoreSheetsRequest.Unmarshal(m, b)
+}
+func (m *MigrateScoreSheetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateScoreSheetsRequest.Marshal(b, m, deterministic)
+}
+func (dst *MigrateScoreSheetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateScoreSheetsRequest.Merge(dst, src)
+}
+func (m *MigrateScoreSheetsRequest) XXX_Size() int {
+	return xxx_messageInfo_MigrateScoreSheetsRequest.Size(m)
+}
+func (m *MigrateScoreSheetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateScoreSheetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateScoreSheetsRequest proto.InternalMessageInfo
+
+func (m *MigrateScoreSheetsRequest) GetScoreSheetTemplateId() string {
+	if m != nil {
+		return m.ScoreSheetTemplateId
+	}
+	return ""
+}
+
+func (m *MigrateScoreSheetsRequest) GetToVersion() int32 {
+	if m != nil {
+		return m.ToVersion
+	}
+	return 0
+}
+
+func (m *MigrateScoreSheetsRequest) GetScoreSheetIds() []string {
+	if m != nil {
+		return m.ScoreSheetIds
+	}
+	return nil
+}
+
+func (m *MigrateScoreSheetsRequest) GetPreview() bool {
+	if m != nil {
+		return m.Preview
+	}
+	return false
+}
+
+type MigrateScoreSheetsResponse struct {
+	Migrations           []*ScoreSheetMigration `protobuf:"bytes,1,rep,name=migrations,proto3" json:"migrations,omitempty"`
+	Applied              bool                   `protobuf:"varint,2,opt,name=applied,proto3" json:"applied,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *MigrateScoreSheetsResponse) Reset()         { *m = MigrateScoreSheetsResponse{} }
+func (m *MigrateScoreSheetsResponse) String() string { return proto.CompactTextString(m) }
+func (*MigrateScoreSheetsResponse) ProtoMessage()    {}
+func (*MigrateScoreSheetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{93}
+}
+func (m *MigrateScoreSheetsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MigrateScoreSheetsResponse.Unmarshal(m, b)
+}
+func (m *MigrateScoreSheetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MigrateScoreSheetsResponse.Marshal(b, m, deterministic)
+}
+func (dst *MigrateScoreSheetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MigrateScoreSheetsResponse.Merge(dst, src)
+}
+func (m *MigrateScoreSheetsResponse) XXX_Size() int {
+	return xxx_messageInfo_MigrateScoreSheetsResponse.Size(m)
+}
+func (m *MigrateScoreSheetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MigrateScoreSheetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MigrateScoreSheetsResponse proto.InternalMessageInfo
+
+func (m *MigrateScoreSheetsResponse) GetMigrations() []*ScoreSheetMigration {
+	if m != nil {
+		return m.Migrations
+	}
+	return nil
+}
+
+func (m *MigrateScoreSheetsResponse) GetApplied() bool {
+	if m != nil {
+		return m.Applied
+	}
+	return false
+}
+
+type CreateUserRequest struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateUserRequest) Reset()         { *m = CreateUserRequest{} }
+func (m *CreateUserRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateUserRequest) ProtoMessage()    {}
+func (*CreateUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{94}
+}
+func (m *CreateUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateUserRequest.Unmarshal(m, b)
+}
+func (m *CreateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateUserRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUserRequest.Merge(dst, src)
+}
+func (m *CreateUserRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateUserRequest.Size(m)
+}
+func (m *CreateUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateUserRequest proto.InternalMessageInfo
+
+func (m *CreateUserRequest) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type CreateUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateUserResponse) Reset()         { *m = CreateUserResponse{} }
+func (m *CreateUserResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateUserResponse) ProtoMessage()    {}
+func (*CreateUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{95}
+}
+func (m *CreateUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateUserResponse.Unmarshal(m, b)
+}
+func (m *CreateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateUserResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateUserResponse.Merge(dst, src)
+}
+func (m *CreateUserResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateUserResponse.Size(m)
+}
+func (m *CreateUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateUserResponse proto.InternalMessageInfo
+
+func (m *CreateUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type UpdateUserRequest struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserRequest) Reset()         { *m = UpdateUserRequest{} }
+func (m *UpdateUserRequest) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserRequest) ProtoMessage()    {}
+func (*UpdateUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{96}
+}
+func (m *UpdateUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserRequest.Unmarshal(m, b)
+}
+func (m *UpdateUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserRequest.Marshal(b, m, deterministic)
+}
+func (dst *UpdateUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserRequest.Merge(dst, src)
+}
+func (m *UpdateUserRequest) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserRequest.Size(m)
+}
+func (m *UpdateUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserRequest proto.InternalMessageInfo
+
+func (m *UpdateUserRequest) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type UpdateUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *UpdateUserResponse) Reset()         { *m = UpdateUserResponse{} }
+func (m *UpdateUserResponse) String() string { return proto.CompactTextString(m) }
+func (*UpdateUserResponse) ProtoMessage()    {}
+func (*UpdateUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{97}
+}
+func (m *UpdateUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_UpdateUserResponse.Unmarshal(m, b)
+}
+func (m *UpdateUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_UpdateUserResponse.Marshal(b, m, deterministic)
+}
+func (dst *UpdateUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_UpdateUserResponse.Merge(dst, src)
+}
+func (m *UpdateUserResponse) XXX_Size() int {
+	return xxx_messageInfo_UpdateUserResponse.Size(m)
+}
+func (m *UpdateUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_UpdateUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_UpdateUserResponse proto.InternalMessageInfo
+
+func (m *UpdateUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type GetCheckinsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCheckinsRequest) Reset()         { *m = GetCheckinsRequest{} }
+func (m *GetCheckinsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCheckinsRequest) ProtoMessage()    {}
+func (*GetCheckinsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{98}
+}
+func (m *GetCheckinsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCheckinsRequest.Unmarshal(m, b)
+}
+func (m *GetCheckinsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCheckinsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetCheckinsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCheckinsRequest.Merge(dst, src)
+}
+func (m *GetCheckinsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCheckinsRequest.Size(m)
+}
+func (m *GetCheckinsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCheckinsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCheckinsRequest proto.InternalMessageInfo
+
+type GetCheckinsResponse struct {
+	CheckIns             []*Checkin `protobuf:"bytes,1,rep,name=check_ins,json=checkIns,proto3" json:"check_ins,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GetCheckinsResponse) Reset()         { *m = GetCheckinsResponse{} }
+func (m *GetCheckinsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCheckinsResponse) ProtoMessage()    {}
+func (*GetCheckinsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{99}
+}
+func (m *GetCheckinsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCheckinsResponse.Unmarshal(m, b)
+}
+func (m *GetCheckinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCheckinsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetCheckinsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCheckinsResponse.Merge(dst, src)
+}
+func (m *GetCheckinsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCheckinsResponse.Size(m)
+}
+func (m *GetCheckinsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCheckinsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCheckinsResponse proto.InternalMessageInfo
+
+func (m *GetCheckinsResponse) GetCheckIns() []*Checkin {
+	if m != nil {
+		return m.CheckIns
+	}
+	return nil
+}
+
+type CreateCheckinRequest struct {
+	CheckIn *Checkin `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	// idempotency_key identifies the creation so a retried request returns the originally created
+	// checkin instead of creating another. It may also be sent as idempotency-key metadata.
+	IdempotencyKey       string   `protobuf:"bytes,2,opt,name=idempotency_key,json=idempotencyKey,proto3" json:"idempotency_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCheckinRequest) Reset()         { *m = CreateCheckinRequest{} }
+func (m *CreateCheckinRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCheckinRequest) ProtoMessage()    {}
+func (*CreateCheckinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{100}
+}
+func (m *CreateCheckinRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCheckinRequest.Unmarshal(m, b)
+}
+func (m *CreateCheckinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCheckinRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateCheckinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCheckinRequest.Merge(dst, src)
+}
+func (m *CreateCheckinRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCheckinRequest.Size(m)
+}
+func (m *CreateCheckinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCheckinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCheckinRequest proto.InternalMessageInfo
+
+func (m *CreateCheckinRequest) GetCheckIn() *Checkin {
+	if m != nil {
+		return m.CheckIn
+	}
+	return nil
+}
+
+func (m *CreateCheckinRequest) GetIdempotencyKey() string {
+	if m != nil {
+		return m.IdempotencyKey
+	}
+	return ""
+}
+
+type CreateCheckinResponse struct {
+	CheckIn              *Checkin `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateCheckinResponse) Reset()         { *m = CreateCheckinResponse{} }
+func (m *CreateCheckinResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCheckinResponse) ProtoMessage()    {}
+func (*CreateCheckinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{101}
+}
+func (m *CreateCheckinResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCheckinResponse.Unmarshal(m, b)
+}
+func (m *CreateCheckinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCheckinResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateCheckinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCheckinResponse.Merge(dst, src)
+}
+func (m *CreateCheckinResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCheckinResponse.Size(m)
+}
+func (m *CreateCheckinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCheckinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCheckinResponse proto.InternalMessageInfo
+
+func (m *CreateCheckinResponse) GetCheckIn() *Checkin {
+	if m != nil {
+		return m.CheckIn
+	}
+	return nil
+}
+
+type GetScoreSheetsRequest struct {
+	CurrentUser          bool     `protobuf:"varint,1,opt,name=current_user,json=currentUser,proto3" json:"current_user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetScoreSheetsRequest) Reset()         { *m = GetScoreSheetsRequest{} }
+func (m *GetScoreSheetsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetScoreSheetsRequest) ProtoMessage()    {}
+func (*GetScoreSheetsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{102}
+}
+func (m *GetScoreSheetsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScoreSheetsRequest.Unmarshal(m, b)
+}
+func (m *GetScoreSheetsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScoreSheetsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetScoreSheetsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScoreSheetsRequest.Merge(dst, src)
+}
+func (m *GetScoreSheetsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetScoreSheetsRequest.Size(m)
+}
+func (m *GetScoreSheetsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScoreSheetsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetScoreSheetsRequest proto.InternalMessageInfo
+
+func (m *GetScoreSheetsRequest) GetCurrentUser() bool {
+	if m != nil {
+		return m.CurrentUser
+	}
+	return false
+}
+
+type GetScoreSheetsResponse struct {
+	ScoreSheets          []*ScoreSheet `protobuf:"bytes,1,rep,name=score_sheets,json=scoreSheets,proto3" json:"score_sheets,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetScoreSheetsResponse) Reset()         { *m = GetScoreSheetsResponse{} }
+func (m *GetScoreSheetsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetScoreSheetsResponse) ProtoMessage()    {}
+func (*GetScoreSheetsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{103}
+}
+func (m *GetScoreSheetsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetScoreSheetsResponse.Unmarshal(m, b)
+}
+func (m *GetScoreSheetsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetScoreSheetsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetScoreSheetsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetScoreSheetsResponse.Merge(dst, src)
+}
+func (m *GetScoreSheetsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetScoreSheetsResponse.Size(m)
+}
+func (m *GetScoreSheetsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetScoreSheetsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetScoreSheetsResponse proto.InternalMessageInfo
+
+func (m *GetScoreSheetsResponse) GetScoreSheets() []*ScoreSheet {
+	if m != nil {
+		return m.ScoreSheets
+	}
+	return nil
+}
+
+type GetSheetTeamsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSheetTeamsRequest) Reset()         { *m = GetSheetTeamsRequest{} }
+func (m *GetSheetTeamsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSheetTeamsRequest) ProtoMessage()    {}
+func (*GetSheetTeamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{104}
+}
+func (m *GetSheetTeamsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSheetTeamsRequest.Unmarshal(m, b)
+}
+func (m *GetSheetTeamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSheetTeamsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetSheetTeamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSheetTeamsRequest.Merge(dst, src)
+}
+func (m *GetSheetTeamsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSheetTeamsRequest.Size(m)
+}
+func (m *GetSheetTeamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSheetTeamsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSheetTeamsRequest proto.InternalMessageInfo
+
+type GetSheetTeamsResponse struct {
+	Teams                []*Team  `protobuf:"bytes,1,rep,name=teams,proto3" json:"teams,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSheetTeamsResponse) Reset()         { *m = GetSheetTeamsResponse{} }
+func (m *GetSheetTeamsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSheetTeamsResponse) ProtoMessage()    {}
+func (*GetSheetTeamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{105}
+}
+func (m *GetSheetTeamsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSheetTeamsResponse.Unmarshal(m, b)
+}
+func (m *GetSheetTeamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSheetTeamsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetSheetTeamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSheetTeamsResponse.Merge(dst, src)
+}
+func (m *GetSheetTeamsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSheetTeamsResponse.Size(m)
+}
+func (m *GetSheetTeamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSheetTeamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSheetTeamsResponse proto.InternalMessageInfo
+
+func (m *GetSheetTeamsResponse) GetTeams() []*Team {
+	if m != nil {
+		return m.Teams
+	}
+	return nil
+}
+
+type JudgeAssignment struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	JudgeId              string   `protobuf:"bytes,2,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	DivisionId           string   `protobuf:"bytes,3,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	AllRounds            bool     `protobuf:"varint,4,opt,name=all_rounds,json=allRounds,proto3" json:"all_rounds,omitempty"`
+	Round                int32    `protobuf:"varint,5,opt,name=round,proto3" json:"round,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *JudgeAssignment) Reset()         { *m = JudgeAssignment{} }
+func (m *JudgeAssignment) String() string { return proto.CompactTextString(m) }
+func (*JudgeAssignment) ProtoMessage()    {}
+func (*JudgeAssignment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{106}
+}
+func (m *JudgeAssignment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_JudgeAssignment.Unmarshal(m, b)
+}
+func (m *JudgeAssignment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_JudgeAssignment.Marshal(b, m, deterministic)
+}
+func (dst *JudgeAssignment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_JudgeAssignment.Merge(dst, src)
+}
+func (m *JudgeAssignment) XXX_Size() int {
+	return xxx_messageInfo_JudgeAssignment.Size(m)
+}
+func (m *JudgeAssignment) XXX_DiscardUnknown() {
+	xxx_messageInfo_JudgeAssignment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_JudgeAssignment proto.InternalMessageInfo
+
+func (m *JudgeAssignment) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *JudgeAssignment) GetJudgeId() string {
+	if m != nil {
+		return m.JudgeId
+	}
+	return ""
+}
+
+func (m *JudgeAssignment) GetDivisionId() string {
+	if m != nil {
+		return m.DivisionId
+	}
+	return ""
+}
+
+func (m *JudgeAssignment) GetAllRounds() bool {
+	if m != nil {
+		return m.AllRounds
+	}
+	return false
+}
+
+func (m *JudgeAssignment) GetRound() int32 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+type GetJudgeAssignmentsRequest struct {
+	JudgeId              string   `protobuf:"bytes,1,opt,name=judge_id,json=judgeId,proto3" json:"judge_id,omitempty"`
+	DivisionId           string   `protobuf:"bytes,2,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetJudgeAssignmentsRequest) Reset()         { *m = GetJudgeAssignmentsRequest{} }
+func (m *GetJudgeAssignmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetJudgeAssignmentsRequest) ProtoMessage()    {}
+func (*GetJudgeAssignmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{107}
+}
+func (m *GetJudgeAssignmentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJudgeAssignmentsRequest.Unmarshal(m, b)
+}
+func (m *GetJudgeAssignmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJudgeAssignmentsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetJudgeAssignmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJudgeAssignmentsRequest.Merge(dst, src)
+}
+func (m *GetJudgeAssignmentsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetJudgeAssignmentsRequest.Size(m)
+}
+func (m *GetJudgeAssignmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJudgeAssignmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJudgeAssignmentsRequest proto.InternalMessageInfo
+
+func (m *GetJudgeAssignmentsRequest) GetJudgeId() string {
+	if m != nil {
+		return m.JudgeId
+	}
+	return ""
+}
+
+func (m *GetJudgeAssignmentsRequest) GetDivisionId() string {
+	if m != nil {
+		return m.DivisionId
+	}
+	return ""
+}
+
+type GetJudgeAssignmentsResponse struct {
+	JudgeAssignments     []*JudgeAssignment `protobuf:"bytes,1,rep,name=judge_assignments,json=judgeAssignments,proto3" json:"judge_assignments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetJudgeAssignmentsResponse) Reset()         { *m = GetJudgeAssignmentsResponse{} }
+func (m *GetJudgeAssignmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetJudgeAssignmentsResponse) ProtoMessage()    {}
+func (*GetJudgeAssignmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{108}
+}
+func (m *GetJudgeAssignmentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetJudgeAssignmentsResponse.Unmarshal(m, b)
+}
+func (m *GetJudgeAssignmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetJudgeAssignmentsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetJudgeAssignmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetJudgeAssignmentsResponse.Merge(dst, src)
+}
+func (m *GetJudgeAssignmentsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetJudgeAssignmentsResponse.Size(m)
+}
+func (m *GetJudgeAssignmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetJudgeAssignmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetJudgeAssignmentsResponse proto.InternalMessageInfo
+
+func (m *GetJudgeAssignmentsResponse) GetJudgeAssignments() []*JudgeAssignment {
+	if m != nil {
+		return m.JudgeAssignments
+	}
+	return nil
+}
+
+type CreateJudgeAssignmentRequest struct {
+	JudgeAssignment      *JudgeAssignment `protobuf:"bytes,1,opt,name=judge_assignment,json=judgeAssignment,proto3" json:"judge_assignment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CreateJudgeAssignmentRequest) Reset()         { *m = CreateJudgeAssignmentRequest{} }
+func (m *CreateJudgeAssignmentRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateJudgeAssignmentRequest) ProtoMessage()    {}
+func (*CreateJudgeAssignmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{109}
+}
+func (m *CreateJudgeAssignmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateJudgeAssignmentRequest.Unmarshal(m, b)
+}
+func (m *CreateJudgeAssignmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateJudgeAssignmentRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateJudgeAssignmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateJudgeAssignmentRequest.Merge(dst, src)
+}
+func (m *CreateJudgeAssignmentRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateJudgeAssignmentRequest.Size(m)
+}
+func (m *CreateJudgeAssignmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateJudgeAssignmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateJudgeAssignmentRequest proto.InternalMessageInfo
+
+func (m *CreateJudgeAssignmentRequest) GetJudgeAssignment() *JudgeAssignment {
+	if m != nil {
+		return m.JudgeAssignment
+	}
+	return nil
+}
+
+type CreateJudgeAssignmentResponse struct {
+	JudgeAssignment      *JudgeAssignment `protobuf:"bytes,1,opt,name=judge_assignment,json=judgeAssignment,proto3" json:"judge_assignment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
+	XXX_unrecognized     []byte           `json:"-"`
+	XXX_sizecache        int32            `json:"-"`
+}
+
+func (m *CreateJudgeAssignmentResponse) Reset()         { *m = CreateJudgeAssignmentResponse{} }
+func (m *CreateJudgeAssignmentResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateJudgeAssignmentResponse) ProtoMessage()    {}
+func (*CreateJudgeAssignmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{110}
+}
+func (m *CreateJudgeAssignmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateJudgeAssignmentResponse.Unmarshal(m, b)
+}
+func (m *CreateJudgeAssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateJudgeAssignmentResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateJudgeAssignmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateJudgeAssignmentResponse.Merge(dst, src)
+}
+func (m *CreateJudgeAssignmentResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateJudgeAssignmentResponse.Size(m)
+}
+func (m *CreateJudgeAssignmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateJudgeAssignmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateJudgeAssignmentResponse proto.InternalMessageInfo
+
+func (m *CreateJudgeAssignmentResponse) GetJudgeAssignment() *JudgeAssignment {
+	if m != nil {
+		return m.JudgeAssignment
+	}
+	return nil
+}
+
+type DeleteJudgeAssignmentRequest struct {
+	JudgeAssignmentId    string   `protobuf:"bytes,1,opt,name=judge_assignment_id,json=judgeAssignmentId,proto3" json:"judge_assignment_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteJudgeAssignmentRequest) Reset()         { *m = DeleteJudgeAssignmentRequest{} }
+func (m *DeleteJudgeAssignmentRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteJudgeAssignmentRequest) ProtoMessage()    {}
+func (*DeleteJudgeAssignmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{111}
+}
+func (m *DeleteJudgeAssignmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteJudgeAssignmentRequest.Unmarshal(m, b)
+}
+func (m *DeleteJudgeAssignmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteJudgeAssignmentRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteJudgeAssignmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteJudgeAssignmentRequest.Merge(dst, src)
+}
+func (m *DeleteJudgeAssignmentRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteJudgeAssignmentRequest.Size(m)
+}
+func (m *DeleteJudgeAssignmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteJudgeAssignmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteJudgeAssignmentRequest proto.InternalMessageInfo
+
+func (m *DeleteJudgeAssignmentRequest) GetJudgeAssignmentId() string {
+	if m != nil {
+		return m.JudgeAssignmentId
+	}
+	return ""
+}
+
+type DeleteJudgeAssignmentResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteJudgeAssignmentResponse) Reset()         { *m = DeleteJudgeAssignmentResponse{} }
+func (m *DeleteJudgeAssignmentResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteJudgeAssignmentResponse) ProtoMessage()    {}
+func (*DeleteJudgeAssignmentResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{112}
+}
+func (m *DeleteJudgeAssignmentResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteJudgeAssignmentResponse.Unmarshal(m, b)
+}
+func (m *DeleteJudgeAssignmentResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteJudgeAssignmentResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteJudgeAssignmentResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteJudgeAssignmentResponse.Merge(dst, src)
+}
+func (m *DeleteJudgeAssignmentResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteJudgeAssignmentResponse.Size(m)
+}
+func (m *DeleteJudgeAssignmentResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteJudgeAssignmentResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteJudgeAssignmentResponse proto.InternalMessageInfo
+
+type AuditEntry struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	ActorId              string               `protobuf:"bytes,2,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Method               string               `protobuf:"bytes,3,opt,name=method,proto3" json:"method,omitempty"`
+	EntityType           string               `protobuf:"bytes,4,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId             string               `protobuf:"bytes,5,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	Before               string               `protobuf:"bytes,6,opt,name=before,proto3" json:"before,omitempty"`
+	After                string               `protobuf:"bytes,7,opt,name=after,proto3" json:"after,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,8,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *AuditEntry) Reset()         { *m = AuditEntry{} }
+func (m *AuditEntry) String() string { return proto.CompactTextString(m) }
+func (*AuditEntry) ProtoMessage()    {}
+func (*AuditEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{113}
+}
+func (m *AuditEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEntry.Unmarshal(m, b)
+}
+func (m *AuditEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEntry.Marshal(b, m, deterministic)
+}
+func (dst *AuditEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEntry.Merge(dst, src)
+}
+func (m *AuditEntry) XXX_Size() int {
+	return xxx_messageInfo_AuditEntry.Size(m)
+}
+func (m *AuditEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEntry proto.InternalMessageInfo
+
+func (m *AuditEntry) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetMethod() string {
+	if m != nil {
+		return m.Method
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetEntityType() string {
+	if m != nil {
+		return m.EntityType
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetBefore() string {
+	if m != nil {
+		return m.Before
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetAfter() string {
+	if m != nil {
+		return m.After
+	}
+	return ""
+}
+
+func (m *AuditEntry) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+type GetAuditLogRequest struct {
+	EntityType           string               `protobuf:"bytes,1,opt,name=entity_type,json=entityType,proto3" json:"entity_type,omitempty"`
+	EntityId             string               `protobuf:"bytes,2,opt,name=entity_id,json=entityId,proto3" json:"entity_id,omitempty"`
+	ActorId              string               `protobuf:"bytes,3,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	StartTime            *timestamp.Timestamp `protobuf:"bytes,4,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	EndTime              *timestamp.Timestamp `protobuf:"bytes,5,opt,name=end_time,json=endTime,proto3" json:"end_time,omitempty"`
+	Limit                int32                `protobuf:"varint,6,opt,name=limit,proto3" json:"limit,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *GetAuditLogRequest) Reset()         { *m = GetAuditLogRequest{} }
+func (m *GetAuditLogRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAuditLogRequest) ProtoMessage()    {}
+func (*GetAuditLogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{114}
+}
+func (m *GetAuditLogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditLogRequest.Unmarshal(m, b)
+}
+func (m *GetAuditLogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditLogRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetAuditLogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditLogRequest.Merge(dst, src)
+}
+func (m *GetAuditLogRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAuditLogRequest.Size(m)
+}
+func (m *GetAuditLogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditLogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditLogRequest proto.InternalMessageInfo
+
+func (m *GetAuditLogRequest) GetEntityType() string {
+	if m != nil {
+		return m.EntityType
+	}
+	return ""
+}
+
+func (m *GetAuditLogRequest) GetEntityId() string {
+	if m != nil {
+		return m.EntityId
+	}
+	return ""
+}
+
+func (m *GetAuditLogRequest) GetActorId() string {
+	if m != nil {
+		return m.ActorId
+	}
+	return ""
+}
+
+func (m *GetAuditLogRequest) GetStartTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.StartTime
+	}
+	return nil
+}
+
+func (m *GetAuditLogRequest) GetEndTime() *timestamp.Timestamp {
+	if m != nil {
+		return m.EndTime
+	}
+	return nil
+}
+
+func (m *GetAuditLogRequest) GetLimit() int32 {
+	if m != nil {
+		return m.Limit
+	}
+	return 0
+}
+
+type GetAuditLogResponse struct {
+	Entries              []*AuditEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetAuditLogResponse) Reset()         { *m = GetAuditLogResponse{} }
+func (m *GetAuditLogResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAuditLogResponse) ProtoMessage()    {}
+func (*GetAuditLogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{115}
+}
+func (m *GetAuditLogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAuditLogResponse.Unmarshal(m, b)
+}
+func (m *GetAuditLogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAuditLogResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetAuditLogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAuditLogResponse.Merge(dst, src)
+}
+func (m *GetAuditLogResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAuditLogResponse.Size(m)
+}
+func (m *GetAuditLogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAuditLogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetAuditLogResponse proto.InternalMessageInfo
+
+func (m *GetAuditLogResponse) GetEntries() []*AuditEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+type LoginLockout struct {
+	Key                  string               `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	Failures             int32                `protobuf:"varint,2,opt,name=failures,proto3" json:"failures,omitempty"`
+	LastFailureAt        *timestamp.Timestamp `protobuf:"bytes,3,opt,name=last_failure_at,json=lastFailureAt,proto3" json:"last_failure_at,omitempty"`
+	LockedUntil          *timestamp.Timestamp `protobuf:"bytes,4,opt,name=locked_until,json=lockedUntil,proto3" json:"locked_until,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *LoginLockout) Reset()         { *m = LoginLockout{} }
+func (m *LoginLockout) String() string { return proto.CompactTextString(m) }
+func (*LoginLockout) ProtoMessage()    {}
+func (*LoginLockout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{116}
+}
+func (m *LoginLockout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LoginLockout.Unmarshal(m, b)
+}
+func (m *LoginLockout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LoginLockout.Marshal(b, m, deterministic)
+}
+func (dst *LoginLockout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LoginLockout.Merge(dst, src)
+}
+func (m *LoginLockout) XXX_Size() int {
+	return xxx_messageInfo_LoginLockout.Size(m)
+}
+func (m *LoginLockout) XXX_DiscardUnknown() {
+	xxx_messageInfo_LoginLockout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LoginLockout proto.InternalMessageInfo
+
+func (m *LoginLockout) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+func (m *LoginLockout) GetFailures() int32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *LoginLockout) GetLastFailureAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.LastFailureAt
+	}
+	return nil
+}
+
+func (m *LoginLockout) GetLockedUntil() *timestamp.Timestamp {
+	if m != nil {
+		return m.LockedUntil
+	}
+	return nil
+}
+
+type GetLoginLockoutsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetLoginLockoutsRequest) Reset()         { *m = GetLoginLockoutsRequest{} }
+func (m *GetLoginLockoutsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLoginLockoutsRequest) ProtoMessage()    {}
+func (*GetLoginLockoutsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{117}
+}
+func (m *GetLoginLockoutsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoginLockoutsRequest.Unmarshal(m, b)
+}
+func (m *GetLoginLockoutsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoginLockoutsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetLoginLockoutsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoginLockoutsRequest.Merge(dst, src)
+}
+func (m *GetLoginLockoutsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLoginLockoutsRequest.Size(m)
+}
+func (m *GetLoginLockoutsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoginLockoutsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoginLockoutsRequest proto.InternalMessageInfo
+
+type GetLoginLockoutsResponse struct {
+	Lockouts             []*LoginLockout `protobuf:"bytes,1,rep,name=lockouts,proto3" json:"lockouts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetLoginLockoutsResponse) Reset()         { *m = GetLoginLockoutsResponse{} }
+func (m *GetLoginLockoutsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetLoginLockoutsResponse) ProtoMessage()    {}
+func (*GetLoginLockoutsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{118}
+}
+func (m *GetLoginLockoutsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLoginLockoutsResponse.Unmarshal(m, b)
+}
+func (m *GetLoginLockoutsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLoginLockoutsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetLoginLockoutsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLoginLockoutsResponse.Merge(dst, src)
+}
+func (m *GetLoginLockoutsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetLoginLockoutsResponse.Size(m)
+}
+func (m *GetLoginLockoutsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLoginLockoutsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLoginLockoutsResponse proto.InternalMessageInfo
+
+func (m *GetLoginLockoutsResponse) GetLockouts() []*LoginLockout {
+	if m != nil {
+		return m.Lockouts
+	}
+	return nil
+}
+
+type ClearLoginLockoutRequest struct {
+	Key                  string   `protobuf:"bytes,1,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearLoginLockoutRequest) Reset()         { *m = ClearLoginLockoutRequest{} }
+func (m *ClearLoginLockoutRequest) String() string { return proto.CompactTextString(m) }
+func (*ClearLoginLockoutRequest) ProtoMessage()    {}
+func (*ClearLoginLockoutRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{119}
+}
+func (m *ClearLoginLockoutRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearLoginLockoutRequest.Unmarshal(m, b)
+}
+func (m *ClearLoginLockoutRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearLoginLockoutRequest.Marshal(b, m, deterministic)
+}
+func (dst *ClearLoginLockoutRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearLoginLockoutRequest.Merge(dst, src)
+}
+func (m *ClearLoginLockoutRequest) XXX_Size() int {
+	return xxx_messageInfo_ClearLoginLockoutRequest.Size(m)
+}
+func (m *ClearLoginLockoutRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearLoginLockoutRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearLoginLockoutRequest proto.InternalMessageInfo
+
+func (m *ClearLoginLockoutRequest) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type ClearLoginLockoutResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClearLoginLockoutResponse) Reset()         { *m = ClearLoginLockoutResponse{} }
+func (m *ClearLoginLockoutResponse) String() string { return proto.CompactTextString(m) }
+func (*ClearLoginLockoutResponse) ProtoMessage()    {}
+func (*ClearLoginLockoutResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{120}
+}
+func (m *ClearLoginLockoutResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClearLoginLockoutResponse.Unmarshal(m, b)
+}
+func (m *ClearLoginLockoutResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClearLoginLockoutResponse.Marshal(b, m, deterministic)
+}
+func (dst *ClearLoginLockoutResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClearLoginLockoutResponse.Merge(dst, src)
+}
+func (m *ClearLoginLockoutResponse) XXX_Size() int {
+	return xxx_messageInfo_ClearLoginLockoutResponse.Size(m)
+}
+func (m *ClearLoginLockoutResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClearLoginLockoutResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClearLoginLockoutResponse proto.InternalMessageInfo
+
+type ApiKey struct {
+	Id                   string               `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Name                 string               `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Scopes               []ApiKey_Scope       `protobuf:"varint,3,rep,packed,name=scopes,proto3,enum=ApiKey_Scope" json:"scopes,omitempty"`
+	CreatedBy            string               `protobuf:"bytes,4,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	CreatedAt            *timestamp.Timestamp `protobuf:"bytes,5,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	RevokedAt            *timestamp.Timestamp `protobuf:"bytes,6,opt,name=revoked_at,json=revokedAt,proto3" json:"revoked_at,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *ApiKey) Reset()         { *m = ApiKey{} }
+func (m *ApiKey) String() string { return proto.CompactTextString(m) }
+func (*ApiKey) ProtoMessage()    {}
+func (*ApiKey) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{121}
+}
+func (m *ApiKey) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApiKey.Unmarshal(m, b)
+}
+func (m *ApiKey) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApiKey.Marshal(b, m, deterministic)
+}
+func (dst *ApiKey) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApiKey.Merge(dst, src)
+}
+func (m *ApiKey) XXX_Size() int {
+	return xxx_messageInfo_ApiKey.Size(m)
+}
+func (m *ApiKey) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApiKey.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApiKey proto.InternalMessageInfo
+
+func (m *ApiKey) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *ApiKey) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ApiKey) GetScopes() []ApiKey_Scope {
+	if m != nil {
+		return m.Scopes
+	}
+	return nil
+}
+
+func (m *ApiKey) GetCreatedBy() string {
+	if m != nil {
+		return m.CreatedBy
+	}
+	return ""
+}
+
+func (m *ApiKey) GetCreatedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return nil
+}
+
+func (m *ApiKey) GetRevokedAt() *timestamp.Timestamp {
+	if m != nil {
+		return m.RevokedAt
+	}
+	return nil
+}
+
+type CreateApiKeyRequest struct {
+	ApiKey               *ApiKey  `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateApiKeyRequest) Reset()         { *m = CreateApiKeyRequest{} }
+func (m *CreateApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateApiKeyRequest) ProtoMessage()    {}
+func (*CreateApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{122}
+}
+func (m *CreateApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiKeyRequest.Unmarshal(m, b)
+}
+func (m *CreateApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiKeyRequest.Merge(dst, src)
+}
+func (m *CreateApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateApiKeyRequest.Size(m)
+}
+func (m *CreateApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateApiKeyRequest proto.InternalMessageInfo
+
+func (m *CreateApiKeyRequest) GetApiKey() *ApiKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+type CreateApiKeyResponse struct {
+	ApiKey *ApiKey `protobuf:"bytes,1,opt,name=api_key,json=apiKey,proto3" json:"api_key,omitempty"`
+	// key is the secret to present as "authorization: Bearer <key>". It is not retrievable later.
+	Key                  string   `protobuf:"bytes,2,opt,name=key,proto3" json:"key,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CreateApiKeyResponse) Reset()         { *m = CreateApiKeyResponse{} }
+func (m *CreateApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateApiKeyResponse) ProtoMessage()    {}
+func (*CreateApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{123}
+}
+func (m *CreateApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateApiKeyResponse.Unmarshal(m, b)
+}
+func (m *CreateApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateApiKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateApiKeyResponse.Merge(dst, src)
+}
+func (m *CreateApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateApiKeyResponse.Size(m)
+}
+func (m *CreateApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateApiKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateApiKeyResponse proto.InternalMessageInfo
+
+func (m *CreateApiKeyResponse) GetApiKey() *ApiKey {
+	if m != nil {
+		return m.ApiKey
+	}
+	return nil
+}
+
+func (m *CreateApiKeyResponse) GetKey() string {
+	if m != nil {
+		return m.Key
+	}
+	return ""
+}
+
+type GetApiKeysRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetApiKeysRequest) Reset()         { *m = GetApiKeysRequest{} }
+func (m *GetApiKeysRequest) String() string { return proto.CompactTextString(m) }
+func (*GetApiKeysRequest) ProtoMessage()    {}
+func (*GetApiKeysRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{124}
+}
+func (m *GetApiKeysRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApiKeysRequest.Unmarshal(m, b)
+}
+func (m *GetApiKeysRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApiKeysRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetApiKeysRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApiKeysRequest.Merge(dst, src)
+}
+func (m *GetApiKeysRequest) XXX_Size() int {
+	return xxx_messageInfo_GetApiKeysRequest.Size(m)
+}
+func (m *GetApiKeysRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApiKeysRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApiKeysRequest proto.InternalMessageInfo
+
+type GetApiKeysResponse struct {
+	ApiKeys              []*ApiKey `protobuf:"bytes,1,rep,name=api_keys,json=apiKeys,proto3" json:"api_keys,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *GetApiKeysResponse) Reset()         { *m = GetApiKeysResponse{} }
+func (m *GetApiKeysResponse) String() string { return proto.CompactTextString(m) }
+func (*GetApiKeysResponse) ProtoMessage()    {}
+func (*GetApiKeysResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{125}
+}
+func (m *GetApiKeysResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApiKeysResponse.Unmarshal(m, b)
+}
+func (m *GetApiKeysResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApiKeysResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetApiKeysResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApiKeysResponse.Merge(dst, src)
+}
+func (m *GetApiKeysResponse) XXX_Size() int {
+	return xxx_messageInfo_GetApiKeysResponse.Size(m)
+}
+func (m *GetApiKeysResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApiKeysResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApiKeysResponse proto.InternalMessageInfo
+
+func (m *GetApiKeysResponse) GetApiKeys() []*ApiKey {
+	if m != nil {
+		return m.ApiKeys
+	}
+	return nil
+}
+
+type RevokeApiKeyRequest struct {
+	ApiKeyId             string   `protobuf:"bytes,1,opt,name=api_key_id,json=apiKeyId,proto3" json:"api_key_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeApiKeyRequest) Reset()         { *m = RevokeApiKeyRequest{} }
+func (m *RevokeApiKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeApiKeyRequest) ProtoMessage()    {}
+func (*RevokeApiKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{126}
+}
+func (m *RevokeApiKeyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeApiKeyRequest.Unmarshal(m, b)
+}
+func (m *RevokeApiKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeApiKeyRequest.Marshal(b, m, deterministic)
+}
+func (dst *RevokeApiKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeApiKeyRequest.Merge(dst, src)
+}
+func (m *RevokeApiKeyRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeApiKeyRequest.Size(m)
+}
+func (m *RevokeApiKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeApiKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeApiKeyRequest proto.InternalMessageInfo
+
+func (m *RevokeApiKeyRequest) GetApiKeyId() string {
+	if m != nil {
+		return m.ApiKeyId
+	}
+	return ""
+}
+
+type RevokeApiKeyResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeApiKeyResponse) Reset()         { *m = RevokeApiKeyResponse{} }
+func (m *RevokeApiKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RevokeApiKeyResponse) ProtoMessage()    {}
+func (*RevokeApiKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{127}
+}
+func (m *RevokeApiKeyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeApiKeyResponse.Unmarshal(m, b)
+}
+func (m *RevokeApiKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeApiKeyResponse.Marshal(b, m, deterministic)
+}
+func (dst *RevokeApiKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeApiKeyResponse.Merge(dst, src)
+}
+func (m *RevokeApiKeyResponse) XXX_Size() int {
+	return xxx_messageInfo_RevokeApiKeyResponse.Size(m)
+}
+func (m *RevokeApiKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeApiKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeApiKeyResponse proto.InternalMessageInfo
+
+type CreateCompetitionRequest struct {
+	Competition          *Competition `protobuf:"bytes,1,opt,name=competition,proto3" json:"competition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateCompetitionRequest) Reset()         { *m = CreateCompetitionRequest{} }
+func (m *CreateCompetitionRequest) String() string { return proto.CompactTextString(m) }
+func (*CreateCompetitionRequest) ProtoMessage()    {}
+func (*CreateCompetitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{128}
+}
+func (m *CreateCompetitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCompetitionRequest.Unmarshal(m, b)
+}
+func (m *CreateCompetitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCompetitionRequest.Marshal(b, m, deterministic)
+}
+func (dst *CreateCompetitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCompetitionRequest.Merge(dst, src)
+}
+func (m *CreateCompetitionRequest) XXX_Size() int {
+	return xxx_messageInfo_CreateCompetitionRequest.Size(m)
+}
+func (m *CreateCompetitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCompetitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCompetitionRequest proto.InternalMessageInfo
+
+func (m *CreateCompetitionRequest) GetCompetition() *Competition {
+	if m != nil {
+		return m.Competition
+	}
+	return nil
+}
+
+type CreateCompetitionResponse struct {
+	Competition          *Competition `protobuf:"bytes,1,opt,name=competition,proto3" json:"competition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CreateCompetitionResponse) Reset()         { *m = CreateCompetitionResponse{} }
+func (m *CreateCompetitionResponse) String() string { return proto.CompactTextString(m) }
+func (*CreateCompetitionResponse) ProtoMessage()    {}
+func (*CreateCompetitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{129}
+}
+func (m *CreateCompetitionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CreateCompetitionResponse.Unmarshal(m, b)
+}
+func (m *CreateCompetitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CreateCompetitionResponse.Marshal(b, m, deterministic)
+}
+func (dst *CreateCompetitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CreateCompetitionResponse.Merge(dst, src)
+}
+func (m *CreateCompetitionResponse) XXX_Size() int {
+	return xxx_messageInfo_CreateCompetitionResponse.Size(m)
+}
+func (m *CreateCompetitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CreateCompetitionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CreateCompetitionResponse proto.InternalMessageInfo
+
+func (m *CreateCompetitionResponse) GetCompetition() *Competition {
+	if m != nil {
+		return m.Competition
+	}
+	return nil
+}
+
+type GetCompetitionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompetitionsRequest) Reset()         { *m = GetCompetitionsRequest{} }
+func (m *GetCompetitionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetCompetitionsRequest) ProtoMessage()    {}
+func (*GetCompetitionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{130}
+}
+func (m *GetCompetitionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompetitionsRequest.Unmarshal(m, b)
+}
+func (m *GetCompetitionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompetitionsRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetCompetitionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompetitionsRequest.Merge(dst, src)
+}
+func (m *GetCompetitionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetCompetitionsRequest.Size(m)
+}
+func (m *GetCompetitionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompetitionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompetitionsRequest proto.InternalMessageInfo
+
+type GetCompetitionsResponse struct {
+	Competitions []*Competition `protobuf:"bytes,1,rep,name=competitions,proto3" json:"competitions,omitempty"`
+	// active_competition_id is the competition the caller's requests are currently scoped to
+	ActiveCompetitionId  string   `protobuf:"bytes,2,opt,name=active_competition_id,json=activeCompetitionId,proto3" json:"active_competition_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetCompetitionsResponse) Reset()         { *m = GetCompetitionsResponse{} }
+func (m *GetCompetitionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetCompetitionsResponse) ProtoMessage()    {}
+func (*GetCompetitionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{131}
+}
+func (m *GetCompetitionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetCompetitionsResponse.Unmarshal(m, b)
+}
+func (m *GetCompetitionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetCompetitionsResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetCompetitionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetCompetitionsResponse.Merge(dst, src)
+}
+func (m *GetCompetitionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetCompetitionsResponse.Size(m)
+}
+func (m *GetCompetitionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetCompetitionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetCompetitionsResponse proto.InternalMessageInfo
+
+func (m *GetCompetitionsResponse) GetCompetitions() []*Competition {
+	if m != nil {
+		return m.Competitions
+	}
+	return nil
+}
+
+func (m *GetCompetitionsResponse) GetActiveCompetitionId() string {
+	if m != nil {
+		return m.ActiveCompetitionId
+	}
+	return ""
+}
+
+type SelectCompetitionRequest struct {
+	CompetitionId        string   `protobuf:"bytes,1,opt,name=competition_id,json=competitionId,proto3" json:"competition_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SelectCompetitionRequest) Reset()         { *m = SelectCompetitionRequest{} }
+func (m *SelectCompetitionRequest) String() string { return proto.CompactTextString(m) }
+func (*SelectCompetitionRequest) ProtoMessage()    {}
+func (*SelectCompetitionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{132}
+}
+func (m *SelectCompetitionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectCompetitionRequest.Unmarshal(m, b)
+}
+func (m *SelectCompetitionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectCompetitionRequest.Marshal(b, m, deterministic)
+}
+func (dst *SelectCompetitionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectCompetitionRequest.Merge(dst, src)
+}
+func (m *SelectCompetitionRequest) XXX_Size() int {
+	return xxx_messageInfo_SelectCompetitionRequest.Size(m)
+}
+func (m *SelectCompetitionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectCompetitionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectCompetitionRequest proto.InternalMessageInfo
+
+func (m *SelectCompetitionRequest) GetCompetitionId() string {
+	if m != nil {
+		return m.CompetitionId
+	}
+	return ""
+}
+
+type SelectCompetitionResponse struct {
+	Competition          *Competition `protobuf:"bytes,1,opt,name=competition,proto3" json:"competition,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *SelectCompetitionResponse) Reset()         { *m = SelectCompetitionResponse{} }
+func (m *SelectCompetitionResponse) String() string { return proto.CompactTextString(m) }
+func (*SelectCompetitionResponse) ProtoMessage()    {}
+func (*SelectCompetitionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{133}
+}
+func (m *SelectCompetitionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SelectCompetitionResponse.Unmarshal(m, b)
+}
+func (m *SelectCompetitionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SelectCompetitionResponse.Marshal(b, m, deterministic)
+}
+func (dst *SelectCompetitionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SelectCompetitionResponse.Merge(dst, src)
+}
+func (m *SelectCompetitionResponse) XXX_Size() int {
+	return xxx_messageInfo_SelectCompetitionResponse.Size(m)
+}
+func (m *SelectCompetitionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SelectCompetitionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SelectCompetitionResponse proto.InternalMessageInfo
+
+func (m *SelectCompetitionResponse) GetCompetition() *Competition {
+	if m != nil {
+		return m.Competition
+	}
+	return nil
+}
+
+type CloneCompetitionSetupRequest struct {
+	SourceCompetitionId string `protobuf:"bytes,1,opt,name=source_competition_id,json=sourceCompetitionId,proto3" json:"source_competition_id,omitempty"`
+	// name is the name of the new competition the setup is copied into
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// include_judge_assignments also assigns the source competition's judges to the copied divisions
+	IncludeJudgeAssignments bool     `protobuf:"varint,3,opt,name=include_judge_assignments,json=includeJudgeAssignments,proto3" json:"include_judge_assignments,omitempty"`
+	XXX_NoUnkeyedLiteral    struct{} `json:"-"`
+	XXX_unrecognized        []byte   `json:"-"`
+	XXX_sizecache           int32    `json:"-"`
+}
+
+func (m *CloneCompetitionSetupRequest) Reset()         { *m = CloneCompetitionSetupRequest{} }
+func (m *CloneCompetitionSetupRequest) String() string { return proto.CompactTextString(m) }
+func (*CloneCompetitionSetupRequest) ProtoMessage()    {}
+func (*CloneCompetitionSetupRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{134}
+}
+func (m *CloneCompetitionSetupRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneCompetitionSetupRequest.Unmarshal(m, b)
+}
+func (m *CloneCompetitionSetupRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneCompetitionSetupRequest.Marshal(b, m, deterministic)
+}
+func (dst *CloneCompetitionSetupRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneCompetitionSetupRequest.Merge(dst, src)
+}
+func (m *CloneCompetitionSetupRequest) XXX_Size() int {
+	return xxx_messageInfo_CloneCompetitionSetupRequest.Size(m)
+}
+func (m *CloneCompetitionSetupRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneCompetitionSetupRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneCompetitionSetupRequest proto.InternalMessageInfo
+
+func (m *CloneCompetitionSetupRequest) GetSourceCompetitionId() string {
+	if m != nil {
+		return m.SourceCompetitionId
+	}
+	return ""
+}
+
+func (m *CloneCompetitionSetupRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CloneCompetitionSetupRequest) GetIncludeJudgeAssignments() bool {
+	if m != nil {
+		return m.IncludeJudgeAssignments
+	}
+	return false
+}
+
+type CloneCompetitionSetupResponse struct {
+	Competition          *Competition          `protobuf:"bytes,1,opt,name=competition,proto3" json:"competition,omitempty"`
+	Divisions            []*Division           `protobuf:"bytes,2,rep,name=divisions,proto3" json:"divisions,omitempty"`
+	ScoreSheetTemplates  []*ScoreSheetTemplate `protobuf:"bytes,3,rep,name=score_sheet_templates,json=scoreSheetTemplates,proto3" json:"score_sheet_templates,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}              `json:"-"`
+	XXX_unrecognized     []byte                `json:"-"`
+	XXX_sizecache        int32                 `json:"-"`
+}
+
+func (m *CloneCompetitionSetupResponse) Reset()         { *m = CloneCompetitionSetupResponse{} }
+func (m *CloneCompetitionSetupResponse) String() string { return proto.CompactTextString(m) }
+func (*CloneCompetitionSetupResponse) ProtoMessage()    {}
+func (*CloneCompetitionSetupResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{135}
+}
+func (m *CloneCompetitionSetupResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CloneCompetitionSetupResponse.Unmarshal(m, b)
+}
+func (m *CloneCompetitionSetupResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CloneCompetitionSetupResponse.Marshal(b, m, deterministic)
+}
+func (dst *CloneCompetitionSetupResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CloneCompetitionSetupResponse.Merge(dst, src)
+}
+func (m *CloneCompetitionSetupResponse) XXX_Size() int {
+	return xxx_messageInfo_CloneCompetitionSetupResponse.Size(m)
+}
+func (m *CloneCompetitionSetupResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CloneCompetitionSetupResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CloneCompetitionSetupResponse proto.InternalMessageInfo
+
+func (m *CloneCompetitionSetupResponse) GetCompetition() *Competition {
+	if m != nil {
+		return m.Competition
+	}
+	return nil
+}
+
+func (m *CloneCompetitionSetupResponse) GetDivisions() []*Division {
+	if m != nil {
+		return m.Divisions
+	}
+	return nil
+}
+
+func (m *CloneCompetitionSetupResponse) GetScoreSheetTemplates() []*ScoreSheetTemplate {
+	if m != nil {
+		return m.ScoreSheetTemplates
+	}
+	return nil
+}
+
+type DeleteTeamRequest struct {
+	TeamId string `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// cascade also deletes rows that refer to it instead of rejecting the delete
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTeamRequest) Reset()         { *m = DeleteTeamRequest{} }
+func (m *DeleteTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTeamRequest) ProtoMessage()    {}
+func (*DeleteTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{136}
+}
+func (m *DeleteTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTeamRequest.Unmarshal(m, b)
+}
+func (m *DeleteTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTeamRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTeamRequest.Merge(dst, src)
+}
+func (m *DeleteTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTeamRequest.Size(m)
+}
+func (m *DeleteTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTeamRequest proto.InternalMessageInfo
+
+func (m *DeleteTeamRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *DeleteTeamRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+type DeleteTeamResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTeamResponse) Reset()         { *m = DeleteTeamResponse{} }
+func (m *DeleteTeamResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTeamResponse) ProtoMessage()    {}
+func (*DeleteTeamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{137}
+}
+func (m *DeleteTeamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTeamResponse.Unmarshal(m, b)
+}
+func (m *DeleteTeamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTeamResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteTeamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTeamResponse.Merge(dst, src)
+}
+func (m *DeleteTeamResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTeamResponse.Size(m)
+}
+func (m *DeleteTeamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTeamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTeamResponse proto.InternalMessageInfo
+
+type RestoreTeamRequest struct {
+	TeamId string `protobuf:"bytes,1,opt,name=team_id,json=teamId,proto3" json:"team_id,omitempty"`
+	// cascade also restores rows that were deleted along with it
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTeamRequest) Reset()         { *m = RestoreTeamRequest{} }
+func (m *RestoreTeamRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreTeamRequest) ProtoMessage()    {}
+func (*RestoreTeamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{138}
+}
+func (m *RestoreTeamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTeamRequest.Unmarshal(m, b)
+}
+func (m *RestoreTeamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTeamRequest.Marshal(b, m, deterministic)
+}
+func (dst *RestoreTeamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTeamRequest.Merge(dst, src)
+}
+func (m *RestoreTeamRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreTeamRequest.Size(m)
+}
+func (m *RestoreTeamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTeamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTeamRequest proto.InternalMessageInfo
+
+func (m *RestoreTeamRequest) GetTeamId() string {
+	if m != nil {
+		return m.TeamId
+	}
+	return ""
+}
+
+func (m *RestoreTeamRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+type RestoreTeamResponse struct {
+	Team                 *Team    `protobuf:"bytes,1,opt,name=team,proto3" json:"team,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreTeamResponse) Reset()         { *m = RestoreTeamResponse{} }
+func (m *RestoreTeamResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreTeamResponse) ProtoMessage()    {}
+func (*RestoreTeamResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{139}
+}
+func (m *RestoreTeamResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreTeamResponse.Unmarshal(m, b)
+}
+func (m *RestoreTeamResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreTeamResponse.Marshal(b, m, deterministic)
+}
+func (dst *RestoreTeamResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreTeamResponse.Merge(dst, src)
+}
+func (m *RestoreTeamResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreTeamResponse.Size(m)
+}
+func (m *RestoreTeamResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreTeamResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreTeamResponse proto.InternalMessageInfo
+
+func (m *RestoreTeamResponse) GetTeam() *Team {
+	if m != nil {
+		return m.Team
+	}
+	return nil
+}
+
+type DeleteDivisionRequest struct {
+	DivisionId string `protobuf:"bytes,1,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	// cascade also deletes rows that refer to it instead of rejecting the delete
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDivisionRequest) Reset()         { *m = DeleteDivisionRequest{} }
+func (m *DeleteDivisionRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteDivisionRequest) ProtoMessage()    {}
+func (*DeleteDivisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{140}
+}
+func (m *DeleteDivisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDivisionRequest.Unmarshal(m, b)
+}
+func (m *DeleteDivisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDivisionRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteDivisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDivisionRequest.Merge(dst, src)
+}
+func (m *DeleteDivisionRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteDivisionRequest.Size(m)
+}
+func (m *DeleteDivisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDivisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDivisionRequest proto.InternalMessageInfo
+
+func (m *DeleteDivisionRequest) GetDivisionId() string {
+	if m != nil {
+		return m.DivisionId
+	}
+	return ""
+}
+
+func (m *DeleteDivisionRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+type DeleteDivisionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteDivisionResponse) Reset()         { *m = DeleteDivisionResponse{} }
+func (m *DeleteDivisionResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteDivisionResponse) ProtoMessage()    {}
+func (*DeleteDivisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{141}
+}
+func (m *DeleteDivisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteDivisionResponse.Unmarshal(m, b)
+}
+func (m *DeleteDivisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteDivisionResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteDivisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteDivisionResponse.Merge(dst, src)
+}
+func (m *DeleteDivisionResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteDivisionResponse.Size(m)
+}
+func (m *DeleteDivisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteDivisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteDivisionResponse proto.InternalMessageInfo
+
+type RestoreDivisionRequest struct {
+	DivisionId string `protobuf:"bytes,1,opt,name=division_id,json=divisionId,proto3" json:"division_id,omitempty"`
+	// cascade also restores rows that were deleted along with it
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreDivisionRequest) Reset()         { *m = RestoreDivisionRequest{} }
+func (m *RestoreDivisionRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreDivisionRequest) ProtoMessage()    {}
+func (*RestoreDivisionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{142}
+}
+func (m *RestoreDivisionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreDivisionRequest.Unmarshal(m, b)
+}
+func (m *RestoreDivisionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreDivisionRequest.Marshal(b, m, deterministic)
+}
+func (dst *RestoreDivisionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreDivisionRequest.Merge(dst, src)
+}
+func (m *RestoreDivisionRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreDivisionRequest.Size(m)
+}
+func (m *RestoreDivisionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreDivisionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreDivisionRequest proto.InternalMessageInfo
+
+func (m *RestoreDivisionRequest) GetDivisionId() string {
+	if m != nil {
+		return m.DivisionId
+	}
+	return ""
+}
+
+func (m *RestoreDivisionRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+type RestoreDivisionResponse struct {
+	Division             *Division `protobuf:"bytes,1,opt,name=division,proto3" json:"division,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
+}
+
+func (m *RestoreDivisionResponse) Reset()         { *m = RestoreDivisionResponse{} }
+func (m *RestoreDivisionResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreDivisionResponse) ProtoMessage()    {}
+func (*RestoreDivisionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{143}
+}
+func (m *RestoreDivisionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreDivisionResponse.Unmarshal(m, b)
+}
+func (m *RestoreDivisionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreDivisionResponse.Marshal(b, m, deterministic)
+}
+func (dst *RestoreDivisionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreDivisionResponse.Merge(dst, src)
+}
+func (m *RestoreDivisionResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreDivisionResponse.Size(m)
+}
+func (m *RestoreDivisionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreDivisionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreDivisionResponse proto.InternalMessageInfo
+
+func (m *RestoreDivisionResponse) GetDivision() *Division {
+	if m != nil {
+		return m.Division
+	}
+	return nil
+}
+
+type DeleteInstitutionRequest struct {
+	InstitutionId string `protobuf:"bytes,1,opt,name=institution_id,json=institutionId,proto3" json:"institution_id,omitempty"`
+	// cascade also deletes rows that refer to it instead of rejecting the delete
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteInstitutionRequest) Reset()         { *m = DeleteInstitutionRequest{} }
+func (m *DeleteInstitutionRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteInstitutionRequest) ProtoMessage()    {}
+func (*DeleteInstitutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{144}
+}
+func (m *DeleteInstitutionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteInstitutionRequest.Unmarshal(m, b)
+}
+func (m *DeleteInstitutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteInstitutionRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteInstitutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteInstitutionRequest.Merge(dst, src)
+}
+func (m *DeleteInstitutionRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteInstitutionRequest.Size(m)
+}
+func (m *DeleteInstitutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteInstitutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteInstitutionRequest proto.InternalMessageInfo
+
+func (m *DeleteInstitutionRequest) GetInstitutionId() string {
+	if m != nil {
+		return m.InstitutionId
+	}
+	return ""
+}
+
+func (m *DeleteInstitutionRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+type DeleteInstitutionResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteInstitutionResponse) Reset()         { *m = DeleteInstitutionResponse{} }
+func (m *DeleteInstitutionResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteInstitutionResponse) ProtoMessage()    {}
+func (*DeleteInstitutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{145}
+}
+func (m *DeleteInstitutionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteInstitutionResponse.Unmarshal(m, b)
+}
+func (m *DeleteInstitutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteInstitutionResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteInstitutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteInstitutionResponse.Merge(dst, src)
+}
+func (m *DeleteInstitutionResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteInstitutionResponse.Size(m)
+}
+func (m *DeleteInstitutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteInstitutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteInstitutionResponse proto.InternalMessageInfo
+
+type RestoreInstitutionRequest struct {
+	InstitutionId string `protobuf:"bytes,1,opt,name=institution_id,json=institutionId,proto3" json:"institution_id,omitempty"`
+	// cascade also restores rows that were deleted along with it
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreInstitutionRequest) Reset()         { *m = RestoreInstitutionRequest{} }
+func (m *RestoreInstitutionRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreInstitutionRequest) ProtoMessage()    {}
+func (*RestoreInstitutionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{146}
+}
+func (m *RestoreInstitutionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreInstitutionRequest.Unmarshal(m, b)
+}
+func (m *RestoreInstitutionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreInstitutionRequest.Marshal(b, m, deterministic)
+}
+func (dst *RestoreInstitutionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreInstitutionRequest.Merge(dst, src)
+}
+func (m *RestoreInstitutionRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreInstitutionRequest.Size(m)
+}
+func (m *RestoreInstitutionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreInstitutionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreInstitutionRequest proto.InternalMessageInfo
+
+func (m *RestoreInstitutionRequest) GetInstitutionId() string {
+	if m != nil {
+		return m.InstitutionId
+	}
+	return ""
+}
+
+func (m *RestoreInstitutionRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+type RestoreInstitutionResponse struct {
+	Institution          *Institution `protobuf:"bytes,1,opt,name=institution,proto3" json:"institution,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *RestoreInstitutionResponse) Reset()         { *m = RestoreInstitutionResponse{} }
+func (m *RestoreInstitutionResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreInstitutionResponse) ProtoMessage()    {}
+func (*RestoreInstitutionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{147}
+}
+func (m *RestoreInstitutionResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreInstitutionResponse.Unmarshal(m, b)
+}
+func (m *RestoreInstitutionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreInstitutionResponse.Marshal(b, m, deterministic)
+}
+func (dst *RestoreInstitutionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreInstitutionResponse.Merge(dst, src)
+}
+func (m *RestoreInstitutionResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreInstitutionResponse.Size(m)
+}
+func (m *RestoreInstitutionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreInstitutionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreInstitutionResponse proto.InternalMessageInfo
+
+func (m *RestoreInstitutionResponse) GetInstitution() *Institution {
+	if m != nil {
+		return m.Institution
+	}
+	return nil
+}
+
+type DeleteUserRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// cascade also deletes rows that refer to it instead of rejecting the delete
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserRequest) Reset()         { *m = DeleteUserRequest{} }
+func (m *DeleteUserRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserRequest) ProtoMessage()    {}
+func (*DeleteUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{148}
+}
+func (m *DeleteUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserRequest.Unmarshal(m, b)
+}
+func (m *DeleteUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserRequest.Merge(dst, src)
+}
+func (m *DeleteUserRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserRequest.Size(m)
+}
+func (m *DeleteUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserRequest proto.InternalMessageInfo
+
+func (m *DeleteUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *DeleteUserRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+type DeleteUserResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteUserResponse) Reset()         { *m = DeleteUserResponse{} }
+func (m *DeleteUserResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteUserResponse) ProtoMessage()    {}
+func (*DeleteUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{149}
+}
+func (m *DeleteUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteUserResponse.Unmarshal(m, b)
+}
+func (m *DeleteUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteUserResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteUserResponse.Merge(dst, src)
+}
+func (m *DeleteUserResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteUserResponse.Size(m)
+}
+func (m *DeleteUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteUserResponse proto.InternalMessageInfo
+
+type RestoreUserRequest struct {
+	UserId string `protobuf:"bytes,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	// cascade also restores rows that were deleted along with it
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreUserRequest) Reset()         { *m = RestoreUserRequest{} }
+func (m *RestoreUserRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserRequest) ProtoMessage()    {}
+func (*RestoreUserRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{150}
+}
+func (m *RestoreUserRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreUserRequest.Unmarshal(m, b)
+}
+func (m *RestoreUserRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreUserRequest.Marshal(b, m, deterministic)
+}
+func (dst *RestoreUserRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserRequest.Merge(dst, src)
+}
+func (m *RestoreUserRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreUserRequest.Size(m)
+}
+func (m *RestoreUserRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreUserRequest proto.InternalMessageInfo
+
+func (m *RestoreUserRequest) GetUserId() string {
+	if m != nil {
+		return m.UserId
+	}
+	return ""
+}
+
+func (m *RestoreUserRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+type RestoreUserResponse struct {
+	User                 *User    `protobuf:"bytes,1,opt,name=user,proto3" json:"user,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreUserResponse) Reset()         { *m = RestoreUserResponse{} }
+func (m *RestoreUserResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreUserResponse) ProtoMessage()    {}
+func (*RestoreUserResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{151}
+}
+func (m *RestoreUserResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreUserResponse.Unmarshal(m, b)
+}
+func (m *RestoreUserResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreUserResponse.Marshal(b, m, deterministic)
+}
+func (dst *RestoreUserResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreUserResponse.Merge(dst, src)
+}
+func (m *RestoreUserResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreUserResponse.Size(m)
+}
+func (m *RestoreUserResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreUserResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreUserResponse proto.InternalMessageInfo
+
+func (m *RestoreUserResponse) GetUser() *User {
+	if m != nil {
+		return m.User
+	}
+	return nil
+}
+
+type DeleteScoreSheetRequest struct {
+	ScoreSheetId         string   `protobuf:"bytes,1,opt,name=score_sheet_id,json=scoreSheetId,proto3" json:"score_sheet_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteScoreSheetRequest) Reset()         { *m = DeleteScoreSheetRequest{} }
+func (m *DeleteScoreSheetRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteScoreSheetRequest) ProtoMessage()    {}
+func (*DeleteScoreSheetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{152}
+}
+func (m *DeleteScoreSheetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteScoreSheetRequest.Unmarshal(m, b)
+}
+func (m *DeleteScoreSheetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteScoreSheetRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteScoreSheetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScoreSheetRequest.Merge(dst, src)
+}
+func (m *DeleteScoreSheetRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteScoreSheetRequest.Size(m)
+}
+func (m *DeleteScoreSheetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScoreSheetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteScoreSheetRequest proto.InternalMessageInfo
+
+func (m *DeleteScoreSheetRequest) GetScoreSheetId() string {
+	if m != nil {
+		return m.ScoreSheetId
+	}
+	return ""
+}
+
+type DeleteScoreSheetResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteScoreSheetResponse) Reset()         { *m = DeleteScoreSheetResponse{} }
+func (m *DeleteScoreSheetResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteScoreSheetResponse) ProtoMessage()    {}
+func (*DeleteScoreSheetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{153}
+}
+func (m *DeleteScoreSheetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteScoreSheetResponse.Unmarshal(m, b)
+}
+func (m *DeleteScoreSheetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteScoreSheetResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteScoreSheetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScoreSheetResponse.Merge(dst, src)
+}
+func (m *DeleteScoreSheetResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteScoreSheetResponse.Size(m)
+}
+func (m *DeleteScoreSheetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScoreSheetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteScoreSheetResponse proto.InternalMessageInfo
+
+type RestoreScoreSheetRequest struct {
+	ScoreSheetId         string   `protobuf:"bytes,1,opt,name=score_sheet_id,json=scoreSheetId,proto3" json:"score_sheet_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreScoreSheetRequest) Reset()         { *m = RestoreScoreSheetRequest{} }
+func (m *RestoreScoreSheetRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreScoreSheetRequest) ProtoMessage()    {}
+func (*RestoreScoreSheetRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{154}
+}
+func (m *RestoreScoreSheetRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreScoreSheetRequest.Unmarshal(m, b)
+}
+func (m *RestoreScoreSheetRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreScoreSheetRequest.Marshal(b, m, deterministic)
+}
+func (dst *RestoreScoreSheetRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreScoreSheetRequest.Merge(dst, src)
+}
+func (m *RestoreScoreSheetRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreScoreSheetRequest.Size(m)
+}
+func (m *RestoreScoreSheetRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreScoreSheetRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreScoreSheetRequest proto.InternalMessageInfo
+
+func (m *RestoreScoreSheetRequest) GetScoreSheetId() string {
+	if m != nil {
+		return m.ScoreSheetId
+	}
+	return ""
+}
+
+type RestoreScoreSheetResponse struct {
+	ScoreSheet           *ScoreSheet `protobuf:"bytes,1,opt,name=score_sheet,json=scoreSheet,proto3" json:"score_sheet,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *RestoreScoreSheetResponse) Reset()         { *m = RestoreScoreSheetResponse{} }
+func (m *RestoreScoreSheetResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreScoreSheetResponse) ProtoMessage()    {}
+func (*RestoreScoreSheetResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{155}
+}
+func (m *RestoreScoreSheetResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreScoreSheetResponse.Unmarshal(m, b)
+}
+func (m *RestoreScoreSheetResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreScoreSheetResponse.Marshal(b, m, deterministic)
+}
+func (dst *RestoreScoreSheetResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreScoreSheetResponse.Merge(dst, src)
+}
+func (m *RestoreScoreSheetResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreScoreSheetResponse.Size(m)
+}
+func (m *RestoreScoreSheetResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreScoreSheetResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreScoreSheetResponse proto.InternalMessageInfo
+
+func (m *RestoreScoreSheetResponse) GetScoreSheet() *ScoreSheet {
+	if m != nil {
+		return m.ScoreSheet
+	}
+	return nil
+}
+
+type DeleteScoreSheetTemplateRequest struct {
+	ScoreSheetTemplateId string `protobuf:"bytes,1,opt,name=score_sheet_template_id,json=scoreSheetTemplateId,proto3" json:"score_sheet_template_id,omitempty"`
+	// cascade also deletes rows that refer to it instead of rejecting the delete
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteScoreSheetTemplateRequest) Reset()         { *m = DeleteScoreSheetTemplateRequest{} }
+func (m *DeleteScoreSheetTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteScoreSheetTemplateRequest) ProtoMessage()    {}
+func (*DeleteScoreSheetTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{156}
+}
+func (m *DeleteScoreSheetTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteScoreSheetTemplateRequest.Unmarshal(m, b)
+}
+func (m *DeleteScoreSheetTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteScoreSheetTemplateRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteScoreSheetTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScoreSheetTemplateRequest.Merge(dst, src)
+}
+func (m *DeleteScoreSheetTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteScoreSheetTemplateRequest.Size(m)
+}
+func (m *DeleteScoreSheetTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScoreSheetTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteScoreSheetTemplateRequest proto.InternalMessageInfo
+
+func (m *DeleteScoreSheetTemplateRequest) GetScoreSheetTemplateId() string {
+	if m != nil {
+		return m.ScoreSheetTemplateId
+	}
+	return ""
+}
+
+func (m *DeleteScoreSheetTemplateRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+type DeleteScoreSheetTemplateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteScoreSheetTemplateResponse) Reset()         { *m = DeleteScoreSheetTemplateResponse{} }
+func (m *DeleteScoreSheetTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteScoreSheetTemplateResponse) ProtoMessage()    {}
+func (*DeleteScoreSheetTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{157}
+}
+func (m *DeleteScoreSheetTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteScoreSheetTemplateResponse.Unmarshal(m, b)
+}
+func (m *DeleteScoreSheetTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteScoreSheetTemplateResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteScoreSheetTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteScoreSheetTemplateResponse.Merge(dst, src)
+}
+func (m *DeleteScoreSheetTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteScoreSheetTemplateResponse.Size(m)
+}
+func (m *DeleteScoreSheetTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteScoreSheetTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteScoreSheetTemplateResponse proto.InternalMessageInfo
+
+type RestoreScoreSheetTemplateRequest struct {
+	ScoreSheetTemplateId string `protobuf:"bytes,1,opt,name=score_sheet_template_id,json=scoreSheetTemplateId,proto3" json:"score_sheet_template_id,omitempty"`
+	// cascade also restores rows that were deleted along with it
+	Cascade              bool     `protobuf:"varint,2,opt,name=cascade,proto3" json:"cascade,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreScoreSheetTemplateRequest) Reset()         { *m = RestoreScoreSheetTemplateRequest{} }
+func (m *RestoreScoreSheetTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreScoreSheetTemplateRequest) ProtoMessage()    {}
+func (*RestoreScoreSheetTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{158}
+}
+func (m *RestoreScoreSheetTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreScoreSheetTemplateRequest.Unmarshal(m, b)
+}
+func (m *RestoreScoreSheetTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreScoreSheetTemplateRequest.Marshal(b, m, deterministic)
+}
+func (dst *RestoreScoreSheetTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreScoreSheetTemplateRequest.Merge(dst, src)
+}
+func (m *RestoreScoreSheetTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreScoreSheetTemplateRequest.Size(m)
+}
+func (m *RestoreScoreSheetTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreScoreSheetTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreScoreSheetTemplateRequest proto.InternalMessageInfo
+
+func (m *RestoreScoreSheetTemplateRequest) GetScoreSheetTemplateId() string {
+	if m != nil {
+		return m.ScoreSheetTemplateId
+	}
+	return ""
+}
+
+func (m *RestoreScoreSheetTemplateRequest) GetCascade() bool {
+	if m != nil {
+		return m.Cascade
+	}
+	return false
+}
+
+type RestoreScoreSheetTemplateResponse struct {
+	ScoreSheetTemplate   *ScoreSheetTemplate `protobuf:"bytes,1,opt,name=score_sheet_template,json=scoreSheetTemplate,proto3" json:"score_sheet_template,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *RestoreScoreSheetTemplateResponse) Reset()         { *m = RestoreScoreSheetTemplateResponse{} }
+func (m *RestoreScoreSheetTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreScoreSheetTemplateResponse) ProtoMessage()    {}
+func (*RestoreScoreSheetTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{159}
+}
+func (m *RestoreScoreSheetTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreScoreSheetTemplateResponse.Unmarshal(m, b)
+}
+func (m *RestoreScoreSheetTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreScoreSheetTemplateResponse.Marshal(b, m, deterministic)
+}
+func (dst *RestoreScoreSheetTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreScoreSheetTemplateResponse.Merge(dst, src)
+}
+func (m *RestoreScoreSheetTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreScoreSheetTemplateResponse.Size(m)
+}
+func (m *RestoreScoreSheetTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreScoreSheetTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreScoreSheetTemplateResponse proto.InternalMessageInfo
+
+func (m *RestoreScoreSheetTemplateResponse) GetScoreSheetTemplate() *ScoreSheetTemplate {
+	if m != nil {
+		return m.ScoreSheetTemplate
+	}
+	return nil
+}
+
+type DeleteCheckinRequest struct {
+	CheckinId            string   `protobuf:"bytes,1,opt,name=checkin_id,json=checkinId,proto3" json:"checkin_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCheckinRequest) Reset()         { *m = DeleteCheckinRequest{} }
+func (m *DeleteCheckinRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteCheckinRequest) ProtoMessage()    {}
+func (*DeleteCheckinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{160}
+}
+func (m *DeleteCheckinRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCheckinRequest.Unmarshal(m, b)
+}
+func (m *DeleteCheckinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCheckinRequest.Marshal(b, m, deterministic)
+}
+func (dst *DeleteCheckinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCheckinRequest.Merge(dst, src)
+}
+func (m *DeleteCheckinRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteCheckinRequest.Size(m)
+}
+func (m *DeleteCheckinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCheckinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCheckinRequest proto.InternalMessageInfo
+
+func (m *DeleteCheckinRequest) GetCheckinId() string {
+	if m != nil {
+		return m.CheckinId
+	}
+	return ""
+}
+
+type DeleteCheckinResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteCheckinResponse) Reset()         { *m = DeleteCheckinResponse{} }
+func (m *DeleteCheckinResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteCheckinResponse) ProtoMessage()    {}
+func (*DeleteCheckinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{161}
+}
+func (m *DeleteCheckinResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteCheckinResponse.Unmarshal(m, b)
+}
+func (m *DeleteCheckinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteCheckinResponse.Marshal(b, m, deterministic)
+}
+func (dst *DeleteCheckinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteCheckinResponse.Merge(dst, src)
+}
+func (m *DeleteCheckinResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteCheckinResponse.Size(m)
+}
+func (m *DeleteCheckinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteCheckinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteCheckinResponse proto.InternalMessageInfo
+
+type RestoreCheckinRequest struct {
+	CheckinId            string   `protobuf:"bytes,1,opt,name=checkin_id,json=checkinId,proto3" json:"checkin_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreCheckinRequest) Reset()         { *m = RestoreCheckinRequest{} }
+func (m *RestoreCheckinRequest) String() string { return proto.CompactTextString(m) }
+func (*RestoreCheckinRequest) ProtoMessage()    {}
+func (*RestoreCheckinRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{162}
+}
+func (m *RestoreCheckinRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreCheckinRequest.Unmarshal(m, b)
+}
+func (m *RestoreCheckinRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreCheckinRequest.Marshal(b, m, deterministic)
+}
+func (dst *RestoreCheckinRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreCheckinRequest.Merge(dst, src)
+}
+func (m *RestoreCheckinRequest) XXX_Size() int {
+	return xxx_messageInfo_RestoreCheckinRequest.Size(m)
+}
+func (m *RestoreCheckinRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreCheckinRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreCheckinRequest proto.InternalMessageInfo
+
+func (m *RestoreCheckinRequest) GetCheckinId() string {
+	if m != nil {
+		return m.CheckinId
+	}
+	return ""
+}
+
+type RestoreCheckinResponse struct {
+	CheckIn              *Checkin `protobuf:"bytes,1,opt,name=check_in,json=checkIn,proto3" json:"check_in,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RestoreCheckinResponse) Reset()         { *m = RestoreCheckinResponse{} }
+func (m *RestoreCheckinResponse) String() string { return proto.CompactTextString(m) }
+func (*RestoreCheckinResponse) ProtoMessage()    {}
+func (*RestoreCheckinResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{163}
+}
+func (m *RestoreCheckinResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RestoreCheckinResponse.Unmarshal(m, b)
+}
+func (m *RestoreCheckinResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RestoreCheckinResponse.Marshal(b, m, deterministic)
+}
+func (dst *RestoreCheckinResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RestoreCheckinResponse.Merge(dst, src)
+}
+func (m *RestoreCheckinResponse) XXX_Size() int {
+	return xxx_messageInfo_RestoreCheckinResponse.Size(m)
+}
+func (m *RestoreCheckinResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RestoreCheckinResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RestoreCheckinResponse proto.InternalMessageInfo
+
+func (m *RestoreCheckinResponse) GetCheckIn() *Checkin {
+	if m != nil {
+		return m.CheckIn
+	}
+	return nil
+}
+
+type SyncCheckinsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncCheckinsRequest) Reset()         { *m = SyncCheckinsRequest{} }
+func (m *SyncCheckinsRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncCheckinsRequest) ProtoMessage()    {}
+func (*SyncCheckinsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{164}
+}
+func (m *SyncCheckinsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncCheckinsRequest.Unmarshal(m, b)
+}
+func (m *SyncCheckinsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncCheckinsRequest.Marshal(b, m, deterministic)
+}
+func (dst *SyncCheckinsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncCheckinsRequest.Merge(dst, src)
+}
+func (m *SyncCheckinsRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncCheckinsRequest.Size(m)
+}
+func (m *SyncCheckinsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncCheckinsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncCheckinsRequest proto.InternalMessageInfo
+
+type SyncCheckinsResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncCheckinsResponse) Reset()         { *m = SyncCheckinsResponse{} }
+func (m *SyncCheckinsResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncCheckinsResponse) ProtoMessage()    {}
+func (*SyncCheckinsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{165}
+}
+func (m *SyncCheckinsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncCheckinsResponse.Unmarshal(m, b)
+}
+func (m *SyncCheckinsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncCheckinsResponse.Marshal(b, m, deterministic)
+}
+func (dst *SyncCheckinsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncCheckinsResponse.Merge(dst, src)
+}
+func (m *SyncCheckinsResponse) XXX_Size() int {
+	return xxx_messageInfo_SyncCheckinsResponse.Size(m)
+}
+func (m *SyncCheckinsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncCheckinsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncCheckinsResponse proto.InternalMessageInfo
+
+type GetSheetAuthUrlRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSheetAuthUrlRequest) Reset()         { *m = GetSheetAuthUrlRequest{} }
+func (m *GetSheetAuthUrlRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSheetAuthUrlRequest) ProtoMessage()    {}
+func (*GetSheetAuthUrlRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{166}
+}
+func (m *GetSheetAuthUrlRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSheetAuthUrlRequest.Unmarshal(m, b)
+}
+func (m *GetSheetAuthUrlRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSheetAuthUrlRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetSheetAuthUrlRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSheetAuthUrlRequest.Merge(dst, src)
+}
+func (m *GetSheetAuthUrlRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSheetAuthUrlRequest.Size(m)
+}
+func (m *GetSheetAuthUrlRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSheetAuthUrlRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSheetAuthUrlRequest proto.InternalMessageInfo
+
+type GetSheetAuthUrlResponse struct {
+	Url                  string   `protobuf:"bytes,1,opt,name=url,proto3" json:"url,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSheetAuthUrlResponse) Reset()         { *m = GetSheetAuthUrlResponse{} }
+func (m *GetSheetAuthUrlResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSheetAuthUrlResponse) ProtoMessage()    {}
+func (*GetSheetAuthUrlResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{167}
+}
+func (m *GetSheetAuthUrlResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSheetAuthUrlResponse.Unmarshal(m, b)
+}
+func (m *GetSheetAuthUrlResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSheetAuthUrlResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetSheetAuthUrlResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSheetAuthUrlResponse.Merge(dst, src)
+}
+func (m *GetSheetAuthUrlResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSheetAuthUrlResponse.Size(m)
+}
+func (m *GetSheetAuthUrlResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSheetAuthUrlResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSheetAuthUrlResponse proto.InternalMessageInfo
+
+func (m *GetSheetAuthUrlResponse) GetUrl() string {
+	if m != nil {
+		return m.Url
+	}
+	return ""
+}
+
+type GetSheetConfigRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetSheetConfigRequest) Reset()         { *m = GetSheetConfigRequest{} }
+func (m *GetSheetConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GetSheetConfigRequest) ProtoMessage()    {}
+func (*GetSheetConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{168}
+}
+func (m *GetSheetConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSheetConfigRequest.Unmarshal(m, b)
+}
+func (m *GetSheetConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSheetConfigRequest.Marshal(b, m, deterministic)
+}
+func (dst *GetSheetConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSheetConfigRequest.Merge(dst, src)
+}
+func (m *GetSheetConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_GetSheetConfigRequest.Size(m)
+}
+func (m *GetSheetConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSheetConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSheetConfigRequest proto.InternalMessageInfo
+
+type GetSheetConfigResponse struct {
+	IngestionSpreadsheetId string   `protobuf:"bytes,1,opt,name=ingestion_spreadsheet_id,json=ingestionSpreadsheetId,proto3" json:"ingestion_spreadsheet_id,omitempty"`
+	PushSpreadsheetId      string   `protobuf:"bytes,2,opt,name=push_spreadsheet_id,json=pushSpreadsheetId,proto3" json:"push_spreadsheet_id,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *GetSheetConfigResponse) Reset()         { *m = GetSheetConfigResponse{} }
+func (m *GetSheetConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GetSheetConfigResponse) ProtoMessage()    {}
+func (*GetSheetConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{169}
+}
+func (m *GetSheetConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetSheetConfigResponse.Unmarshal(m, b)
+}
+func (m *GetSheetConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetSheetConfigResponse.Marshal(b, m, deterministic)
+}
+func (dst *GetSheetConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetSheetConfigResponse.Merge(dst, src)
+}
+func (m *GetSheetConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_GetSheetConfigResponse.Size(m)
+}
+func (m *GetSheetConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetSheetConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetSheetConfigResponse proto.InternalMessageInfo
+
+func (m *GetSheetConfigResponse) GetIngestionSpreadsheetId() string {
+	if m != nil {
+		return m.IngestionSpreadsheetId
+	}
+	return ""
+}
+
+func (m *GetSheetConfigResponse) GetPushSpreadsheetId() string {
+	if m != nil {
+		return m.PushSpreadsheetId
+	}
+	return ""
+}
+
+type SubmitSheetConfigRequest struct {
+	Code                   string   `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	IngestionSpreadsheetId string   `protobuf:"bytes,2,opt,name=ingestion_spreadsheet_id,json=ingestionSpreadsheetId,proto3" json:"ingestion_spreadsheet_id,omitempty"`
+	PushSpreadsheetId      string   `protobuf:"bytes,3,opt,name=push_spreadsheet_id,json=pushSpreadsheetId,proto3" json:"push_spreadsheet_id,omitempty"`
+	XXX_NoUnkeyedLiteral   struct{} `json:"-"`
+	XXX_unrecognized       []byte   `json:"-"`
+	XXX_sizecache          int32    `json:"-"`
+}
+
+func (m *SubmitSheetConfigRequest) Reset()         { *m = SubmitSheetConfigRequest{} }
+func (m *SubmitSheetConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitSheetConfigRequest) ProtoMessage()    {}
+func (*SubmitSheetConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{170}
+}
+func (m *SubmitSheetConfigRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitSheetConfigRequest.Unmarshal(m, b)
+}
+func (m *SubmitSheetConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitSheetConfigRequest.Marshal(b, m, deterministic)
+}
+func (dst *SubmitSheetConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitSheetConfigRequest.Merge(dst, src)
+}
+func (m *SubmitSheetConfigRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitSheetConfigRequest.Size(m)
+}
+func (m *SubmitSheetConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitSheetConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitSheetConfigRequest proto.InternalMessageInfo
+
+func (m *SubmitSheetConfigRequest) GetCode() string {
+	if m != nil {
+		return m.Code
+	}
+	return ""
+}
+
+func (m *SubmitSheetConfigRequest) GetIngestionSpreadsheetId() string {
+	if m != nil {
+		return m.IngestionSpreadsheetId
+	}
+	return ""
+}
+
+func (m *SubmitSheetConfigRequest) GetPushSpreadsheetId() string {
+	if m != nil {
+		return m.PushSpreadsheetId
+	}
+	return ""
+}
+
+type SubmitSheetConfigResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SubmitSheetConfigResponse) Reset()         { *m = SubmitSheetConfigResponse{} }
+func (m *SubmitSheetConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*SubmitSheetConfigResponse) ProtoMessage()    {}
+func (*SubmitSheetConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_robocup_c61a48116c66836a, []int{171}
+}
+func (m *SubmitSheetConfigResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitSheetConfigResponse.Unmarshal(m, b)
+}
+func (m *SubmitSheetConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitSheetConfigResponse.Marshal(b, m, deterministic)
+}
+func (dst *SubmitSheetConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitSheetConfigResponse.Merge(dst, src)
+}
+func (m *SubmitSheetConfigResponse) XXX_Size() int {
+	return xxx_messageInfo_SubmitSheetConfigResponse.Size(m)
+}
+func (m *SubmitSheetConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitSheetConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SubmitSheetConfigResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Division)(nil), "Division")
+	proto.RegisterType((*Competition)(nil), "Competition")
+	proto.RegisterType((*Institution)(nil), "Institution")
+	proto.RegisterType((*Member)(nil), "Member")
+	proto.RegisterType((*Team)(nil), "Team")
+	proto.RegisterType((*GetDivisionsRequest)(nil), "GetDivisionsRequest")
+	proto.RegisterType((*GetDivisionsResponse)(nil), "GetDivisionsResponse")
+	proto.RegisterType((*User)(nil), "User")
+	proto.RegisterType((*GetUsersRequest)(nil), "GetUsersRequest")
+	proto.RegisterType((*GetUsersResponse)(nil), "GetUsersResponse")
+	proto.RegisterType((*ScoreSheetTemplateSection)(nil), "ScoreSheetTemplateSection")
+	proto.RegisterType((*ScoreSheetTemplateSection_Level)(nil), "ScoreSheetTemplateSection.Level")
+	proto.RegisterType((*ScoreSheetTemplate)(nil), "ScoreSheetTemplate")
+	proto.RegisterType((*GetScoreSheetTemplatesRequest)(nil), "GetScoreSheetTemplatesRequest")
+	proto.RegisterType((*GetScoreSheetTemplatesRequest_QueryParameters)(nil), "GetScoreSheetTemplatesRequest.QueryParameters")
+	proto.RegisterType((*GetScoreSheetTemplatesResponse)(nil), "GetScoreSheetTemplatesResponse")
+	proto.RegisterType((*LoginRequest)(nil), "LoginRequest")
+	proto.RegisterType((*AuthProvider)(nil), "AuthProvider")
+	proto.RegisterType((*BulkCreateUsersRequest)(nil), "BulkCreateUsersRequest")
+	proto.RegisterType((*BulkUserResult)(nil), "BulkUserResult")
+	proto.RegisterType((*BulkCreateUsersResponse)(nil), "BulkCreateUsersResponse")
+	proto.RegisterType((*ChangePasswordRequest)(nil), "ChangePasswordRequest")
+	proto.RegisterType((*ChangePasswordResponse)(nil), "ChangePasswordResponse")
+	proto.RegisterType((*ResetUserPasswordRequest)(nil), "ResetUserPasswordRequest")
+	proto.RegisterType((*ResetUserPasswordResponse)(nil), "ResetUserPasswordResponse")
+	proto.RegisterType((*UserIdentity)(nil), "UserIdentity")
+	proto.RegisterType((*GetUserIdentitiesRequest)(nil), "GetUserIdentitiesRequest")
+	proto.RegisterType((*GetUserIdentitiesResponse)(nil), "GetUserIdentitiesResponse")
+	proto.RegisterType((*LinkUserIdentityRequest)(nil), "LinkUserIdentityRequest")
+	proto.RegisterType((*LinkUserIdentityResponse)(nil), "LinkUserIdentityResponse")
+	proto.RegisterType((*UnlinkUserIdentityRequest)(nil), "UnlinkUserIdentityRequest")
+	proto.RegisterType((*UnlinkUserIdentityResponse)(nil), "UnlinkUserIdentityResponse")
+	proto.RegisterType((*GetAuthProvidersRequest)(nil), "GetAuthProvidersRequest")
+	proto.RegisterType((*GetAuthProvidersResponse)(nil), "GetAuthProvidersResponse")
+	proto.RegisterType((*LoginResponse)(nil), "LoginResponse")
+	proto.RegisterType((*LogoutRequest)(nil), "LogoutRequest")
+	proto.RegisterType((*LogoutResponse)(nil), "LogoutResponse")
+	proto.RegisterType((*GetCurrentUserRequest)(nil), "GetCurrentUserRequest")
+	proto.RegisterType((*GetCurrentUserResponse)(nil), "GetCurrentUserResponse")
+	proto.RegisterType((*DivisionLadder)(nil), "DivisionLadder")
+	proto.RegisterType((*DivisionLadder_LadderEntry)(nil), "DivisionLadder.LadderEntry")
+	proto.RegisterType((*DivisionLadder_LadderEntry_RoundAverage)(nil), "DivisionLadder.LadderEntry.RoundAverage")
+	proto.RegisterType((*GetDanceLadderRequest)(nil), "GetDanceLadderRequest")
+	proto.RegisterType((*GetDanceLadderResponse)(nil), "GetDanceLadderResponse")
+	proto.RegisterType((*GetDivisionRequest)(nil), "GetDivisionRequest")
+	proto.RegisterType((*GetDivisionResponse)(nil), "GetDivisionResponse")
+	proto.RegisterType((*ScoreSheetSection)(nil), "ScoreSheetSection")
+	proto.RegisterType((*ScoreSheet)(nil), "ScoreSheet")
+	proto.RegisterType((*ScoreSheet_Timing)(nil), "ScoreSheet.Timing")
+	proto.RegisterType((*RoundLock)(nil), "RoundLock")
+	proto.RegisterType((*LockRoundRequest)(nil), "LockRoundRequest")
+	proto.RegisterType((*LockRoundResponse)(nil), "LockRoundResponse")
+	proto.RegisterType((*UnlockRoundRequest)(nil), "UnlockRoundRequest")
+	proto.RegisterType((*UnlockRoundResponse)(nil), "UnlockRoundResponse")
+	proto.RegisterType((*GetRoundLocksRequest)(nil), "GetRoundLocksRequest")
+	proto.RegisterType((*GetRoundLocksResponse)(nil), "GetRoundLocksResponse")
+	proto.RegisterType((*ScoreSheetRevision)(nil), "ScoreSheetRevision")
+	proto.RegisterType((*ScoreSheetRevision_SectionChange)(nil), "ScoreSheetRevision.SectionChange")
+	proto.RegisterType((*GetScoreSheetHistoryRequest)(nil), "GetScoreSheetHistoryRequest")
+	proto.RegisterType((*GetScoreSheetHistoryResponse)(nil), "GetScoreSheetHistoryResponse")
+	proto.RegisterType((*RestoreScoreSheetRevisionRequest)(nil), "RestoreScoreSheetRevisionRequest")
+	proto.RegisterType((*RestoreScoreSheetRevisionResponse)(nil), "RestoreScoreSheetRevisionResponse")
+	proto.RegisterType((*DuplicateScoreSheets)(nil), "DuplicateScoreSheets")
+	proto.RegisterType((*GetDuplicateScoreSheetsRequest)(nil), "GetDuplicateScoreSheetsRequest")
+	proto.RegisterType((*GetDuplicateScoreSheetsResponse)(nil), "GetDuplicateScoreSheetsResponse")
+	proto.RegisterType((*SyncScoreSheetsRequest)(nil), "SyncScoreSheetsRequest")
+	proto.RegisterType((*ScoreSheetSyncResult)(nil), "ScoreSheetSyncResult")
+	proto.RegisterType((*SyncScoreSheetsResponse)(nil), "SyncScoreSheetsResponse")
+	proto.RegisterType((*Checkin)(nil), "Checkin")
+	proto.RegisterType((*GetScoreSheetRequest)(nil), "GetScoreSheetRequest")
+	proto.RegisterType((*GetScoreSheetResponse)(nil), "GetScoreSheetResponse")
+	proto.RegisterType((*CreateScoreSheetRequest)(nil), "CreateScoreSheetRequest")
+	proto.RegisterType((*CreateScoreSheetResponse)(nil), "CreateScoreSheetResponse")
+	proto.RegisterType((*UpdateScoreSheetRequest)(nil), "UpdateScoreSheetRequest")
+	proto.RegisterType((*UpdateScoreSheetResponse)(nil), "UpdateScoreSheetResponse")
+	proto.RegisterType((*GetTeamRequest)(nil), "GetTeamRequest")
+	proto.RegisterType((*GetTeamResponse)(nil), "GetTeamResponse")
+	proto.RegisterType((*CreateTeamRequest)(nil), "CreateTeamRequest")
+	proto.RegisterType((*CreateTeamResponse)(nil), "CreateTeamResponse")
+	proto.RegisterType((*GetInstitutionsRequest)(nil), "GetInstitutionsRequest")
+	proto.RegisterType((*GetInstitutionsResponse)(nil), "GetInstitutionsResponse")
+	proto.RegisterType((*UpdateTeamRequest)(nil), "UpdateTeamRequest")
+	proto.RegisterType((*UpdateTeamResponse)(nil), "UpdateTeamResponse")
+	proto.RegisterType((*GetTeamsRequest)(nil), "GetTeamsRequest")
+	proto.RegisterType((*GetTeamsResponse)(nil), "GetTeamsResponse")
+	proto.RegisterType((*CreateDivisionRequest)(nil), "CreateDivisionRequest")
+	proto.RegisterType((*CreateDivisionResponse)(nil), "CreateDivisionResponse")
+	proto.RegisterType((*CreateScoreSheetTemplateRequest)(nil), "CreateScoreSheetTemplateRequest")
+	proto.RegisterType((*CreateScoreSheetTemplateResponse)(nil), "CreateScoreSheetTemplateResponse")
+	proto.RegisterType((*UpdateDivisionRequest)(nil), "UpdateDivisionRequest")
+	proto.RegisterType((*UpdateDivisionResponse)(nil), "UpdateDivisionResponse")
+	proto.RegisterType((*UpdateScoreSheetTemplateRequest)(nil), "UpdateScoreSheetTemplateRequest")
+	proto.RegisterType((*UpdateScoreSheetTemplateResponse)(nil), "UpdateScoreSheetTemplateResponse")
+	proto.RegisterType((*ExportScoreSheetTemplateRequest)(nil), "ExportScoreSheetTemplateRequest")
+	proto.RegisterType((*ExportScoreSheetTemplateResponse)(nil), "ExportScoreSheetTemplateResponse")
+	proto.RegisterType((*ImportScoreSheetTemplateRequest)(nil), "ImportScoreSheetTemplateRequest")
+	proto.RegisterType((*ImportScoreSheetTemplateResponse)(nil), "ImportScoreSheetTemplateResponse")
+	proto.RegisterType((*ScoreSheetMigration)(nil), "ScoreSheetMigration")
+	proto.RegisterType((*MigrateScoreSheetsRequest)(nil), "MigrateScoreSheetsRequest")
+	proto.RegisterType((*MigrateScoreSheetsResponse)(nil), "MigrateScoreSheetsResponse")
+	proto.RegisterType((*CreateUserRequest)(nil), "CreateUserRequest")
+	proto.RegisterType((*CreateUserResponse)(nil), "CreateUserResponse")
+	proto.RegisterType((*UpdateUserRequest)(nil), "UpdateUserRequest")
+	proto.RegisterType((*UpdateUserResponse)(nil), "UpdateUserResponse")
+	proto.RegisterType((*GetCheckinsRequest)(nil), "GetCheckinsRequest")
+	proto.RegisterType((*GetCheckinsResponse)(nil), "GetCheckinsResponse")
+	proto.RegisterType((*CreateCheckinRequest)(nil), "CreateCheckinRequest")
+	proto.RegisterType((*CreateCheckinResponse)(nil), "CreateCheckinResponse")
+	proto.RegisterType((*GetScoreSheetsRequest)(nil), "GetScoreSheetsRequest")
+	proto.RegisterType((*GetScoreSheetsResponse)(nil), "GetScoreSheetsResponse")
+	proto.RegisterType((*GetSheetTeamsRequest)(nil), "GetSheetTeamsRequest")
+	proto.RegisterType((*GetSheetTeamsResponse)(nil), "GetSheetTeamsResponse")
+	proto.RegisterType((*JudgeAssignment)(nil), "JudgeAssignment")
+	proto.RegisterType((*GetJudgeAssignmentsRequest)(nil), "GetJudgeAssignmentsRequest")
+	proto.RegisterType((*GetJudgeAssignmentsResponse)(nil), "GetJudgeAssignmentsResponse")
+	proto.RegisterType((*CreateJudgeAssignmentRequest)(nil), "CreateJudgeAssignmentRequest")
+	proto.RegisterType((*CreateJudgeAssignmentResponse)(nil), "CreateJudgeAssignmentResponse")
+	proto.RegisterType((*DeleteJudgeAssignmentRequest)(nil), "DeleteJudgeAssignmentRequest")
+	proto.RegisterType((*DeleteJudgeAssignmentResponse)(nil), "DeleteJudgeAssignmentResponse")
+	proto.RegisterType((*AuditEntry)(nil), "AuditEntry")
+	proto.RegisterType((*GetAuditLogRequest)(nil), "GetAuditLogRequest")
+	proto.RegisterType((*GetAuditLogResponse)(nil), "GetAuditLogResponse")
+	proto.RegisterType((*LoginLockout)(nil), "LoginLockout")
+	proto.RegisterType((*GetLoginLockoutsRequest)(nil), "GetLoginLockoutsRequest")
+	proto.RegisterType((*GetLoginLockoutsResponse)(nil), "GetLoginLockoutsResponse")
+	proto.RegisterType((*ClearLoginLockoutRequest)(nil), "ClearLoginLockoutRequest")
+	proto.RegisterType((*ClearLoginLockoutResponse)(nil), "ClearLoginLockoutResponse")
+	proto.RegisterType((*ApiKey)(nil), "ApiKey")
+	proto.RegisterType((*CreateApiKeyRequest)(nil), "CreateApiKeyRequest")
+	proto.RegisterType((*CreateApiKeyResponse)(nil), "CreateApiKeyResponse")
+	proto.RegisterType((*GetApiKeysRequest)(nil), "GetApiKeysRequest")
+	proto.RegisterType((*GetApiKeysResponse)(nil), "GetApiKeysResponse")
+	proto.RegisterType((*RevokeApiKeyRequest)(nil), "RevokeApiKeyRequest")
+	proto.RegisterType((*RevokeApiKeyResponse)(nil), "RevokeApiKeyResponse")
+	proto.RegisterType((*CreateCompetitionRequest)(nil), "CreateCompetitionRequest")
+	proto.RegisterType((*CreateCompetitionResponse)(nil), "CreateCompetitionResponse")
+	proto.RegisterType((*GetCompetitionsRequest)(nil), "GetCompetitionsRequest")
+	proto.RegisterType((*GetCompetitionsResponse)(nil), "GetCompetitionsResponse")
+	proto.RegisterType((*SelectCompetitionRequest)(nil), "SelectCompetitionRequest")
+	proto.RegisterType((*SelectCompetitionResponse)(nil), "SelectCompetitionResponse")
+	proto.RegisterType((*CloneCompetitionSetupRequest)(nil), "CloneCompetitionSetupRequest")
+	proto.RegisterType((*CloneCompetitionSetupResponse)(nil), "CloneCompetitionSetupResponse")
+	proto.RegisterType((*DeleteTeamRequest)(nil), "DeleteTeamRequest")
+	proto.RegisterType((*DeleteTeamResponse)(nil), "DeleteTeamResponse")
+	proto.RegisterType((*RestoreTeamRequest)(nil), "RestoreTeamRequest")
+	proto.RegisterType((*RestoreTeamResponse)(nil), "RestoreTeamResponse")
+	proto.RegisterType((*DeleteDivisionRequest)(nil), "DeleteDivisionRequest")
+	proto.RegisterType((*DeleteDivisionResponse)(nil), "DeleteDivisionResponse")
+	proto.RegisterType((*RestoreDivisionRequest)(nil), "RestoreDivisionRequest")
+	proto.RegisterType((*RestoreDivisionResponse)(nil), "RestoreDivisionResponse")
+	proto.RegisterType((*DeleteInstitutionRequest)(nil), "DeleteInstitutionRequest")
+	proto.RegisterType((*DeleteInstitutionResponse)(nil), "DeleteInstitutionResponse")
+	proto.RegisterType((*RestoreInstitutionRequest)(nil), "RestoreInstitutionRequest")
+	proto.RegisterType((*RestoreInstitutionResponse)(nil), "RestoreInstitutionResponse")
+	proto.RegisterType((*DeleteUserRequest)(nil), "DeleteUserRequest")
+	proto.RegisterType((*DeleteUserResponse)(nil), "DeleteUserResponse")
+	proto.RegisterType((*RestoreUserRequest)(nil), "RestoreUserRequest")
+	proto.RegisterType((*RestoreUserResponse)(nil), "RestoreUserResponse")
+	proto.RegisterType((*DeleteScoreSheetRequest)(nil), "DeleteScoreSheetRequest")
+	proto.RegisterType((*DeleteScoreSheetResponse)(nil), "DeleteScoreSheetResponse")
+	proto.RegisterType((*RestoreScoreSheetRequest)(nil), "RestoreScoreSheetRequest")
+	proto.RegisterType((*RestoreScoreSheetResponse)(nil), "RestoreScoreSheetResponse")
+	proto.RegisterType((*DeleteScoreSheetTemplateRequest)(nil), "DeleteScoreSheetTemplateRequest")
+	proto.RegisterType((*DeleteScoreSheetTemplateResponse)(nil), "DeleteScoreSheetTemplateResponse")
+	proto.RegisterType((*RestoreScoreSheetTemplateRequest)(nil), "RestoreScoreSheetTemplateRequest")
+	proto.RegisterType((*RestoreScoreSheetTemplateResponse)(nil), "RestoreScoreSheetTemplateResponse")
+	proto.RegisterType((*DeleteCheckinRequest)(nil), "DeleteCheckinRequest")
+	proto.RegisterType((*DeleteCheckinResponse)(nil), "DeleteCheckinResponse")
+	proto.RegisterType((*RestoreCheckinRequest)(nil), "RestoreCheckinRequest")
+	proto.RegisterType((*RestoreCheckinResponse)(nil), "RestoreCheckinResponse")
+	proto.RegisterType((*SyncCheckinsRequest)(nil), "SyncCheckinsRequest")
+	proto.RegisterType((*SyncCheckinsResponse)(nil), "SyncCheckinsResponse")
+	proto.RegisterType((*GetSheetAuthUrlRequest)(nil), "GetSheetAuthUrlRequest")
+	proto.RegisterType((*GetSheetAuthUrlResponse)(nil), "GetSheetAuthUrlResponse")
+	proto.RegisterType((*GetSheetConfigRequest)(nil), "GetSheetConfigRequest")
+	proto.RegisterType((*GetSheetConfigResponse)(nil), "GetSheetConfigResponse")
+	proto.RegisterType((*SubmitSheetConfigRequest)(nil), "SubmitSheetConfigRequest")
+	proto.RegisterType((*SubmitSheetConfigResponse)(nil), "SubmitSheetConfigResponse")
+	proto.RegisterEnum("TemplateFormat", TemplateFormat_name, TemplateFormat_value)
+	proto.RegisterEnum("Division_League", Division_League_name, Division_League_value)
+	proto.RegisterEnum("Member_Gender", Member_Gender_name, Member_Gender_value)
+	proto.RegisterEnum("User_Role", User_Role_name, User_Role_value)
+	proto.RegisterEnum("ScoreSheetTemplateSection_Kind", ScoreSheetTemplateSection_Kind_name, ScoreSheetTemplateSection_Kind_value)
+	proto.RegisterEnum("ScoreSheetTemplate_Type", ScoreSheetTemplate_Type_name, ScoreSheetTemplate_Type_value)
+	proto.RegisterEnum("AuthProvider_Type", AuthProvider_Type_name, AuthProvider_Type_value)
+	proto.RegisterEnum("ScoreSheet_Status", ScoreSheet_Status_name, ScoreSheet_Status_value)
+	proto.RegisterEnum("ScoreSheetSyncResult_Outcome", ScoreSheetSyncResult_Outcome_name, ScoreSheetSyncResult_Outcome_value)
+	proto.RegisterEnum("ApiKey_Scope", ApiKey_Scope_name, ApiKey_Scope_value)
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RobocupClient is the client API for Robocup service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RobocupClient interface {
+	Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error)
+	Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error)
+	GetAuthProviders(ctx context.Context, in *GetAuthProvidersRequest, opts ...grpc.CallOption) (*GetAuthProvidersResponse, error)
+	GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error)
+	GetDanceLadder(ctx context.Context, in *GetDanceLadderRequest, opts ...grpc.CallOption) (*GetDanceLadderResponse, error)
+	GetDivision(ctx context.Context, in *GetDivisionRequest, opts ...grpc.CallOption) (*GetDivisionResponse, error)
+	GetScoreSheet(ctx context.Context, in *GetScoreSheetRequest, opts ...grpc.CallOption) (*GetScoreSheetResponse, error)
+	CreateScoreSheet(ctx context.Context, in *CreateScoreSheetRequest, opts ...grpc.CallOption) (*CreateScoreSheetResponse, error)
+	UpdateScoreSheet(ctx context.Context, in *UpdateScoreSheetRequest, opts ...grpc.CallOption) (*UpdateScoreSheetResponse, error)
+	GetDivisions(ctx context.Context, in *GetDivisionsRequest, opts ...grpc.CallOption) (*GetDivisionsResponse, error)
+	GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error)
+	GetScoreSheetTemplates(ctx context.Context, in *GetScoreSheetTemplatesRequest, opts ...grpc.CallOption) (*GetScoreSheetTemplatesResponse, error)
+	GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error)
+	CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error)
+	GetInstitutions(ctx context.Context, in *GetInstitutionsRequest, opts ...grpc.CallOption) (*GetInstitutionsResponse, error)
+	UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*UpdateTeamResponse, error)
+	GetTeams(ctx context.Context, in *GetTeamsRequest, opts ...grpc.CallOption) (*GetTeamsResponse, error)
+	CreateDivision(ctx context.Context, in *CreateDivisionRequest, opts ...grpc.CallOption) (*CreateDivisionResponse, error)
+	CreateScoreSheetTemplate(ctx context.Context, in *CreateScoreSheetTemplateRequest, opts ...grpc.CallOption) (*CreateScoreSheetTemplateResponse, error)
+	UpdateDivision(ctx context.Context, in *UpdateDivisionRequest, opts ...grpc.CallOption) (*UpdateDivisionResponse, error)
+	UpdateScoreSheetTemplate(ctx context.Context, in *UpdateScoreSheetTemplateRequest, opts ...grpc.CallOption) (*UpdateScoreSheetTemplateResponse, error)
+	MigrateScoreSheets(ctx context.Context, in *MigrateScoreSheetsRequest, opts ...grpc.CallOption) (*MigrateScoreSheetsResponse, error)
+	LockRound(ctx context.Context, in *LockRoundRequest, opts ...grpc.CallOption) (*LockRoundResponse, error)
+	UnlockRound(ctx context.Context, in *UnlockRoundRequest, opts ...grpc.CallOption) (*UnlockRoundResponse, error)
+	GetRoundLocks(ctx context.Context, in *GetRoundLocksRequest, opts ...grpc.CallOption) (*GetRoundLocksResponse, error)
+	GetScoreSheetHistory(ctx context.Context, in *GetScoreSheetHistoryRequest, opts ...grpc.CallOption) (*GetScoreSheetHistoryResponse, error)
+	RestoreScoreSheetRevision(ctx context.Context, in *RestoreScoreSheetRevisionRequest, opts ...grpc.CallOption) (*RestoreScoreSheetRevisionResponse, error)
+	GetDuplicateScoreSheets(ctx context.Context, in *GetDuplicateScoreSheetsRequest, opts ...grpc.CallOption) (*GetDuplicateScoreSheetsResponse, error)
+	SyncScoreSheets(ctx context.Context, in *SyncScoreSheetsRequest, opts ...grpc.CallOption) (*SyncScoreSheetsResponse, error)
+	ExportScoreSheetTemplate(ctx context.Context, in *ExportScoreSheetTemplateRequest, opts ...grpc.CallOption) (*ExportScoreSheetTemplateResponse, error)
+	ImportScoreSheetTemplate(ctx context.Context, in *ImportScoreSheetTemplateRequest, opts ...grpc.CallOption) (*ImportScoreSheetTemplateResponse, error)
+	CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error)
+	UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error)
+	BulkCreateUsers(ctx context.Context, in *BulkCreateUsersRequest, opts ...grpc.CallOption) (*BulkCreateUsersResponse, error)
+	ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error)
+	ResetUserPassword(ctx context.Context, in *ResetUserPasswordRequest, opts ...grpc.CallOption) (*ResetUserPasswordResponse, error)
+	GetUserIdentities(ctx context.Context, in *GetUserIdentitiesRequest, opts ...grpc.CallOption) (*GetUserIdentitiesResponse, error)
+	LinkUserIdentity(ctx context.Context, in *LinkUserIdentityRequest, opts ...grpc.CallOption) (*LinkUserIdentityResponse, error)
+	UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityRequest, opts ...grpc.CallOption) (*UnlinkUserIdentityResponse, error)
+	GetCheckins(ctx context.Context, in *GetCheckinsRequest, opts ...grpc.CallOption) (*GetCheckinsResponse, error)
+	CreateCheckin(ctx context.Context, in *CreateCheckinRequest, opts ...grpc.CallOption) (*CreateCheckinResponse, error)
+	GetScoreSheets(ctx context.Context, in *GetScoreSheetsRequest, opts ...grpc.CallOption) (*GetScoreSheetsResponse, error)
+	GetSheetTeams(ctx context.Context, in *GetSheetTeamsRequest, opts ...grpc.CallOption) (*GetSheetTeamsResponse, error)
+	SyncCheckins(ctx context.Context, in *SyncCheckinsRequest, opts ...grpc.CallOption) (*SyncCheckinsResponse, error)
+	GetSheetAuthUrl(ctx context.Context, in *GetSheetAuthUrlRequest, opts ...grpc.CallOption) (*GetSheetAuthUrlResponse, error)
+	GetSheetConfig(ctx context.Context, in *GetSheetConfigRequest, opts ...grpc.CallOption) (*GetSheetConfigResponse, error)
+	SubmitSheetConfig(ctx context.Context, in *SubmitSheetConfigRequest, opts ...grpc.CallOption) (*SubmitSheetConfigResponse, error)
+	GetJudgeAssignments(ctx context.Context, in *GetJudgeAssignmentsRequest, opts ...grpc.CallOption) (*GetJudgeAssignmentsResponse, error)
+	CreateJudgeAssignment(ctx context.Context, in *CreateJudgeAssignmentRequest, opts ...grpc.CallOption) (*CreateJudgeAssignmentResponse, error)
+	DeleteJudgeAssignment(ctx context.Context, in *DeleteJudgeAssignmentRequest, opts ...grpc.CallOption) (*DeleteJudgeAssignmentResponse, error)
+	GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error)
+	GetLoginLockouts(ctx context.Context, in *GetLoginLockoutsRequest, opts ...grpc.CallOption) (*GetLoginLockoutsResponse, error)
+	ClearLoginLockout(ctx context.Context, in *ClearLoginLockoutRequest, opts ...grpc.CallOption) (*ClearLoginLockoutResponse, error)
+	CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error)
+	GetApiKeys(ctx context.Context, in *GetApiKeysRequest, opts ...grpc.CallOption) (*GetApiKeysResponse, error)
+	RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error)
+	CreateCompetition(ctx context.Context, in *CreateCompetitionRequest, opts ...grpc.CallOption) (*CreateCompetitionResponse, error)
+	GetCompetitions(ctx context.Context, in *GetCompetitionsRequest, opts ...grpc.CallOption) (*GetCompetitionsResponse, error)
+	SelectCompetition(ctx context.Context, in *SelectCompetitionRequest, opts ...grpc.CallOption) (*SelectCompetitionResponse, error)
+	CloneCompetitionSetup(ctx context.Context, in *CloneCompetitionSetupRequest, opts ...grpc.CallOption) (*CloneCompetitionSetupResponse, error)
+	DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error)
+	RestoreTeam(ctx context.Context, in *RestoreTeamRequest, opts ...grpc.CallOption) (*RestoreTeamResponse, error)
+	DeleteDivision(ctx context.Context, in *DeleteDivisionRequest, opts ...grpc.CallOption) (*DeleteDivisionResponse, error)
+	RestoreDivision(ctx context.Context, in *RestoreDivisionRequest, opts ...grpc.CallOption) (*RestoreDivisionResponse, error)
+	DeleteInstitution(ctx context.Context, in *DeleteInstitutionRequest, opts ...grpc.CallOption) (*DeleteInstitutionResponse, error)
+	RestoreInstitution(ctx context.Context, in *RestoreInstitutionRequest, opts ...grpc.CallOption) (*RestoreInstitutionResponse, error)
+	DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error)
+	RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error)
+	DeleteScoreSheet(ctx context.Context, in *DeleteScoreSheetRequest, opts ...grpc.CallOption) (*DeleteScoreSheetResponse, error)
+	RestoreScoreSheet(ctx context.Context, in *RestoreScoreSheetRequest, opts ...grpc.CallOption) (*RestoreScoreSheetResponse, error)
+	DeleteScoreSheetTemplate(ctx context.Context, in *DeleteScoreSheetTemplateRequest, opts ...grpc.CallOption) (*DeleteScoreSheetTemplateResponse, error)
+	RestoreScoreSheetTemplate(ctx context.Context, in *RestoreScoreSheetTemplateRequest, opts ...grpc.CallOption) (*RestoreScoreSheetTemplateResponse, error)
+	DeleteCheckin(ctx context.Context, in *DeleteCheckinRequest, opts ...grpc.CallOption) (*DeleteCheckinResponse, error)
+	RestoreCheckin(ctx context.Context, in *RestoreCheckinRequest, opts ...grpc.CallOption) (*RestoreCheckinResponse, error)
+}
+
+type robocupClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewRobocupClient(cc *grpc.ClientConn) RobocupClient {
+	return &robocupClient{cc}
+}
+
+func (c *robocupClient) Login(ctx context.Context, in *LoginRequest, opts ...grpc.CallOption) (*LoginResponse, error) {
+	out := new(LoginResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/Login", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) Logout(ctx context.Context, in *LogoutRequest, opts ...grpc.CallOption) (*LogoutResponse, error) {
+	out := new(LogoutResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/Logout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetAuthProviders(ctx context.Context, in *GetAuthProvidersRequest, opts ...grpc.CallOption) (*GetAuthProvidersResponse, error) {
+	out := new(GetAuthProvidersResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetAuthProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetCurrentUser(ctx context.Context, in *GetCurrentUserRequest, opts ...grpc.CallOption) (*GetCurrentUserResponse, error) {
+	out := new(GetCurrentUserResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetCurrentUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetDanceLadder(ctx context.Context, in *GetDanceLadderRequest, opts ...grpc.CallOption) (*GetDanceLadderResponse, error) {
+	out := new(GetDanceLadderResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetDanceLadder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetDivision(ctx context.Context, in *GetDivisionRequest, opts ...grpc.CallOption) (*GetDivisionResponse, error) {
+	out := new(GetDivisionResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetDivision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetScoreSheet(ctx context.Context, in *GetScoreSheetRequest, opts ...grpc.CallOption) (*GetScoreSheetResponse, error) {
+	out := new(GetScoreSheetResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetScoreSheet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) CreateScoreSheet(ctx context.Context, in *CreateScoreSheetRequest, opts ...grpc.CallOption) (*CreateScoreSheetResponse, error) {
+	out := new(CreateScoreSheetResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/CreateScoreSheet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) UpdateScoreSheet(ctx context.Context, in *UpdateScoreSheetRequest, opts ...grpc.CallOption) (*UpdateScoreSheetResponse, error) {
+	out := new(UpdateScoreSheetResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/UpdateScoreSheet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetDivisions(ctx context.Context, in *GetDivisionsRequest, opts ...grpc.CallOption) (*GetDivisionsResponse, error) {
+	out := new(GetDivisionsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetDivisions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetUsers(ctx context.Context, in *GetUsersRequest, opts ...grpc.CallOption) (*GetUsersResponse, error) {
+	out := new(GetUsersResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetScoreSheetTemplates(ctx context.Context, in *GetScoreSheetTemplatesRequest, opts ...grpc.CallOption) (*GetScoreSheetTemplatesResponse, error) {
+	out := new(GetScoreSheetTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetScoreSheetTemplates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetTeam(ctx context.Context, in *GetTeamRequest, opts ...grpc.CallOption) (*GetTeamResponse, error) {
+	out := new(GetTeamResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) CreateTeam(ctx context.Context, in *CreateTeamRequest, opts ...grpc.CallOption) (*CreateTeamResponse, error) {
+	out := new(CreateTeamResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/CreateTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetInstitutions(ctx context.Context, in *GetInstitutionsRequest, opts ...grpc.CallOption) (*GetInstitutionsResponse, error) {
+	out := new(GetInstitutionsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetInstitutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) UpdateTeam(ctx context.Context, in *UpdateTeamRequest, opts ...grpc.CallOption) (*UpdateTeamResponse, error) {
+	out := new(UpdateTeamResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/UpdateTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetTeams(ctx context.Context, in *GetTeamsRequest, opts ...grpc.CallOption) (*GetTeamsResponse, error) {
+	out := new(GetTeamsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetTeams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) CreateDivision(ctx context.Context, in *CreateDivisionRequest, opts ...grpc.CallOption) (*CreateDivisionResponse, error) {
+	out := new(CreateDivisionResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/CreateDivision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) CreateScoreSheetTemplate(ctx context.Context, in *CreateScoreSheetTemplateRequest, opts ...grpc.CallOption) (*CreateScoreSheetTemplateResponse, error) {
+	out := new(CreateScoreSheetTemplateResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/CreateScoreSheetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) UpdateDivision(ctx context.Context, in *UpdateDivisionRequest, opts ...grpc.CallOption) (*UpdateDivisionResponse, error) {
+	out := new(UpdateDivisionResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/UpdateDivision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) UpdateScoreSheetTemplate(ctx context.Context, in *UpdateScoreSheetTemplateRequest, opts ...grpc.CallOption) (*UpdateScoreSheetTemplateResponse, error) {
+	out := new(UpdateScoreSheetTemplateResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/UpdateScoreSheetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) MigrateScoreSheets(ctx context.Context, in *MigrateScoreSheetsRequest, opts ...grpc.CallOption) (*MigrateScoreSheetsResponse, error) {
+	out := new(MigrateScoreSheetsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/MigrateScoreSheets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) LockRound(ctx context.Context, in *LockRoundRequest, opts ...grpc.CallOption) (*LockRoundResponse, error) {
+	out := new(LockRoundResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/LockRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) UnlockRound(ctx context.Context, in *UnlockRoundRequest, opts ...grpc.CallOption) (*UnlockRoundResponse, error) {
+	out := new(UnlockRoundResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/UnlockRound", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetRoundLocks(ctx context.Context, in *GetRoundLocksRequest, opts ...grpc.CallOption) (*GetRoundLocksResponse, error) {
+	out := new(GetRoundLocksResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetRoundLocks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetScoreSheetHistory(ctx context.Context, in *GetScoreSheetHistoryRequest, opts ...grpc.CallOption) (*GetScoreSheetHistoryResponse, error) {
+	out := new(GetScoreSheetHistoryResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetScoreSheetHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) RestoreScoreSheetRevision(ctx context.Context, in *RestoreScoreSheetRevisionRequest, opts ...grpc.CallOption) (*RestoreScoreSheetRevisionResponse, error) {
+	out := new(RestoreScoreSheetRevisionResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/RestoreScoreSheetRevision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetDuplicateScoreSheets(ctx context.Context, in *GetDuplicateScoreSheetsRequest, opts ...grpc.CallOption) (*GetDuplicateScoreSheetsResponse, error) {
+	out := new(GetDuplicateScoreSheetsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetDuplicateScoreSheets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) SyncScoreSheets(ctx context.Context, in *SyncScoreSheetsRequest, opts ...grpc.CallOption) (*SyncScoreSheetsResponse, error) {
+	out := new(SyncScoreSheetsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/SyncScoreSheets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) ExportScoreSheetTemplate(ctx context.Context, in *ExportScoreSheetTemplateRequest, opts ...grpc.CallOption) (*ExportScoreSheetTemplateResponse, error) {
+	out := new(ExportScoreSheetTemplateResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/ExportScoreSheetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) ImportScoreSheetTemplate(ctx context.Context, in *ImportScoreSheetTemplateRequest, opts ...grpc.CallOption) (*ImportScoreSheetTemplateResponse, error) {
+	out := new(ImportScoreSheetTemplateResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/ImportScoreSheetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) CreateUser(ctx context.Context, in *CreateUserRequest, opts ...grpc.CallOption) (*CreateUserResponse, error) {
+	out := new(CreateUserResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/CreateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) UpdateUser(ctx context.Context, in *UpdateUserRequest, opts ...grpc.CallOption) (*UpdateUserResponse, error) {
+	out := new(UpdateUserResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/UpdateUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) BulkCreateUsers(ctx context.Context, in *BulkCreateUsersRequest, opts ...grpc.CallOption) (*BulkCreateUsersResponse, error) {
+	out := new(BulkCreateUsersResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/BulkCreateUsers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) ChangePassword(ctx context.Context, in *ChangePasswordRequest, opts ...grpc.CallOption) (*ChangePasswordResponse, error) {
+	out := new(ChangePasswordResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/ChangePassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) ResetUserPassword(ctx context.Context, in *ResetUserPasswordRequest, opts ...grpc.CallOption) (*ResetUserPasswordResponse, error) {
+	out := new(ResetUserPasswordResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/ResetUserPassword", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetUserIdentities(ctx context.Context, in *GetUserIdentitiesRequest, opts ...grpc.CallOption) (*GetUserIdentitiesResponse, error) {
+	out := new(GetUserIdentitiesResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetUserIdentities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) LinkUserIdentity(ctx context.Context, in *LinkUserIdentityRequest, opts ...grpc.CallOption) (*LinkUserIdentityResponse, error) {
+	out := new(LinkUserIdentityResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/LinkUserIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) UnlinkUserIdentity(ctx context.Context, in *UnlinkUserIdentityRequest, opts ...grpc.CallOption) (*UnlinkUserIdentityResponse, error) {
+	out := new(UnlinkUserIdentityResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/UnlinkUserIdentity", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetCheckins(ctx context.Context, in *GetCheckinsRequest, opts ...grpc.CallOption) (*GetCheckinsResponse, error) {
+	out := new(GetCheckinsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetCheckins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) CreateCheckin(ctx context.Context, in *CreateCheckinRequest, opts ...grpc.CallOption) (*CreateCheckinResponse, error) {
+	out := new(CreateCheckinResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/CreateCheckin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetScoreSheets(ctx context.Context, in *GetScoreSheetsRequest, opts ...grpc.CallOption) (*GetScoreSheetsResponse, error) {
+	out := new(GetScoreSheetsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetScoreSheets", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetSheetTeams(ctx context.Context, in *GetSheetTeamsRequest, opts ...grpc.CallOption) (*GetSheetTeamsResponse, error) {
+	out := new(GetSheetTeamsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetSheetTeams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) SyncCheckins(ctx context.Context, in *SyncCheckinsRequest, opts ...grpc.CallOption) (*SyncCheckinsResponse, error) {
+	out := new(SyncCheckinsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/SyncCheckins", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetSheetAuthUrl(ctx context.Context, in *GetSheetAuthUrlRequest, opts ...grpc.CallOption) (*GetSheetAuthUrlResponse, error) {
+	out := new(GetSheetAuthUrlResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetSheetAuthUrl", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetSheetConfig(ctx context.Context, in *GetSheetConfigRequest, opts ...grpc.CallOption) (*GetSheetConfigResponse, error) {
+	out := new(GetSheetConfigResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetSheetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) SubmitSheetConfig(ctx context.Context, in *SubmitSheetConfigRequest, opts ...grpc.CallOption) (*SubmitSheetConfigResponse, error) {
+	out := new(SubmitSheetConfigResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/SubmitSheetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetJudgeAssignments(ctx context.Context, in *GetJudgeAssignmentsRequest, opts ...grpc.CallOption) (*GetJudgeAssignmentsResponse, error) {
+	out := new(GetJudgeAssignmentsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetJudgeAssignments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) CreateJudgeAssignment(ctx context.Context, in *CreateJudgeAssignmentRequest, opts ...grpc.CallOption) (*CreateJudgeAssignmentResponse, error) {
+	out := new(CreateJudgeAssignmentResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/CreateJudgeAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) DeleteJudgeAssignment(ctx context.Context, in *DeleteJudgeAssignmentRequest, opts ...grpc.CallOption) (*DeleteJudgeAssignmentResponse, error) {
+	out := new(DeleteJudgeAssignmentResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/DeleteJudgeAssignment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetAuditLog(ctx context.Context, in *GetAuditLogRequest, opts ...grpc.CallOption) (*GetAuditLogResponse, error) {
+	out := new(GetAuditLogResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetAuditLog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetLoginLockouts(ctx context.Context, in *GetLoginLockoutsRequest, opts ...grpc.CallOption) (*GetLoginLockoutsResponse, error) {
+	out := new(GetLoginLockoutsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetLoginLockouts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) ClearLoginLockout(ctx context.Context, in *ClearLoginLockoutRequest, opts ...grpc.CallOption) (*ClearLoginLockoutResponse, error) {
+	out := new(ClearLoginLockoutResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/ClearLoginLockout", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) CreateApiKey(ctx context.Context, in *CreateApiKeyRequest, opts ...grpc.CallOption) (*CreateApiKeyResponse, error) {
+	out := new(CreateApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/CreateApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetApiKeys(ctx context.Context, in *GetApiKeysRequest, opts ...grpc.CallOption) (*GetApiKeysResponse, error) {
+	out := new(GetApiKeysResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetApiKeys", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) RevokeApiKey(ctx context.Context, in *RevokeApiKeyRequest, opts ...grpc.CallOption) (*RevokeApiKeyResponse, error) {
+	out := new(RevokeApiKeyResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/RevokeApiKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) CreateCompetition(ctx context.Context, in *CreateCompetitionRequest, opts ...grpc.CallOption) (*CreateCompetitionResponse, error) {
+	out := new(CreateCompetitionResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/CreateCompetition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) GetCompetitions(ctx context.Context, in *GetCompetitionsRequest, opts ...grpc.CallOption) (*GetCompetitionsResponse, error) {
+	out := new(GetCompetitionsResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/GetCompetitions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) SelectCompetition(ctx context.Context, in *SelectCompetitionRequest, opts ...grpc.CallOption) (*SelectCompetitionResponse, error) {
+	out := new(SelectCompetitionResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/SelectCompetition", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) CloneCompetitionSetup(ctx context.Context, in *CloneCompetitionSetupRequest, opts ...grpc.CallOption) (*CloneCompetitionSetupResponse, error) {
+	out := new(CloneCompetitionSetupResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/CloneCompetitionSetup", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) DeleteTeam(ctx context.Context, in *DeleteTeamRequest, opts ...grpc.CallOption) (*DeleteTeamResponse, error) {
+	out := new(DeleteTeamResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/DeleteTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) RestoreTeam(ctx context.Context, in *RestoreTeamRequest, opts ...grpc.CallOption) (*RestoreTeamResponse, error) {
+	out := new(RestoreTeamResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/RestoreTeam", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) DeleteDivision(ctx context.Context, in *DeleteDivisionRequest, opts ...grpc.CallOption) (*DeleteDivisionResponse, error) {
+	out := new(DeleteDivisionResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/DeleteDivision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) RestoreDivision(ctx context.Context, in *RestoreDivisionRequest, opts ...grpc.CallOption) (*RestoreDivisionResponse, error) {
+	out := new(RestoreDivisionResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/RestoreDivision", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) DeleteInstitution(ctx context.Context, in *DeleteInstitutionRequest, opts ...grpc.CallOption) (*DeleteInstitutionResponse, error) {
+	out := new(DeleteInstitutionResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/DeleteInstitution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) RestoreInstitution(ctx context.Context, in *RestoreInstitutionRequest, opts ...grpc.CallOption) (*RestoreInstitutionResponse, error) {
+	out := new(RestoreInstitutionResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/RestoreInstitution", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) DeleteUser(ctx context.Context, in *DeleteUserRequest, opts ...grpc.CallOption) (*DeleteUserResponse, error) {
+	out := new(DeleteUserResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/DeleteUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) RestoreUser(ctx context.Context, in *RestoreUserRequest, opts ...grpc.CallOption) (*RestoreUserResponse, error) {
+	out := new(RestoreUserResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/RestoreUser", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) DeleteScoreSheet(ctx context.Context, in *DeleteScoreSheetRequest, opts ...grpc.CallOption) (*DeleteScoreSheetResponse, error) {
+	out := new(DeleteScoreSheetResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/DeleteScoreSheet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) RestoreScoreSheet(ctx context.Context, in *RestoreScoreSheetRequest, opts ...grpc.CallOption) (*RestoreScoreSheetResponse, error) {
+	out := new(RestoreScoreSheetResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/RestoreScoreSheet", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) DeleteScoreSheetTemplate(ctx context.Context, in *DeleteScoreSheetTemplateRequest, opts ...grpc.CallOption) (*DeleteScoreSheetTemplateResponse, error) {
+	out := new(DeleteScoreSheetTemplateResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/DeleteScoreSheetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) RestoreScoreSheetTemplate(ctx context.Context, in *RestoreScoreSheetTemplateRequest, opts ...grpc.CallOption) (*RestoreScoreSheetTemplateResponse, error) {
+	out := new(RestoreScoreSheetTemplateResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/RestoreScoreSheetTemplate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) DeleteCheckin(ctx context.Context, in *DeleteCheckinRequest, opts ...grpc.CallOption) (*DeleteCheckinResponse, error) {
+	out := new(DeleteCheckinResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/DeleteCheckin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *robocupClient) RestoreCheckin(ctx context.Context, in *RestoreCheckinRequest, opts ...grpc.CallOption) (*RestoreCheckinResponse, error) {
+	out := new(RestoreCheckinResponse)
+	err := c.cc.Invoke(ctx, "/Robocup/RestoreCheckin", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RobocupServer is the server API for Robocup service.
+type RobocupServer interface {
 	Login(context.Context, *LoginRequest) (*LoginResponse, error)
+	Logout(context.Context, *LogoutRequest) (*LogoutResponse, error)
+	GetAuthProviders(context.Context, *GetAuthProvidersRequest) (*GetAuthProvidersResponse, error)
 	GetCurrentUser(context.Context, *GetCurrentUserRequest) (*GetCurrentUserResponse, error)
 	GetDanceLadder(context.Context, *GetDanceLadderRequest) (*GetDanceLadderResponse, error)
 	GetDivision(context.Context, *GetDivisionRequest) (*GetDivisionResponse, error)
@@ -3585,8 +9526,26 @@ type RobocupServer interface {
 	GetTeams(context.Context, *GetTeamsRequest) (*GetTeamsResponse, error)
 	CreateDivision(context.Context, *CreateDivisionRequest) (*CreateDivisionResponse, error)
 	CreateScoreSheetTemplate(context.Context, *CreateScoreSheetTemplateRequest) (*CreateScoreSheetTemplateResponse, error)
+	UpdateDivision(context.Context, *UpdateDivisionRequest) (*UpdateDivisionResponse, error)
+	UpdateScoreSheetTemplate(context.Context, *UpdateScoreSheetTemplateRequest) (*UpdateScoreSheetTemplateResponse, error)
+	MigrateScoreSheets(context.Context, *MigrateScoreSheetsRequest) (*MigrateScoreSheetsResponse, error)
+	LockRound(context.Context, *LockRoundRequest) (*LockRoundResponse, error)
+	UnlockRound(context.Context, *UnlockRoundRequest) (*UnlockRoundResponse, error)
+	GetRoundLocks(context.Context, *GetRoundLocksRequest) (*GetRoundLocksResponse, error)
+	GetScoreSheetHistory(context.Context, *GetScoreSheetHistoryRequest) (*GetScoreSheetHistoryResponse, error)
+	RestoreScoreSheetRevision(context.Context, *RestoreScoreSheetRevisionRequest) (*RestoreScoreSheetRevisionResponse, error)
+	GetDuplicateScoreSheets(context.Context, *GetDuplicateScoreSheetsRequest) (*GetDuplicateScoreSheetsResponse, error)
+	SyncScoreSheets(context.Context, *SyncScoreSheetsRequest) (*SyncScoreSheetsResponse, error)
+	ExportScoreSheetTemplate(context.Context, *ExportScoreSheetTemplateRequest) (*ExportScoreSheetTemplateResponse, error)
+	ImportScoreSheetTemplate(context.Context, *ImportScoreSheetTemplateRequest) (*ImportScoreSheetTemplateResponse, error)
 	CreateUser(context.Context, *CreateUserRequest) (*CreateUserResponse, error)
 	UpdateUser(context.Context, *UpdateUserRequest) (*UpdateUserResponse, error)
+	BulkCreateUsers(context.Context, *BulkCreateUsersRequest) (*BulkCreateUsersResponse, error)
+	ChangePassword(context.Context, *ChangePasswordRequest) (*ChangePasswordResponse, error)
+	ResetUserPassword(context.Context, *ResetUserPasswordRequest) (*ResetUserPasswordResponse, error)
+	GetUserIdentities(context.Context, *GetUserIdentitiesRequest) (*GetUserIdentitiesResponse, error)
+	LinkUserIdentity(context.Context, *LinkUserIdentityRequest) (*LinkUserIdentityResponse, error)
+	UnlinkUserIdentity(context.Context, *UnlinkUserIdentityRequest) (*UnlinkUserIdentityResponse, error)
 	GetCheckins(context.Context, *GetCheckinsRequest) (*GetCheckinsResponse, error)
 	CreateCheckin(context.Context, *CreateCheckinRequest) (*CreateCheckinResponse, error)
 	GetScoreSheets(context.Context, *GetScoreSheetsRequest) (*GetScoreSheetsResponse, error)
@@ -3595,494 +9554,1367 @@ type RobocupServer interface {
 	GetSheetAuthUrl(context.Context, *GetSheetAuthUrlRequest) (*GetSheetAuthUrlResponse, error)
 	GetSheetConfig(context.Context, *GetSheetConfigRequest) (*GetSheetConfigResponse, error)
 	SubmitSheetConfig(context.Context, *SubmitSheetConfigRequest) (*SubmitSheetConfigResponse, error)
+	GetJudgeAssignments(context.Context, *GetJudgeAssignmentsRequest) (*GetJudgeAssignmentsResponse, error)
+	CreateJudgeAssignment(context.Context, *CreateJudgeAssignmentRequest) (*CreateJudgeAssignmentResponse, error)
+	DeleteJudgeAssignment(context.Context, *DeleteJudgeAssignmentRequest) (*DeleteJudgeAssignmentResponse, error)
+	GetAuditLog(context.Context, *GetAuditLogRequest) (*GetAuditLogResponse, error)
+	GetLoginLockouts(context.Context, *GetLoginLockoutsRequest) (*GetLoginLockoutsResponse, error)
+	ClearLoginLockout(context.Context, *ClearLoginLockoutRequest) (*ClearLoginLockoutResponse, error)
+	CreateApiKey(context.Context, *CreateApiKeyRequest) (*CreateApiKeyResponse, error)
+	GetApiKeys(context.Context, *GetApiKeysRequest) (*GetApiKeysResponse, error)
+	RevokeApiKey(context.Context, *RevokeApiKeyRequest) (*RevokeApiKeyResponse, error)
+	CreateCompetition(context.Context, *CreateCompetitionRequest) (*CreateCompetitionResponse, error)
+	GetCompetitions(context.Context, *GetCompetitionsRequest) (*GetCompetitionsResponse, error)
+	SelectCompetition(context.Context, *SelectCompetitionRequest) (*SelectCompetitionResponse, error)
+	CloneCompetitionSetup(context.Context, *CloneCompetitionSetupRequest) (*CloneCompetitionSetupResponse, error)
+	DeleteTeam(context.Context, *DeleteTeamRequest) (*DeleteTeamResponse, error)
+	RestoreTeam(context.Context, *RestoreTeamRequest) (*RestoreTeamResponse, error)
+	DeleteDivision(context.Context, *DeleteDivisionRequest) (*DeleteDivisionResponse, error)
+	RestoreDivision(context.Context, *RestoreDivisionRequest) (*RestoreDivisionResponse, error)
+	DeleteInstitution(context.Context, *DeleteInstitutionRequest) (*DeleteInstitutionResponse, error)
+	RestoreInstitution(context.Context, *RestoreInstitutionRequest) (*RestoreInstitutionResponse, error)
+	DeleteUser(context.Context, *DeleteUserRequest) (*DeleteUserResponse, error)
+	RestoreUser(context.Context, *RestoreUserRequest) (*RestoreUserResponse, error)
+	DeleteScoreSheet(context.Context, *DeleteScoreSheetRequest) (*DeleteScoreSheetResponse, error)
+	RestoreScoreSheet(context.Context, *RestoreScoreSheetRequest) (*RestoreScoreSheetResponse, error)
+	DeleteScoreSheetTemplate(context.Context, *DeleteScoreSheetTemplateRequest) (*DeleteScoreSheetTemplateResponse, error)
+	RestoreScoreSheetTemplate(context.Context, *RestoreScoreSheetTemplateRequest) (*RestoreScoreSheetTemplateResponse, error)
+	DeleteCheckin(context.Context, *DeleteCheckinRequest) (*DeleteCheckinResponse, error)
+	RestoreCheckin(context.Context, *RestoreCheckinRequest) (*RestoreCheckinResponse, error)
+}
+
+func RegisterRobocupServer(s *grpc.Server, srv RobocupServer) {
+	s.RegisterService(&_Robocup_serviceDesc, srv)
+}
+
+func _Robocup_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LoginRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).Login(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/Login",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).Login(ctx, req.(*LoginRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_Logout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LogoutRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).Logout(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/Logout",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).Logout(ctx, req.(*LogoutRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetAuthProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuthProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetAuthProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetAuthProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetAuthProviders(ctx, req.(*GetAuthProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCurrentUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetCurrentUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetCurrentUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetCurrentUser(ctx, req.(*GetCurrentUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetDanceLadder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDanceLadderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetDanceLadder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetDanceLadder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetDanceLadder(ctx, req.(*GetDanceLadderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetDivision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDivisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetDivision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetDivision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetDivision(ctx, req.(*GetDivisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetScoreSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoreSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetScoreSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetScoreSheet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetScoreSheet(ctx, req.(*GetScoreSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_CreateScoreSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScoreSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).CreateScoreSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/CreateScoreSheet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).CreateScoreSheet(ctx, req.(*CreateScoreSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_UpdateScoreSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScoreSheetRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).UpdateScoreSheet(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/UpdateScoreSheet",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).UpdateScoreSheet(ctx, req.(*UpdateScoreSheetRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetDivisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDivisionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetDivisions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetDivisions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetDivisions(ctx, req.(*GetDivisionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetUsers(ctx, req.(*GetUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetScoreSheetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoreSheetTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetScoreSheetTemplates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetScoreSheetTemplates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetScoreSheetTemplates(ctx, req.(*GetScoreSheetTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetTeam(ctx, req.(*GetTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).CreateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/CreateTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetInstitutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInstitutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetInstitutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetInstitutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetInstitutions(ctx, req.(*GetInstitutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_UpdateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateTeamRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).UpdateTeam(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/UpdateTeam",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).UpdateTeam(ctx, req.(*UpdateTeamRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetTeams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetTeams(ctx, req.(*GetTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_CreateDivision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDivisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).CreateDivision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/CreateDivision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).CreateDivision(ctx, req.(*CreateDivisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_CreateScoreSheetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateScoreSheetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).CreateScoreSheetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/CreateScoreSheetTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).CreateScoreSheetTemplate(ctx, req.(*CreateScoreSheetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_UpdateDivision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDivisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).UpdateDivision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/UpdateDivision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).UpdateDivision(ctx, req.(*UpdateDivisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_UpdateScoreSheetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateScoreSheetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).UpdateScoreSheetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/UpdateScoreSheetTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).UpdateScoreSheetTemplate(ctx, req.(*UpdateScoreSheetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_MigrateScoreSheets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MigrateScoreSheetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).MigrateScoreSheets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/MigrateScoreSheets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).MigrateScoreSheets(ctx, req.(*MigrateScoreSheetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_LockRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LockRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).LockRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/LockRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).LockRound(ctx, req.(*LockRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_UnlockRound_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlockRoundRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).UnlockRound(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/UnlockRound",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).UnlockRound(ctx, req.(*UnlockRoundRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetRoundLocks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRoundLocksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetRoundLocks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetRoundLocks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetRoundLocks(ctx, req.(*GetRoundLocksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetScoreSheetHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoreSheetHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetScoreSheetHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetScoreSheetHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetScoreSheetHistory(ctx, req.(*GetScoreSheetHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_RestoreScoreSheetRevision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreScoreSheetRevisionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).RestoreScoreSheetRevision(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/RestoreScoreSheetRevision",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).RestoreScoreSheetRevision(ctx, req.(*RestoreScoreSheetRevisionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetDuplicateScoreSheets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDuplicateScoreSheetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetDuplicateScoreSheets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetDuplicateScoreSheets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetDuplicateScoreSheets(ctx, req.(*GetDuplicateScoreSheetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_SyncScoreSheets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncScoreSheetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).SyncScoreSheets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/SyncScoreSheets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).SyncScoreSheets(ctx, req.(*SyncScoreSheetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_ExportScoreSheetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportScoreSheetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).ExportScoreSheetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/ExportScoreSheetTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).ExportScoreSheetTemplate(ctx, req.(*ExportScoreSheetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_ImportScoreSheetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ImportScoreSheetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).ImportScoreSheetTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/ImportScoreSheetTemplate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).ImportScoreSheetTemplate(ctx, req.(*ImportScoreSheetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).CreateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/CreateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).CreateUser(ctx, req.(*CreateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateUserRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).UpdateUser(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/UpdateUser",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_BulkCreateUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(BulkCreateUsersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).BulkCreateUsers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/BulkCreateUsers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).BulkCreateUsers(ctx, req.(*BulkCreateUsersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_ChangePassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangePasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).ChangePassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/ChangePassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).ChangePassword(ctx, req.(*ChangePasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_ResetUserPassword_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetUserPasswordRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).ResetUserPassword(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/ResetUserPassword",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).ResetUserPassword(ctx, req.(*ResetUserPasswordRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetUserIdentities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetUserIdentitiesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetUserIdentities(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetUserIdentities",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetUserIdentities(ctx, req.(*GetUserIdentitiesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_LinkUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(LinkUserIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).LinkUserIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/LinkUserIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).LinkUserIdentity(ctx, req.(*LinkUserIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_UnlinkUserIdentity_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UnlinkUserIdentityRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).UnlinkUserIdentity(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/UnlinkUserIdentity",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).UnlinkUserIdentity(ctx, req.(*UnlinkUserIdentityRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetCheckins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCheckinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetCheckins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetCheckins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetCheckins(ctx, req.(*GetCheckinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_CreateCheckin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCheckinRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).CreateCheckin(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/CreateCheckin",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).CreateCheckin(ctx, req.(*CreateCheckinRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetScoreSheets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetScoreSheetsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetScoreSheets(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetScoreSheets",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetScoreSheets(ctx, req.(*GetScoreSheetsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetSheetTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSheetTeamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetSheetTeams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetSheetTeams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetSheetTeams(ctx, req.(*GetSheetTeamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_SyncCheckins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncCheckinsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).SyncCheckins(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/SyncCheckins",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).SyncCheckins(ctx, req.(*SyncCheckinsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetSheetAuthUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSheetAuthUrlRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetSheetAuthUrl(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetSheetAuthUrl",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetSheetAuthUrl(ctx, req.(*GetSheetAuthUrlRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Robocup_GetSheetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSheetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).GetSheetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/GetSheetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).GetSheetConfig(ctx, req.(*GetSheetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterRobocupServer(s *grpc.Server, srv RobocupServer) {
-	s.RegisterService(&_Robocup_serviceDesc, srv)
+func _Robocup_SubmitSheetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitSheetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RobocupServer).SubmitSheetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/Robocup/SubmitSheetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RobocupServer).SubmitSheetConfig(ctx, req.(*SubmitSheetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_Login_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(LoginRequest)
+func _Robocup_GetJudgeAssignments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetJudgeAssignmentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).Login(ctx, in)
+		return srv.(RobocupServer).GetJudgeAssignments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/Login",
+		FullMethod: "/Robocup/GetJudgeAssignments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).Login(ctx, req.(*LoginRequest))
+		return srv.(RobocupServer).GetJudgeAssignments(ctx, req.(*GetJudgeAssignmentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetCurrentUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCurrentUserRequest)
+func _Robocup_CreateJudgeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateJudgeAssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetCurrentUser(ctx, in)
+		return srv.(RobocupServer).CreateJudgeAssignment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetCurrentUser",
+		FullMethod: "/Robocup/CreateJudgeAssignment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetCurrentUser(ctx, req.(*GetCurrentUserRequest))
+		return srv.(RobocupServer).CreateJudgeAssignment(ctx, req.(*CreateJudgeAssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetDanceLadder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDanceLadderRequest)
+func _Robocup_DeleteJudgeAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteJudgeAssignmentRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetDanceLadder(ctx, in)
+		return srv.(RobocupServer).DeleteJudgeAssignment(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetDanceLadder",
+		FullMethod: "/Robocup/DeleteJudgeAssignment",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetDanceLadder(ctx, req.(*GetDanceLadderRequest))
+		return srv.(RobocupServer).DeleteJudgeAssignment(ctx, req.(*DeleteJudgeAssignmentRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetDivision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDivisionRequest)
+func _Robocup_GetAuditLog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditLogRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetDivision(ctx, in)
+		return srv.(RobocupServer).GetAuditLog(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetDivision",
+		FullMethod: "/Robocup/GetAuditLog",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetDivision(ctx, req.(*GetDivisionRequest))
+		return srv.(RobocupServer).GetAuditLog(ctx, req.(*GetAuditLogRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetScoreSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetScoreSheetRequest)
+func _Robocup_GetLoginLockouts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoginLockoutsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetScoreSheet(ctx, in)
+		return srv.(RobocupServer).GetLoginLockouts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetScoreSheet",
+		FullMethod: "/Robocup/GetLoginLockouts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetScoreSheet(ctx, req.(*GetScoreSheetRequest))
+		return srv.(RobocupServer).GetLoginLockouts(ctx, req.(*GetLoginLockoutsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_CreateScoreSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateScoreSheetRequest)
+func _Robocup_ClearLoginLockout_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearLoginLockoutRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).CreateScoreSheet(ctx, in)
+		return srv.(RobocupServer).ClearLoginLockout(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/CreateScoreSheet",
+		FullMethod: "/Robocup/ClearLoginLockout",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).CreateScoreSheet(ctx, req.(*CreateScoreSheetRequest))
+		return srv.(RobocupServer).ClearLoginLockout(ctx, req.(*ClearLoginLockoutRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_UpdateScoreSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateScoreSheetRequest)
+func _Robocup_CreateApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).UpdateScoreSheet(ctx, in)
+		return srv.(RobocupServer).CreateApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/UpdateScoreSheet",
+		FullMethod: "/Robocup/CreateApiKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).UpdateScoreSheet(ctx, req.(*UpdateScoreSheetRequest))
+		return srv.(RobocupServer).CreateApiKey(ctx, req.(*CreateApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetDivisions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDivisionsRequest)
+func _Robocup_GetApiKeys_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApiKeysRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetDivisions(ctx, in)
+		return srv.(RobocupServer).GetApiKeys(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetDivisions",
+		FullMethod: "/Robocup/GetApiKeys",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetDivisions(ctx, req.(*GetDivisionsRequest))
+		return srv.(RobocupServer).GetApiKeys(ctx, req.(*GetApiKeysRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetUsers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetUsersRequest)
+func _Robocup_RevokeApiKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeApiKeyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetUsers(ctx, in)
+		return srv.(RobocupServer).RevokeApiKey(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetUsers",
+		FullMethod: "/Robocup/RevokeApiKey",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetUsers(ctx, req.(*GetUsersRequest))
+		return srv.(RobocupServer).RevokeApiKey(ctx, req.(*RevokeApiKeyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetScoreSheetTemplates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetScoreSheetTemplatesRequest)
+func _Robocup_CreateCompetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateCompetitionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetScoreSheetTemplates(ctx, in)
+		return srv.(RobocupServer).CreateCompetition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetScoreSheetTemplates",
+		FullMethod: "/Robocup/CreateCompetition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetScoreSheetTemplates(ctx, req.(*GetScoreSheetTemplatesRequest))
+		return srv.(RobocupServer).CreateCompetition(ctx, req.(*CreateCompetitionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTeamRequest)
+func _Robocup_GetCompetitions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompetitionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetTeam(ctx, in)
+		return srv.(RobocupServer).GetCompetitions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetTeam",
+		FullMethod: "/Robocup/GetCompetitions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetTeam(ctx, req.(*GetTeamRequest))
+		return srv.(RobocupServer).GetCompetitions(ctx, req.(*GetCompetitionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_CreateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateTeamRequest)
+func _Robocup_SelectCompetition_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SelectCompetitionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).CreateTeam(ctx, in)
+		return srv.(RobocupServer).SelectCompetition(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/CreateTeam",
+		FullMethod: "/Robocup/SelectCompetition",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).CreateTeam(ctx, req.(*CreateTeamRequest))
+		return srv.(RobocupServer).SelectCompetition(ctx, req.(*SelectCompetitionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetInstitutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInstitutionsRequest)
+func _Robocup_CloneCompetitionSetup_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CloneCompetitionSetupRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetInstitutions(ctx, in)
+		return srv.(RobocupServer).CloneCompetitionSetup(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetInstitutions",
+		FullMethod: "/Robocup/CloneCompetitionSetup",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetInstitutions(ctx, req.(*GetInstitutionsRequest))
+		return srv.(RobocupServer).CloneCompetitionSetup(ctx, req.(*CloneCompetitionSetupRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_UpdateTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateTeamRequest)
+func _Robocup_DeleteTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).UpdateTeam(ctx, in)
+		return srv.(RobocupServer).DeleteTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/UpdateTeam",
+		FullMethod: "/Robocup/DeleteTeam",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).UpdateTeam(ctx, req.(*UpdateTeamRequest))
+		return srv.(RobocupServer).DeleteTeam(ctx, req.(*DeleteTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTeamsRequest)
+func _Robocup_RestoreTeam_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreTeamRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetTeams(ctx, in)
+		return srv.(RobocupServer).RestoreTeam(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetTeams",
+		FullMethod: "/Robocup/RestoreTeam",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetTeams(ctx, req.(*GetTeamsRequest))
+		return srv.(RobocupServer).RestoreTeam(ctx, req.(*RestoreTeamRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_CreateDivision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateDivisionRequest)
+func _Robocup_DeleteDivision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteDivisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).CreateDivision(ctx, in)
+		return srv.(RobocupServer).DeleteDivision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/CreateDivision",
+		FullMethod: "/Robocup/DeleteDivision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).CreateDivision(ctx, req.(*CreateDivisionRequest))
+		return srv.(RobocupServer).DeleteDivision(ctx, req.(*DeleteDivisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_CreateScoreSheetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateScoreSheetTemplateRequest)
+func _Robocup_RestoreDivision_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDivisionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).CreateScoreSheetTemplate(ctx, in)
+		return srv.(RobocupServer).RestoreDivision(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/CreateScoreSheetTemplate",
+		FullMethod: "/Robocup/RestoreDivision",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).CreateScoreSheetTemplate(ctx, req.(*CreateScoreSheetTemplateRequest))
+		return srv.(RobocupServer).RestoreDivision(ctx, req.(*RestoreDivisionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_CreateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateUserRequest)
+func _Robocup_DeleteInstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteInstitutionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).CreateUser(ctx, in)
+		return srv.(RobocupServer).DeleteInstitution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/CreateUser",
+		FullMethod: "/Robocup/DeleteInstitution",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).CreateUser(ctx, req.(*CreateUserRequest))
+		return srv.(RobocupServer).DeleteInstitution(ctx, req.(*DeleteInstitutionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_UpdateUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateUserRequest)
+func _Robocup_RestoreInstitution_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreInstitutionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).UpdateUser(ctx, in)
+		return srv.(RobocupServer).RestoreInstitution(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/UpdateUser",
+		FullMethod: "/Robocup/RestoreInstitution",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).UpdateUser(ctx, req.(*UpdateUserRequest))
+		return srv.(RobocupServer).RestoreInstitution(ctx, req.(*RestoreInstitutionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetCheckins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCheckinsRequest)
+func _Robocup_DeleteUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetCheckins(ctx, in)
+		return srv.(RobocupServer).DeleteUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetCheckins",
+		FullMethod: "/Robocup/DeleteUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetCheckins(ctx, req.(*GetCheckinsRequest))
+		return srv.(RobocupServer).DeleteUser(ctx, req.(*DeleteUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_CreateCheckin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CreateCheckinRequest)
+func _Robocup_RestoreUser_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreUserRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).CreateCheckin(ctx, in)
+		return srv.(RobocupServer).RestoreUser(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/CreateCheckin",
+		FullMethod: "/Robocup/RestoreUser",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).CreateCheckin(ctx, req.(*CreateCheckinRequest))
+		return srv.(RobocupServer).RestoreUser(ctx, req.(*RestoreUserRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetScoreSheets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetScoreSheetsRequest)
+func _Robocup_DeleteScoreSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScoreSheetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetScoreSheets(ctx, in)
+		return srv.(RobocupServer).DeleteScoreSheet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetScoreSheets",
+		FullMethod: "/Robocup/DeleteScoreSheet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetScoreSheets(ctx, req.(*GetScoreSheetsRequest))
+		return srv.(RobocupServer).DeleteScoreSheet(ctx, req.(*DeleteScoreSheetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetSheetTeams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSheetTeamsRequest)
+func _Robocup_RestoreScoreSheet_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreScoreSheetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetSheetTeams(ctx, in)
+		return srv.(RobocupServer).RestoreScoreSheet(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetSheetTeams",
+		FullMethod: "/Robocup/RestoreScoreSheet",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetSheetTeams(ctx, req.(*GetSheetTeamsRequest))
+		return srv.(RobocupServer).RestoreScoreSheet(ctx, req.(*RestoreScoreSheetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_SyncCheckins_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SyncCheckinsRequest)
+func _Robocup_DeleteScoreSheetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteScoreSheetTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).SyncCheckins(ctx, in)
+		return srv.(RobocupServer).DeleteScoreSheetTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/SyncCheckins",
+		FullMethod: "/Robocup/DeleteScoreSheetTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).SyncCheckins(ctx, req.(*SyncCheckinsRequest))
+		return srv.(RobocupServer).DeleteScoreSheetTemplate(ctx, req.(*DeleteScoreSheetTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetSheetAuthUrl_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSheetAuthUrlRequest)
+func _Robocup_RestoreScoreSheetTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreScoreSheetTemplateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetSheetAuthUrl(ctx, in)
+		return srv.(RobocupServer).RestoreScoreSheetTemplate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetSheetAuthUrl",
+		FullMethod: "/Robocup/RestoreScoreSheetTemplate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetSheetAuthUrl(ctx, req.(*GetSheetAuthUrlRequest))
+		return srv.(RobocupServer).RestoreScoreSheetTemplate(ctx, req.(*RestoreScoreSheetTemplateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_GetSheetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSheetConfigRequest)
+func _Robocup_DeleteCheckin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteCheckinRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).GetSheetConfig(ctx, in)
+		return srv.(RobocupServer).DeleteCheckin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/GetSheetConfig",
+		FullMethod: "/Robocup/DeleteCheckin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).GetSheetConfig(ctx, req.(*GetSheetConfigRequest))
+		return srv.(RobocupServer).DeleteCheckin(ctx, req.(*DeleteCheckinRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Robocup_SubmitSheetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitSheetConfigRequest)
+func _Robocup_RestoreCheckin_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreCheckinRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(RobocupServer).SubmitSheetConfig(ctx, in)
+		return srv.(RobocupServer).RestoreCheckin(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/Robocup/SubmitSheetConfig",
+		FullMethod: "/Robocup/RestoreCheckin",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(RobocupServer).SubmitSheetConfig(ctx, req.(*SubmitSheetConfigRequest))
+		return srv.(RobocupServer).RestoreCheckin(ctx, req.(*RestoreCheckinRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -4095,6 +10927,14 @@ var _Robocup_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Login",
 			Handler:    _Robocup_Login_Handler,
 		},
+		{
+			MethodName: "Logout",
+			Handler:    _Robocup_Logout_Handler,
+		},
+		{
+			MethodName: "GetAuthProviders",
+			Handler:    _Robocup_GetAuthProviders_Handler,
+		},
 		{
 			MethodName: "GetCurrentUser",
 			Handler:    _Robocup_GetCurrentUser_Handler,
@@ -4159,6 +10999,54 @@ var _Robocup_serviceDesc = grpc.ServiceDesc{
 			MethodName: "CreateScoreSheetTemplate",
 			Handler:    _Robocup_CreateScoreSheetTemplate_Handler,
 		},
+		{
+			MethodName: "UpdateDivision",
+			Handler:    _Robocup_UpdateDivision_Handler,
+		},
+		{
+			MethodName: "UpdateScoreSheetTemplate",
+			Handler:    _Robocup_UpdateScoreSheetTemplate_Handler,
+		},
+		{
+			MethodName: "MigrateScoreSheets",
+			Handler:    _Robocup_MigrateScoreSheets_Handler,
+		},
+		{
+			MethodName: "LockRound",
+			Handler:    _Robocup_LockRound_Handler,
+		},
+		{
+			MethodName: "UnlockRound",
+			Handler:    _Robocup_UnlockRound_Handler,
+		},
+		{
+			MethodName: "GetRoundLocks",
+			Handler:    _Robocup_GetRoundLocks_Handler,
+		},
+		{
+			MethodName: "GetScoreSheetHistory",
+			Handler:    _Robocup_GetScoreSheetHistory_Handler,
+		},
+		{
+			MethodName: "RestoreScoreSheetRevision",
+			Handler:    _Robocup_RestoreScoreSheetRevision_Handler,
+		},
+		{
+			MethodName: "GetDuplicateScoreSheets",
+			Handler:    _Robocup_GetDuplicateScoreSheets_Handler,
+		},
+		{
+			MethodName: "SyncScoreSheets",
+			Handler:    _Robocup_SyncScoreSheets_Handler,
+		},
+		{
+			MethodName: "ExportScoreSheetTemplate",
+			Handler:    _Robocup_ExportScoreSheetTemplate_Handler,
+		},
+		{
+			MethodName: "ImportScoreSheetTemplate",
+			Handler:    _Robocup_ImportScoreSheetTemplate_Handler,
+		},
 		{
 			MethodName: "CreateUser",
 			Handler:    _Robocup_CreateUser_Handler,
@@ -4167,6 +11055,30 @@ var _Robocup_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateUser",
 			Handler:    _Robocup_UpdateUser_Handler,
 		},
+		{
+			MethodName: "BulkCreateUsers",
+			Handler:    _Robocup_BulkCreateUsers_Handler,
+		},
+		{
+			MethodName: "ChangePassword",
+			Handler:    _Robocup_ChangePassword_Handler,
+		},
+		{
+			MethodName: "ResetUserPassword",
+			Handler:    _Robocup_ResetUserPassword_Handler,
+		},
+		{
+			MethodName: "GetUserIdentities",
+			Handler:    _Robocup_GetUserIdentities_Handler,
+		},
+		{
+			MethodName: "LinkUserIdentity",
+			Handler:    _Robocup_LinkUserIdentity_Handler,
+		},
+		{
+			MethodName: "UnlinkUserIdentity",
+			Handler:    _Robocup_UnlinkUserIdentity_Handler,
+		},
 		{
 			MethodName: "GetCheckins",
 			Handler:    _Robocup_GetCheckins_Handler,
@@ -4199,168 +11111,512 @@ var _Robocup_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SubmitSheetConfig",
 			Handler:    _Robocup_SubmitSheetConfig_Handler,
 		},
+		{
+			MethodName: "GetJudgeAssignments",
+			Handler:    _Robocup_GetJudgeAssignments_Handler,
+		},
+		{
+			MethodName: "CreateJudgeAssignment",
+			Handler:    _Robocup_CreateJudgeAssignment_Handler,
+		},
+		{
+			MethodName: "DeleteJudgeAssignment",
+			Handler:    _Robocup_DeleteJudgeAssignment_Handler,
+		},
+		{
+			MethodName: "GetAuditLog",
+			Handler:    _Robocup_GetAuditLog_Handler,
+		},
+		{
+			MethodName: "GetLoginLockouts",
+			Handler:    _Robocup_GetLoginLockouts_Handler,
+		},
+		{
+			MethodName: "ClearLoginLockout",
+			Handler:    _Robocup_ClearLoginLockout_Handler,
+		},
+		{
+			MethodName: "CreateApiKey",
+			Handler:    _Robocup_CreateApiKey_Handler,
+		},
+		{
+			MethodName: "GetApiKeys",
+			Handler:    _Robocup_GetApiKeys_Handler,
+		},
+		{
+			MethodName: "RevokeApiKey",
+			Handler:    _Robocup_RevokeApiKey_Handler,
+		},
+		{
+			MethodName: "CreateCompetition",
+			Handler:    _Robocup_CreateCompetition_Handler,
+		},
+		{
+			MethodName: "GetCompetitions",
+			Handler:    _Robocup_GetCompetitions_Handler,
+		},
+		{
+			MethodName: "SelectCompetition",
+			Handler:    _Robocup_SelectCompetition_Handler,
+		},
+		{
+			MethodName: "CloneCompetitionSetup",
+			Handler:    _Robocup_CloneCompetitionSetup_Handler,
+		},
+		{
+			MethodName: "DeleteTeam",
+			Handler:    _Robocup_DeleteTeam_Handler,
+		},
+		{
+			MethodName: "RestoreTeam",
+			Handler:    _Robocup_RestoreTeam_Handler,
+		},
+		{
+			MethodName: "DeleteDivision",
+			Handler:    _Robocup_DeleteDivision_Handler,
+		},
+		{
+			MethodName: "RestoreDivision",
+			Handler:    _Robocup_RestoreDivision_Handler,
+		},
+		{
+			MethodName: "DeleteInstitution",
+			Handler:    _Robocup_DeleteInstitution_Handler,
+		},
+		{
+			MethodName: "RestoreInstitution",
+			Handler:    _Robocup_RestoreInstitution_Handler,
+		},
+		{
+			MethodName: "DeleteUser",
+			Handler:    _Robocup_DeleteUser_Handler,
+		},
+		{
+			MethodName: "RestoreUser",
+			Handler:    _Robocup_RestoreUser_Handler,
+		},
+		{
+			MethodName: "DeleteScoreSheet",
+			Handler:    _Robocup_DeleteScoreSheet_Handler,
+		},
+		{
+			MethodName: "RestoreScoreSheet",
+			Handler:    _Robocup_RestoreScoreSheet_Handler,
+		},
+		{
+			MethodName: "DeleteScoreSheetTemplate",
+			Handler:    _Robocup_DeleteScoreSheetTemplate_Handler,
+		},
+		{
+			MethodName: "RestoreScoreSheetTemplate",
+			Handler:    _Robocup_RestoreScoreSheetTemplate_Handler,
+		},
+		{
+			MethodName: "DeleteCheckin",
+			Handler:    _Robocup_DeleteCheckin_Handler,
+		},
+		{
+			MethodName: "RestoreCheckin",
+			Handler:    _Robocup_RestoreCheckin_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "robocup.proto",
 }
 
-func init() { proto.RegisterFile("robocup.proto", fileDescriptor_robocup_83f7fc6d7f938968) }
-
-var fileDescriptor_robocup_83f7fc6d7f938968 = []byte{
-	// 2471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x59, 0x4b, 0x73, 0x1b, 0xc7,
-	0x11, 0xc6, 0x02, 0x24, 0x1e, 0x0d, 0x3e, 0xc0, 0x21, 0x41, 0x82, 0xcb, 0x92, 0x44, 0x8d, 0x13,
-	0x9b, 0x29, 0x4b, 0x23, 0x8b, 0x72, 0x9c, 0x94, 0x23, 0xc7, 0x66, 0x51, 0x20, 0x8d, 0x8a, 0x5e,
-	0x59, 0x52, 0xce, 0xc1, 0x07, 0xd4, 0x12, 0x18, 0x81, 0x5b, 0x01, 0x76, 0x91, 0xdd, 0x85, 0x64,
-	0x1e, 0x72, 0x49, 0x2a, 0x95, 0x4b, 0x7e, 0x40, 0xce, 0xf9, 0x11, 0xce, 0x2f, 0xf0, 0x31, 0xf7,
-	0x1c, 0x73, 0xcd, 0x1f, 0xc8, 0x39, 0x35, 0xcf, 0x9d, 0x7d, 0x80, 0x84, 0x15, 0x1f, 0x72, 0x22,
-	0xb6, 0xa7, 0x67, 0xa6, 0x7b, 0xa6, 0xfb, 0xeb, 0xaf, 0x87, 0xb0, 0x1a, 0x06, 0x17, 0xc1, 0x60,
-	0x36, 0x25, 0xd3, 0x30, 0x88, 0x03, 0xfb, 0xce, 0x28, 0x08, 0x46, 0x63, 0xfa, 0x80, 0x7f, 0x5d,
-	0xcc, 0x5e, 0x3f, 0x88, 0xbd, 0x09, 0x8d, 0x62, 0x77, 0x22, 0x15, 0xf0, 0x77, 0x65, 0xa8, 0x3f,
-	0xf1, 0xde, 0x78, 0x91, 0x17, 0xf8, 0x68, 0x0d, 0xca, 0xde, 0xb0, 0x63, 0xed, 0x5b, 0x07, 0x0d,
-	0xa7, 0xec, 0x0d, 0x11, 0x82, 0x25, 0xdf, 0x9d, 0xd0, 0x4e, 0x99, 0x4b, 0xf8, 0x6f, 0x74, 0x00,
-	0xd5, 0x31, 0x75, 0x47, 0x33, 0xda, 0xa9, 0xec, 0x5b, 0x07, 0x6b, 0x87, 0x2d, 0xa2, 0xa6, 0x93,
-	0xa7, 0x5c, 0xee, 0xc8, 0x71, 0x74, 0x1f, 0xd0, 0x20, 0x98, 0x4c, 0x69, 0xec, 0xc5, 0x5e, 0xe0,
-	0xf7, 0xc3, 0x60, 0xe6, 0x0f, 0xa3, 0xce, 0xd2, 0xbe, 0x75, 0xb0, 0xec, 0x6c, 0x18, 0x23, 0x0e,
-	0x1f, 0x40, 0x77, 0x61, 0xe5, 0xb5, 0xe7, 0xbb, 0x63, 0xa5, 0xb8, 0xcc, 0x15, 0x9b, 0x5c, 0x26,
-	0x55, 0x0e, 0xa1, 0xed, 0xf9, 0x31, 0x0d, 0xdf, 0x78, 0xf4, 0x6d, 0x3f, 0xa6, 0x93, 0xe9, 0xd8,
-	0x8d, 0x69, 0xdf, 0x1b, 0x76, 0xaa, 0xdc, 0xc0, 0x4d, 0x3d, 0x78, 0x2e, 0xc7, 0x7a, 0x43, 0xf4,
-	0x09, 0xec, 0x4c, 0x69, 0xf8, 0x3a, 0x08, 0x27, 0xae, 0x3f, 0xa0, 0xa9, 0x59, 0x35, 0x3e, 0xab,
-	0x6d, 0x0c, 0x27, 0xf3, 0xf0, 0x7d, 0xa8, 0x0a, 0x7f, 0x50, 0x13, 0x6a, 0x2f, 0x9e, 0x9f, 0x9d,
-	0x1f, 0x9d, 0x76, 0x5b, 0x25, 0x04, 0x50, 0x75, 0xba, 0x67, 0xc7, 0xaf, 0xba, 0x2d, 0x8b, 0xfd,
-	0x3e, 0x7b, 0x71, 0x7c, 0xdc, 0x75, 0x5a, 0x65, 0xfc, 0x10, 0x9a, 0x3d, 0x3f, 0x8a, 0xbd, 0x78,
-	0x16, 0x2f, 0x78, 0x92, 0xf8, 0x4f, 0x16, 0x54, 0x9f, 0xd1, 0xc9, 0x05, 0x0d, 0x17, 0x3a, 0xf8,
-	0xf7, 0xa1, 0x3a, 0xa2, 0xfe, 0x90, 0x86, 0xf2, 0xe0, 0xd7, 0x88, 0x98, 0x4c, 0x4e, 0xb9, 0xd4,
-	0x91, 0xa3, 0xf8, 0x01, 0x54, 0x85, 0x04, 0xad, 0x43, 0xf3, 0xd5, 0xf3, 0xb3, 0x97, 0xdd, 0xe3,
-	0xde, 0x49, 0xaf, 0xfb, 0xa4, 0x55, 0x42, 0x75, 0x58, 0x7a, 0x76, 0xf4, 0x54, 0x9a, 0x7e, 0xd2,
-	0xe5, 0xbf, 0xcb, 0xf8, 0x5b, 0x0b, 0x96, 0xce, 0xa9, 0x3b, 0x59, 0xc8, 0x0a, 0x02, 0x4d, 0x2f,
-	0xf1, 0x93, 0x9b, 0xd2, 0x3c, 0x5c, 0x21, 0x86, 0xef, 0x8e, 0xa9, 0x80, 0x6c, 0xa8, 0x0f, 0x65,
-	0x7c, 0xf0, 0xab, 0x6f, 0x38, 0xfa, 0x1b, 0xed, 0x41, 0xc3, 0x9b, 0x4c, 0x83, 0x30, 0x66, 0x97,
-	0xb1, 0x2c, 0x06, 0x85, 0xa0, 0x37, 0x44, 0x77, 0xa1, 0x36, 0xe1, 0xfe, 0x45, 0x9d, 0xea, 0x7e,
-	0xe5, 0xa0, 0x79, 0x58, 0x93, 0xfe, 0x3a, 0x4a, 0x8e, 0xdb, 0xb0, 0x79, 0x4a, 0x63, 0x15, 0x7e,
-	0x91, 0x43, 0x7f, 0x37, 0xa3, 0x51, 0x8c, 0x3f, 0x87, 0xad, 0xb4, 0x38, 0x9a, 0x06, 0x7e, 0x44,
-	0xd1, 0x07, 0xd0, 0x50, 0x5b, 0x47, 0x1d, 0x8b, 0xaf, 0xd9, 0xd0, 0xc1, 0xeb, 0x24, 0x63, 0xf8,
-	0xf7, 0xb0, 0xf4, 0x2a, 0x5a, 0xf0, 0x56, 0x6c, 0xa8, 0xcf, 0x22, 0x1a, 0x72, 0x79, 0x45, 0xb8,
-	0xa0, 0xbe, 0xd1, 0x2e, 0xd4, 0xbd, 0xa8, 0xef, 0x0e, 0x27, 0x9e, 0xf0, 0xbd, 0xee, 0xd4, 0xbc,
-	0xe8, 0x88, 0x7d, 0xb2, 0x69, 0x53, 0x37, 0x8a, 0xde, 0x06, 0xa1, 0xf6, 0x5c, 0x7d, 0xe3, 0x0d,
-	0x58, 0x3f, 0xa5, 0x31, 0xb3, 0x40, 0xbb, 0xf4, 0x00, 0x5a, 0x89, 0x48, 0xba, 0xb3, 0x07, 0xcb,
-	0x6c, 0x27, 0xe5, 0xca, 0x32, 0x61, 0xc3, 0x8e, 0x90, 0xe1, 0xef, 0x2c, 0xd8, 0x3d, 0x1b, 0x04,
-	0x21, 0x3d, 0xbb, 0xa4, 0x34, 0x56, 0x61, 0x7d, 0x46, 0x07, 0x85, 0xd1, 0xb9, 0x05, 0xcb, 0xb1,
-	0x17, 0x8f, 0x95, 0x67, 0xe2, 0x03, 0xed, 0x43, 0x73, 0x48, 0xa3, 0x41, 0xe8, 0x4d, 0xf5, 0x55,
-	0x37, 0x1c, 0x53, 0xc4, 0x2e, 0x70, 0xe2, 0x7e, 0xd3, 0x7f, 0xe3, 0x8e, 0x67, 0x54, 0x26, 0x76,
-	0x7d, 0xe2, 0x7e, 0xf3, 0x15, 0xfb, 0x46, 0xb7, 0x01, 0x26, 0xb3, 0x71, 0xec, 0x4d, 0xc7, 0x1e,
-	0x0d, 0x65, 0x36, 0x1b, 0x12, 0xf4, 0x1e, 0xac, 0x0e, 0xbd, 0x68, 0x3a, 0x76, 0xaf, 0xfa, 0x41,
-	0xc8, 0xc2, 0xba, 0xca, 0x55, 0x56, 0xa4, 0xf0, 0x05, 0x93, 0xe1, 0x7f, 0x59, 0x80, 0xf2, 0x7e,
-	0x2c, 0x74, 0x33, 0xf7, 0x60, 0x29, 0xbe, 0x9a, 0x2a, 0x98, 0xea, 0x90, 0xfc, 0x32, 0xe4, 0xfc,
-	0x6a, 0x4a, 0x1d, 0xae, 0x85, 0x3a, 0x50, 0x8b, 0xbd, 0x89, 0xe7, 0x8f, 0x18, 0x42, 0x55, 0x0e,
-	0x1a, 0x8e, 0xfa, 0x44, 0x9f, 0x40, 0x3d, 0x12, 0xe7, 0xc6, 0x30, 0x89, 0x1d, 0xb5, 0x4d, 0xe6,
-	0x1e, 0xad, 0xa3, 0x75, 0xf1, 0xfb, 0xb0, 0xc4, 0xd6, 0x47, 0xab, 0xd0, 0xe8, 0x3d, 0x3f, 0xef,
-	0x3a, 0x5f, 0xf5, 0xba, 0xbf, 0x69, 0x95, 0x58, 0x52, 0xbe, 0xec, 0x3a, 0x27, 0x2f, 0x9c, 0x67,
-	0x47, 0xcf, 0x8f, 0xbb, 0x2d, 0x0b, 0xff, 0xdd, 0x82, 0x5b, 0xa7, 0x34, 0xce, 0x2f, 0xa9, 0x6e,
-	0x1f, 0x9d, 0x40, 0xf5, 0xb5, 0x37, 0x8e, 0x69, 0xc8, 0x3d, 0x6e, 0x1e, 0x12, 0x72, 0xad, 0x3e,
-	0xf9, 0xf5, 0x8c, 0x86, 0x57, 0x2f, 0xdd, 0xd0, 0x9d, 0xd0, 0x98, 0x45, 0x8c, 0x9c, 0x8d, 0x3e,
-	0x84, 0x8d, 0x69, 0x30, 0x9d, 0x71, 0xf8, 0xd3, 0x2e, 0x95, 0x79, 0x60, 0xb6, 0xd4, 0x80, 0xf4,
-	0x23, 0xb2, 0xef, 0xc2, 0x7a, 0x66, 0x1d, 0x7d, 0xea, 0x15, 0x71, 0xea, 0xd8, 0x83, 0xdb, 0xf3,
-	0x0c, 0x91, 0x31, 0x7a, 0x0a, 0xed, 0x88, 0x0d, 0xf7, 0x23, 0x36, 0xae, 0xc1, 0x57, 0xc5, 0xec,
-	0x66, 0xc1, 0x41, 0x3a, 0x9b, 0x51, 0x7e, 0x41, 0x7c, 0x02, 0x2b, 0x4f, 0x83, 0x91, 0xe7, 0xab,
-	0x23, 0x31, 0xd3, 0xce, 0xca, 0xa4, 0x9d, 0x99, 0x5b, 0xe5, 0x4c, 0x6e, 0x75, 0x61, 0x55, 0xae,
-	0x23, 0x2d, 0xfc, 0x18, 0x90, 0x3b, 0x8b, 0x2f, 0xa9, 0x1f, 0x7b, 0x03, 0x37, 0xa6, 0xc3, 0x3e,
-	0x5b, 0x46, 0x9e, 0xb3, 0x4c, 0xa9, 0x8d, 0x94, 0x02, 0x13, 0xe1, 0x1d, 0x68, 0x9f, 0xd2, 0xf8,
-	0x78, 0x16, 0x86, 0xd4, 0xe7, 0x69, 0xa9, 0x12, 0xf5, 0x39, 0x6c, 0x67, 0x07, 0xfe, 0xa7, 0x8d,
-	0xfe, 0x59, 0x81, 0x35, 0x05, 0x51, 0x4f, 0xdd, 0x21, 0x43, 0xf5, 0x1f, 0x1b, 0x88, 0x2a, 0xa6,
-	0x1b, 0x28, 0x96, 0x80, 0xeb, 0x23, 0xa8, 0x8e, 0xf9, 0x84, 0x4e, 0x99, 0x9f, 0xf5, 0x1e, 0x49,
-	0xaf, 0x43, 0xc4, 0x9f, 0xae, 0x1f, 0x87, 0x57, 0x8e, 0x54, 0xb5, 0xff, 0x5d, 0x86, 0xa6, 0x21,
-	0x47, 0xbb, 0xb0, 0x14, 0x53, 0x77, 0xa2, 0xcd, 0x64, 0x65, 0xc2, 0xe1, 0x22, 0xf4, 0x05, 0x54,
-	0x65, 0xa1, 0x16, 0xeb, 0x1f, 0x5c, 0xb3, 0x3e, 0xe1, 0xf5, 0xfb, 0xe8, 0x0d, 0x0d, 0xdd, 0x11,
-	0x75, 0xe4, 0x3c, 0xf4, 0x01, 0xac, 0x27, 0xd5, 0x9c, 0x5f, 0x3a, 0xcf, 0x55, 0xcb, 0x59, 0xd3,
-	0x62, 0x1e, 0x1e, 0xe8, 0x16, 0xc0, 0x05, 0x8d, 0x62, 0x41, 0x0c, 0x38, 0xce, 0x58, 0x4e, 0x83,
-	0x49, 0xf8, 0xb2, 0x7a, 0x98, 0x33, 0x05, 0x0e, 0x34, 0x72, 0xf8, 0x84, 0x09, 0xd0, 0x1d, 0x68,
-	0xf2, 0x89, 0xfd, 0x38, 0x88, 0xdd, 0x31, 0x47, 0x19, 0xcb, 0x01, 0x2e, 0x3a, 0x67, 0x12, 0xa6,
-	0x20, 0x88, 0x87, 0x50, 0xa8, 0x09, 0x05, 0x2e, 0xe2, 0x0a, 0xf6, 0x39, 0xac, 0x98, 0x0e, 0x30,
-	0xb8, 0x14, 0xa6, 0x58, 0x1c, 0xb1, 0xc4, 0x07, 0x43, 0x10, 0x57, 0x28, 0xf0, 0xa8, 0xb3, 0x1c,
-	0xf5, 0xc9, 0xf4, 0x07, 0xc1, 0xcc, 0x8f, 0xb9, 0x7b, 0xcb, 0x8e, 0xf8, 0xc0, 0x87, 0x3c, 0x86,
-	0x9e, 0x30, 0xda, 0x21, 0x8e, 0x4a, 0xc5, 0xf6, 0x2e, 0xd4, 0xa3, 0xcb, 0xe0, 0x6d, 0xdf, 0x1d,
-	0x8f, 0xf9, 0x0e, 0x75, 0xa7, 0xc6, 0xbe, 0x8f, 0xc6, 0x63, 0x7c, 0xca, 0xc3, 0x2b, 0x35, 0x47,
-	0x86, 0xd7, 0xfd, 0x7c, 0x71, 0x5b, 0xcf, 0xdc, 0x88, 0x59, 0xe2, 0xfe, 0x6c, 0x01, 0x32, 0x8a,
-	0xa4, 0xda, 0xfa, 0x0e, 0x34, 0x95, 0x4e, 0x5f, 0x03, 0x2c, 0x28, 0x51, 0x6f, 0xc8, 0x40, 0xdb,
-	0xf3, 0x07, 0xe3, 0xd9, 0x90, 0x31, 0x29, 0x77, 0xa2, 0xe0, 0x63, 0x45, 0x0a, 0x59, 0x7c, 0x44,
-	0x0c, 0x67, 0x12, 0x25, 0x95, 0xf1, 0x15, 0x81, 0x33, 0x5a, 0x51, 0x65, 0xf6, 0x5f, 0xac, 0x54,
-	0x15, 0xd7, 0x0e, 0x2d, 0x18, 0xe6, 0x7b, 0xb0, 0xac, 0x0c, 0xa9, 0x24, 0x21, 0x2a, 0x64, 0xe8,
-	0x21, 0x34, 0x4c, 0x03, 0xe6, 0x42, 0x4e, 0xa2, 0x85, 0xff, 0x61, 0xc1, 0x46, 0xa2, 0xf1, 0x7f,
-	0x55, 0x30, 0x6f, 0x01, 0x48, 0xd4, 0x4e, 0x28, 0x6f, 0x43, 0x4a, 0x7a, 0xdc, 0x26, 0xb1, 0xae,
-	0x08, 0x60, 0xf1, 0x81, 0xbf, 0xad, 0x00, 0x24, 0xfe, 0xe4, 0x1c, 0xb1, 0xa1, 0x3e, 0x08, 0x26,
-	0x13, 0xea, 0xc7, 0x91, 0xc2, 0x4a, 0xf5, 0x9d, 0x84, 0x79, 0xc5, 0x0c, 0x73, 0x05, 0x09, 0x4b,
-	0x79, 0x48, 0xb8, 0x05, 0x55, 0x86, 0x60, 0x81, 0x30, 0x5e, 0xc3, 0x9a, 0x14, 0x22, 0x62, 0x14,
-	0x52, 0x41, 0xe9, 0x10, 0xc9, 0x1d, 0x75, 0x52, 0x40, 0xd1, 0xbd, 0xa4, 0x24, 0xd7, 0x72, 0xea,
-	0xe4, 0x9c, 0x0f, 0x25, 0x65, 0x5a, 0x95, 0xfb, 0xfa, 0x42, 0xe5, 0xfe, 0xa7, 0xb0, 0x53, 0x54,
-	0x98, 0xd8, 0xc1, 0x36, 0xf8, 0x31, 0x6c, 0xe5, 0xab, 0x50, 0x6f, 0x98, 0xcd, 0x0f, 0xc8, 0xe5,
-	0x07, 0x0b, 0x0c, 0x8e, 0x22, 0x4d, 0x71, 0x09, 0xfc, 0xc3, 0x3e, 0x84, 0xaa, 0x30, 0x57, 0x13,
-	0x15, 0xcb, 0x20, 0x2a, 0xfa, 0xe2, 0x64, 0x30, 0x89, 0x8b, 0xfb, 0x9b, 0x05, 0xb5, 0xe3, 0x4b,
-	0x3a, 0xf8, 0xad, 0x97, 0x0f, 0x3f, 0x75, 0x07, 0xe5, 0xfc, 0x1d, 0xec, 0xc1, 0xb2, 0x3b, 0xa2,
-	0x12, 0x6b, 0x12, 0x56, 0xc8, 0x65, 0xa9, 0xdb, 0x5e, 0xca, 0xdc, 0xf6, 0x23, 0xa8, 0x79, 0x7e,
-	0x9f, 0xb5, 0x87, 0xf2, 0xf6, 0x6c, 0x22, 0x7a, 0x47, 0xa2, 0x7a, 0x47, 0x76, 0xe4, 0xa2, 0x77,
-	0x74, 0xaa, 0x9e, 0xcf, 0x3e, 0xf0, 0x63, 0x4e, 0xb5, 0x93, 0xa3, 0x56, 0x38, 0xf2, 0x23, 0x58,
-	0x33, 0x8f, 0x57, 0x1b, 0xbf, 0x92, 0x9c, 0x6a, 0x8f, 0x15, 0xe3, 0x76, 0x66, 0xb6, 0xcc, 0xfd,
-	0x7b, 0xd0, 0x34, 0xa6, 0xcb, 0xf4, 0x6f, 0x1a, 0x57, 0xea, 0x40, 0xb2, 0x10, 0x3e, 0x85, 0x9d,
-	0xe3, 0x90, 0x32, 0xee, 0x92, 0xb3, 0xe3, 0xfb, 0x2d, 0xf4, 0x25, 0x74, 0xf2, 0x0b, 0xbd, 0xab,
-	0x49, 0xaf, 0xa6, 0xc3, 0x1f, 0xc6, 0xa4, 0xfc, 0x42, 0xef, 0x64, 0xd2, 0xd7, 0xb0, 0x76, 0xca,
-	0x62, 0xd9, 0x9d, 0x28, 0x4b, 0x76, 0xa0, 0xc6, 0x42, 0x26, 0xb9, 0x9d, 0x2a, 0xfb, 0xec, 0x0d,
-	0xd1, 0x47, 0xb0, 0xa5, 0xf0, 0xdb, 0xd8, 0x40, 0x61, 0x3d, 0x92, 0x63, 0xc9, 0x3e, 0x11, 0xfe,
-	0xa3, 0xc5, 0x7b, 0x16, 0xb1, 0xba, 0x34, 0xef, 0x1a, 0xee, 0x60, 0x62, 0x7b, 0x79, 0x3e, 0xb6,
-	0x13, 0x58, 0x49, 0xed, 0x2f, 0x10, 0x3c, 0xe5, 0x61, 0x33, 0x32, 0xac, 0x20, 0xb0, 0x21, 0xee,
-	0xcf, 0xf4, 0x72, 0xbe, 0x19, 0xf8, 0x01, 0x20, 0x53, 0xff, 0x46, 0xbb, 0xf1, 0x67, 0xbc, 0xfc,
-	0x1a, 0xbd, 0xae, 0xa6, 0xe8, 0xef, 0xc1, 0x6a, 0x44, 0xdd, 0x70, 0x70, 0xd9, 0x8f, 0xe2, 0xd0,
-	0xf3, 0x47, 0x3a, 0xde, 0xb9, 0xf0, 0x8c, 0xcb, 0xf0, 0xaf, 0x60, 0x27, 0x37, 0x5d, 0x6e, 0xfa,
-	0x11, 0xac, 0x18, 0x5d, 0xb3, 0xaa, 0xe0, 0xe9, 0xbe, 0x3a, 0xa5, 0xc1, 0x9c, 0x15, 0x91, 0xb1,
-	0xb8, 0xb3, 0xa6, 0xfe, 0xcd, 0xce, 0x3e, 0xd6, 0x57, 0xaa, 0xbd, 0xfc, 0x09, 0xe8, 0x3e, 0xa1,
-	0xaf, 0x9a, 0x73, 0xc1, 0x50, 0xd6, 0x95, 0xfc, 0x99, 0xec, 0xcd, 0x45, 0xc7, 0x2a, 0x67, 0x27,
-	0x1d, 0xab, 0xa8, 0xd5, 0x56, 0xbe, 0x56, 0xe3, 0x5f, 0x42, 0x5b, 0x5c, 0x46, 0x96, 0x93, 0x2c,
-	0x46, 0x04, 0xf0, 0xe7, 0xb0, 0x9d, 0x9d, 0xff, 0xbd, 0x98, 0x04, 0xbe, 0x84, 0x3b, 0xd9, 0xec,
-	0xd7, 0x04, 0x41, 0x9a, 0xd2, 0x85, 0xad, 0xa2, 0xaa, 0x21, 0x57, 0x2d, 0xa4, 0x16, 0x28, 0x5f,
-	0x47, 0xb0, 0x07, 0xfb, 0xf3, 0x77, 0x92, 0x46, 0xff, 0x40, 0x5b, 0xe9, 0x94, 0x30, 0x9a, 0x14,
-	0x76, 0xe9, 0xf9, 0xe6, 0x83, 0x8b, 0x92, 0x94, 0x48, 0xf5, 0x2e, 0xd7, 0x4c, 0xd0, 0x61, 0xb8,
-	0xf8, 0x06, 0xa6, 0xfe, 0xcd, 0x1b, 0x6c, 0x71, 0xa2, 0x2a, 0x2b, 0xa1, 0x7e, 0x10, 0x79, 0xcc,
-	0x49, 0x63, 0x22, 0xd5, 0x57, 0xdd, 0x18, 0x30, 0x59, 0xdf, 0xd3, 0x39, 0x54, 0x27, 0x52, 0xcb,
-	0xa9, 0xf3, 0xa1, 0x9e, 0x1f, 0xe1, 0x5f, 0xc0, 0x96, 0xf0, 0x52, 0x0d, 0xe9, 0x2c, 0xae, 0xab,
-	0xe9, 0xd2, 0x94, 0x64, 0x76, 0x4d, 0xce, 0xc6, 0x8f, 0x55, 0xa0, 0xea, 0xc9, 0x72, 0xf3, 0x85,
-	0x66, 0x7f, 0x9a, 0xa9, 0x79, 0x3a, 0xb7, 0xee, 0xc2, 0xca, 0x40, 0xb4, 0x8d, 0x49, 0x67, 0x58,
-	0x77, 0x9a, 0x83, 0xa4, 0x95, 0xc4, 0x5f, 0x72, 0xf8, 0x49, 0xcd, 0x95, 0x5b, 0x67, 0x91, 0xd2,
-	0xba, 0x01, 0x29, 0xb7, 0x45, 0xdd, 0x16, 0xa1, 0x92, 0x24, 0x38, 0xfe, 0x58, 0x58, 0x67, 0xc8,
-	0x17, 0x49, 0xdd, 0x36, 0x6c, 0x9e, 0x5d, 0xf9, 0x83, 0xec, 0x1d, 0x6d, 0xc3, 0x56, 0x5a, 0x2c,
-	0xd6, 0xc2, 0x1d, 0xe1, 0x06, 0xdb, 0xe4, 0x68, 0x16, 0x5f, 0xbe, 0x0a, 0xc7, 0x6a, 0xc6, 0x87,
-	0x1c, 0x20, 0xd3, 0x23, 0xd2, 0x80, 0x16, 0x54, 0x66, 0xe1, 0x58, 0xc2, 0x2a, 0xfb, 0x29, 0x7b,
-	0x70, 0xae, 0x7c, 0x1c, 0xf8, 0xaf, 0xbd, 0x91, 0x5a, 0xe5, 0x0f, 0x56, 0xb2, 0x81, 0x1a, 0x91,
-	0xab, 0xfc, 0x1c, 0x3a, 0x9e, 0x3f, 0xa2, 0x11, 0x27, 0xd1, 0xd1, 0x34, 0xa4, 0xee, 0x30, 0xc3,
-	0x50, 0xb6, 0xf5, 0xf8, 0x59, 0x32, 0xdc, 0x1b, 0x22, 0x02, 0x9b, 0xd3, 0x59, 0x74, 0x99, 0x9d,
-	0x24, 0x28, 0xdb, 0x06, 0x1b, 0x4a, 0xe9, 0xe3, 0xbf, 0x5a, 0xd0, 0x39, 0x9b, 0x5d, 0x4c, 0xbc,
-	0x02, 0x0b, 0x19, 0x0b, 0x1c, 0x04, 0x43, 0xcd, 0x02, 0xd9, 0xef, 0x6b, 0x4d, 0x2b, 0xbf, 0x8b,
-	0x69, 0x95, 0x79, 0xa6, 0xed, 0xc1, 0x6e, 0x81, 0x65, 0xe2, 0x84, 0x0e, 0xff, 0xb3, 0x0a, 0x35,
-	0x47, 0xfc, 0x0b, 0x01, 0x1d, 0xc0, 0x32, 0x7f, 0x2c, 0x41, 0xab, 0xc4, 0x7c, 0x7c, 0xb1, 0xd7,
-	0x48, 0xea, 0x0d, 0x05, 0x97, 0xd0, 0x31, 0x27, 0x17, 0xc6, 0xb3, 0x07, 0xda, 0x26, 0x85, 0x0f,
-	0x24, 0xf6, 0x0e, 0x29, 0x7e, 0x1f, 0xd1, 0x8b, 0x18, 0xcd, 0xad, 0x58, 0x24, 0xdf, 0x21, 0x8b,
-	0x45, 0x0a, 0xba, 0x60, 0x5c, 0x42, 0x9f, 0x42, 0xd3, 0xe8, 0x26, 0xd1, 0x26, 0xc9, 0x77, 0xb9,
-	0xf6, 0x16, 0x29, 0x68, 0x38, 0x71, 0x09, 0x7d, 0x01, 0xab, 0xa9, 0xfc, 0x42, 0x6d, 0x52, 0xc4,
-	0x6e, 0xed, 0x6d, 0x52, 0x48, 0x5b, 0x71, 0x09, 0xf5, 0xa0, 0x95, 0x45, 0x76, 0xd4, 0x21, 0x73,
-	0xd8, 0xa9, 0xbd, 0x4b, 0xe6, 0xd1, 0x4d, 0xb1, 0x54, 0x96, 0xf9, 0xa1, 0x0e, 0x99, 0xc3, 0x2a,
-	0xed, 0x5d, 0x32, 0x8f, 0x26, 0xe2, 0x12, 0xfa, 0x0c, 0x56, 0xcc, 0x07, 0x71, 0x94, 0xf2, 0x5f,
-	0xa5, 0xab, 0xdd, 0x26, 0x45, 0xaf, 0xe6, 0xb8, 0x84, 0x1e, 0x42, 0x5d, 0x3d, 0x3e, 0xa3, 0x16,
-	0xc9, 0x3c, 0x4d, 0xdb, 0x1b, 0x24, 0xfb, 0x32, 0x8d, 0x4b, 0xe8, 0xeb, 0x0c, 0x52, 0xe9, 0x76,
-	0x1f, 0xdd, 0xbe, 0xfe, 0xed, 0xd2, 0xbe, 0x43, 0xae, 0x7f, 0x52, 0xc4, 0x25, 0x44, 0xa0, 0x26,
-	0xa9, 0x05, 0x5a, 0x27, 0x69, 0x4e, 0x6b, 0xb7, 0x48, 0x86, 0x86, 0xe2, 0x12, 0xfa, 0x19, 0x40,
-	0x42, 0xf3, 0x10, 0x22, 0x39, 0x8e, 0x68, 0x6f, 0x92, 0x3c, 0x0f, 0xc4, 0x25, 0x74, 0xc2, 0x19,
-	0x90, 0xc9, 0xd7, 0x10, 0x8f, 0xbc, 0x02, 0x02, 0x68, 0x77, 0xc8, 0x1c, 0x6a, 0x27, 0x0c, 0x48,
-	0xa8, 0x17, 0x42, 0x24, 0xc7, 0xdb, 0xec, 0x4d, 0x92, 0xe7, 0x66, 0xfa, 0xe4, 0xc5, 0xa3, 0x8a,
-	0xf6, 0x2c, 0x7d, 0xf2, 0x29, 0x98, 0x16, 0x49, 0x94, 0xa6, 0x41, 0x68, 0x9b, 0x14, 0xf2, 0x2a,
-	0x7b, 0x87, 0x14, 0xf3, 0x25, 0x5c, 0x42, 0x6e, 0xbe, 0x11, 0xd2, 0x4f, 0xef, 0xfb, 0xe4, 0x06,
-	0x96, 0x64, 0xdf, 0x25, 0x37, 0xb1, 0x1b, 0xf3, 0x52, 0x38, 0x5a, 0xa8, 0x4b, 0x31, 0x91, 0x62,
-	0x93, 0xe4, 0x99, 0x88, 0x79, 0x98, 0x72, 0x62, 0x8e, 0x7d, 0xe8, 0xc3, 0xcc, 0x4c, 0x14, 0xc8,
-	0xa0, 0xaa, 0x91, 0x40, 0x86, 0x4c, 0xc9, 0x12, 0xc8, 0x90, 0x2b, 0x58, 0x1c, 0x19, 0x52, 0x35,
-	0x1f, 0xb5, 0x49, 0x11, 0x81, 0xb0, 0xb7, 0x49, 0x21, 0x35, 0xd0, 0xe0, 0x66, 0xd4, 0x6e, 0x94,
-	0x41, 0x91, 0x28, 0x05, 0x6e, 0x05, 0x45, 0x3e, 0x01, 0x28, 0x5d, 0x9e, 0x25, 0x40, 0x65, 0xcb,
-	0xb8, 0x04, 0xa8, 0x5c, 0x15, 0x17, 0x50, 0x60, 0xd6, 0x64, 0xb4, 0x45, 0x0a, 0x2a, 0xb7, 0xdd,
-	0x26, 0x85, 0x85, 0x5b, 0x65, 0x84, 0x59, 0xa0, 0x45, 0x46, 0x14, 0x14, 0x73, 0x91, 0x11, 0x45,
-	0xb5, 0x3c, 0x39, 0x8d, 0xa4, 0xfe, 0xa0, 0xc4, 0xe4, 0x54, 0xa9, 0xb4, 0x77, 0x48, 0x71, 0x29,
-	0xc7, 0x25, 0xf4, 0x14, 0x36, 0x72, 0x75, 0x0c, 0xed, 0x92, 0x79, 0x55, 0xd7, 0xb6, 0xc9, 0xdc,
-	0xb2, 0x87, 0x4b, 0x17, 0x55, 0xfe, 0xcc, 0xf1, 0xe8, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xa3,
-	0xe1, 0xa1, 0x6b, 0x41, 0x1f, 0x00, 0x00,
+func init() { proto.RegisterFile("robocup.proto", fileDescriptor_robocup_c61a48116c66836a) }
+
+var fileDescriptor_robocup_c61a48116c66836a = []byte{
+	// 6248 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x3c, 0x4b, 0x6f, 0x23, 0x47,
+	0x7a, 0x6a, 0x4a, 0xe2, 0xe3, 0xa3, 0x44, 0x51, 0x45, 0x89, 0x8f, 0xd6, 0x3c, 0xa4, 0xce, 0xda,
+	0x3b, 0xbb, 0xf6, 0x96, 0xd7, 0x33, 0xeb, 0xf5, 0xae, 0xd7, 0xde, 0x35, 0x87, 0xe2, 0xc8, 0x9c,
+	0xd1, 0x3c, 0xdc, 0x92, 0xd6, 0x5e, 0x78, 0xb1, 0x4c, 0x0f, 0x59, 0xa3, 0x69, 0x0f, 0xc9, 0x66,
+	0xba, 0x9b, 0x33, 0xd6, 0x21, 0x08, 0x36, 0x8b, 0x9c, 0x92, 0x43, 0x10, 0xe4, 0x90, 0x5c, 0x16,
+	0xc8, 0x02, 0xb9, 0xe5, 0x96, 0x43, 0x90, 0x4b, 0x1e, 0x3f, 0x20, 0x40, 0x8e, 0x09, 0x92, 0x1f,
+	0x90, 0x00, 0x39, 0x05, 0x48, 0xce, 0x41, 0xbd, 0xba, 0xab, 0x5f, 0x24, 0x25, 0xdb, 0x40, 0x4e,
+	0x52, 0x7d, 0xf5, 0xd5, 0xd7, 0x5f, 0x7d, 0x55, 0xf5, 0xd5, 0xf7, 0x2a, 0xc2, 0xa6, 0xeb, 0x3c,
+	0x75, 0x06, 0xb3, 0x29, 0x9e, 0xba, 0x8e, 0xef, 0xe8, 0x37, 0xcf, 0x1d, 0xe7, 0x7c, 0x44, 0xde,
+	0x62, 0xad, 0xa7, 0xb3, 0x67, 0x6f, 0xf9, 0xf6, 0x98, 0x78, 0xbe, 0x35, 0x16, 0x08, 0xc6, 0x7f,
+	0xe5, 0xa0, 0x78, 0x68, 0xbf, 0xb4, 0x3d, 0xdb, 0x99, 0xa0, 0x0a, 0xe4, 0xec, 0x61, 0x53, 0xdb,
+	0xd7, 0x6e, 0x95, 0xcc, 0x9c, 0x3d, 0x44, 0x08, 0xd6, 0x26, 0xd6, 0x98, 0x34, 0x73, 0x0c, 0xc2,
+	0xfe, 0x47, 0xb7, 0x20, 0x3f, 0x22, 0xd6, 0xf9, 0x8c, 0x34, 0x57, 0xf7, 0xb5, 0x5b, 0x95, 0xdb,
+	0x55, 0x2c, 0x87, 0xe3, 0x63, 0x06, 0x37, 0x45, 0x3f, 0xfa, 0x0e, 0xa0, 0x81, 0x33, 0x9e, 0x12,
+	0xdf, 0xf6, 0x6d, 0x67, 0xd2, 0x77, 0x9d, 0xd9, 0x64, 0xe8, 0x35, 0xd7, 0xf6, 0xb5, 0x5b, 0xeb,
+	0xe6, 0xb6, 0xd2, 0x63, 0xb2, 0x0e, 0x74, 0x00, 0x1b, 0xcf, 0xec, 0x89, 0x35, 0x92, 0x88, 0xeb,
+	0x0c, 0xb1, 0xcc, 0x60, 0x02, 0xe5, 0x36, 0xec, 0xda, 0x13, 0x9f, 0xb8, 0x2f, 0x6d, 0xf2, 0xaa,
+	0xef, 0x93, 0xf1, 0x74, 0x64, 0xf9, 0xa4, 0x6f, 0x0f, 0x9b, 0x79, 0xc6, 0x60, 0x2d, 0xe8, 0x3c,
+	0x15, 0x7d, 0xbd, 0x21, 0xfa, 0x3e, 0x34, 0xa6, 0xc4, 0x7d, 0xe6, 0xb8, 0x63, 0x6b, 0x32, 0x20,
+	0x91, 0x51, 0x05, 0x36, 0x6a, 0x57, 0xe9, 0x56, 0xc6, 0xbd, 0x06, 0x15, 0x95, 0x7b, 0x7b, 0xd8,
+	0x2c, 0x32, 0xf4, 0x4d, 0x05, 0xda, 0x1b, 0x1a, 0xdf, 0x81, 0x3c, 0x9f, 0x36, 0x2a, 0x43, 0xe1,
+	0xf1, 0xa3, 0x93, 0xd3, 0xf6, 0x51, 0xb7, 0xba, 0x82, 0x00, 0xf2, 0x66, 0xf7, 0xa4, 0x73, 0xd6,
+	0xad, 0x6a, 0xf4, 0xff, 0x93, 0xc7, 0x9d, 0x4e, 0xd7, 0xac, 0xe6, 0x8c, 0x11, 0x94, 0x3b, 0xe1,
+	0xf8, 0xa5, 0x04, 0xfe, 0x43, 0x80, 0x81, 0x4b, 0x2c, 0x9f, 0x0c, 0xfb, 0x96, 0xcf, 0x84, 0x5e,
+	0xbe, 0xad, 0x63, 0xbe, 0xae, 0x58, 0xae, 0x2b, 0x3e, 0x95, 0xeb, 0x6a, 0x96, 0x04, 0x76, 0xdb,
+	0x37, 0xde, 0x86, 0x72, 0x6f, 0xe2, 0xf9, 0xb6, 0x3f, 0x5b, 0xf6, 0x6b, 0xc6, 0x1f, 0x68, 0x90,
+	0x7f, 0x48, 0xc6, 0x4f, 0x89, 0xbb, 0x14, 0x73, 0xaf, 0x43, 0xfe, 0x9c, 0x4c, 0x86, 0xc4, 0x15,
+	0xbb, 0xa1, 0x82, 0xf9, 0x60, 0x7c, 0xc4, 0xa0, 0xa6, 0xe8, 0x35, 0xde, 0x82, 0x3c, 0x87, 0xa0,
+	0x2d, 0x28, 0x9f, 0x3d, 0x3a, 0x79, 0xd2, 0xed, 0xf4, 0xee, 0xf5, 0xba, 0x87, 0xd5, 0x15, 0x54,
+	0x84, 0xb5, 0x87, 0xed, 0x63, 0x21, 0xa8, 0x7b, 0x5d, 0xf6, 0x7f, 0xce, 0xf8, 0x67, 0x0d, 0xd6,
+	0x4e, 0x89, 0x35, 0x5e, 0x8a, 0x0b, 0x0c, 0x65, 0x3b, 0x9c, 0xa7, 0x90, 0xd1, 0x06, 0x56, 0xe6,
+	0x6e, 0xaa, 0x08, 0x48, 0x87, 0xe2, 0x50, 0x6c, 0x5a, 0xb6, 0x1f, 0x4b, 0x66, 0xd0, 0x46, 0x7b,
+	0x50, 0xb2, 0xc7, 0x53, 0xc7, 0xf5, 0xe9, 0x92, 0xaf, 0xf3, 0x4e, 0x0e, 0xe8, 0x0d, 0xd1, 0x01,
+	0x14, 0xc6, 0x6c, 0x7e, 0x5e, 0x33, 0xbf, 0xbf, 0x7a, 0xab, 0x7c, 0xbb, 0x20, 0xe6, 0x6b, 0x4a,
+	0x38, 0x6a, 0x42, 0xe1, 0x25, 0x71, 0x19, 0xe9, 0x02, 0xdb, 0xc1, 0xb2, 0x69, 0xbc, 0x07, 0xb5,
+	0x23, 0xe2, 0xcb, 0xd3, 0xe2, 0x99, 0xe4, 0x77, 0x66, 0xc4, 0xf3, 0xd1, 0x6f, 0xc1, 0xa6, 0xe5,
+	0x79, 0xf6, 0xf9, 0x84, 0x0c, 0xfb, 0xce, 0x64, 0x74, 0xc1, 0xe6, 0x5a, 0x34, 0x37, 0x24, 0xf0,
+	0xf1, 0x64, 0x74, 0x61, 0xfc, 0x04, 0x76, 0xa2, 0x63, 0xbd, 0xa9, 0x33, 0xf1, 0x08, 0xfa, 0x26,
+	0x94, 0x24, 0xe7, 0x5e, 0x53, 0x63, 0x2c, 0x95, 0x82, 0x03, 0x69, 0x86, 0x7d, 0xc6, 0xaf, 0x73,
+	0xb0, 0x76, 0xe6, 0x2d, 0xb9, 0xaa, 0x3a, 0x14, 0x67, 0x1e, 0x71, 0x19, 0x7c, 0x95, 0x8b, 0x40,
+	0xb6, 0x51, 0x0b, 0x8a, 0xb6, 0xd7, 0xb7, 0x86, 0x63, 0x9b, 0xcb, 0xae, 0x68, 0x16, 0x6c, 0xaf,
+	0x4d, 0x9b, 0x74, 0xd8, 0xd4, 0xf2, 0xbc, 0x57, 0x8e, 0x1b, 0x48, 0x4e, 0xb6, 0xd1, 0x3e, 0xac,
+	0xbb, 0xce, 0x88, 0x70, 0xb9, 0x55, 0x6e, 0x03, 0xa6, 0xcc, 0x60, 0xd3, 0x19, 0x11, 0x93, 0x77,
+	0xa0, 0xef, 0xc2, 0xce, 0x78, 0xe6, 0xf9, 0xfd, 0xc1, 0x73, 0x6b, 0x72, 0x4e, 0xfa, 0x01, 0xa5,
+	0x02, 0xfb, 0x08, 0xa2, 0x7d, 0x1d, 0xd6, 0xf5, 0x44, 0xf4, 0x18, 0x0f, 0x60, 0x8d, 0x12, 0xa0,
+	0xfb, 0xe6, 0xa7, 0xbd, 0xee, 0x27, 0x5d, 0xb3, 0xba, 0x82, 0x4a, 0xb0, 0x7e, 0xff, 0xec, 0xf0,
+	0x88, 0x6e, 0xa7, 0x0a, 0xc0, 0x47, 0xdd, 0xf6, 0x61, 0x9f, 0xb7, 0x73, 0x68, 0x1b, 0x36, 0x3b,
+	0x1f, 0x75, 0x3b, 0x0f, 0x7a, 0x8f, 0xfa, 0xed, 0xa3, 0xee, 0xa3, 0xd3, 0xea, 0x2a, 0xc5, 0x6e,
+	0x1f, 0x3e, 0xec, 0x3d, 0xaa, 0xae, 0x19, 0xdb, 0xb0, 0x75, 0x44, 0x7c, 0xca, 0x95, 0x5c, 0x19,
+	0xe3, 0x2d, 0xa8, 0x86, 0x20, 0x21, 0xf0, 0x3d, 0x58, 0xa7, 0xa2, 0x90, 0xc2, 0x5e, 0x67, 0xf3,
+	0x30, 0x39, 0xcc, 0xf8, 0xb7, 0x55, 0x68, 0x9d, 0x0c, 0x1c, 0x97, 0x9c, 0x3c, 0x27, 0xc4, 0x97,
+	0xca, 0xe4, 0x84, 0x0c, 0x52, 0x8f, 0xdf, 0x0e, 0xac, 0xfb, 0xb6, 0x3f, 0x92, 0xa2, 0xe7, 0x0d,
+	0xb4, 0x0f, 0xe5, 0x21, 0xf1, 0x06, 0xae, 0x3d, 0x0d, 0xf6, 0x72, 0xc9, 0x54, 0x41, 0x74, 0x87,
+	0x8e, 0xad, 0x2f, 0xfa, 0x2f, 0xad, 0xd1, 0x8c, 0x08, 0x75, 0x5a, 0x1c, 0x5b, 0x5f, 0xfc, 0x94,
+	0xb6, 0xd1, 0x0d, 0x80, 0xf1, 0x6c, 0xe4, 0xdb, 0xd3, 0x91, 0x4d, 0x5c, 0xa1, 0x43, 0x15, 0x08,
+	0xdd, 0x6d, 0x43, 0xdb, 0x9b, 0x8e, 0xac, 0x8b, 0xbe, 0xe3, 0xd2, 0x73, 0x9b, 0x67, 0x28, 0x1b,
+	0x02, 0xf8, 0x98, 0xc2, 0xd0, 0x1d, 0x58, 0x7b, 0x61, 0x4f, 0xb8, 0xe8, 0x2b, 0xb7, 0x6f, 0xe2,
+	0xcc, 0x39, 0xe1, 0x07, 0xf6, 0x64, 0x68, 0x32, 0x64, 0xba, 0x91, 0x3c, 0x9f, 0x4c, 0x99, 0x9a,
+	0xd4, 0x4c, 0xf6, 0x3f, 0xfa, 0x01, 0xbd, 0x2c, 0x5e, 0x92, 0x91, 0xd7, 0x2c, 0x31, 0x71, 0xed,
+	0xcf, 0x21, 0x75, 0x4c, 0x11, 0x4d, 0x81, 0x8f, 0xea, 0x90, 0x9f, 0x3a, 0xf6, 0xc4, 0xf7, 0x9a,
+	0xc0, 0xe8, 0x89, 0x96, 0x7e, 0x07, 0xd6, 0x19, 0x22, 0x95, 0xde, 0xc8, 0x7a, 0x4a, 0x46, 0x42,
+	0xa0, 0xbc, 0x41, 0xa1, 0x5c, 0x2e, 0x39, 0x36, 0x8a, 0x37, 0x8c, 0x43, 0x58, 0xa3, 0x8c, 0x52,
+	0x15, 0xfd, 0xe8, 0xec, 0x61, 0xd7, 0xec, 0x75, 0xaa, 0x2b, 0x68, 0x03, 0x8a, 0x6c, 0x3b, 0xdc,
+	0x7d, 0xfc, 0x69, 0x55, 0xa3, 0x3b, 0xe1, 0xa4, 0xc3, 0x54, 0x0f, 0xfd, 0xb7, 0xf3, 0xf8, 0x8c,
+	0xed, 0x8f, 0x32, 0x14, 0x9e, 0x74, 0x1f, 0xb5, 0x8f, 0x4f, 0x7f, 0x56, 0x5d, 0x33, 0xfe, 0x22,
+	0x07, 0x28, 0xc9, 0xfe, 0x52, 0x07, 0xea, 0x4d, 0x58, 0xf3, 0x2f, 0xa6, 0xf2, 0xca, 0x6c, 0xa6,
+	0x48, 0x01, 0x9f, 0x5e, 0x4c, 0x89, 0xc9, 0xb0, 0xa8, 0x0a, 0xf1, 0xed, 0xb1, 0x3d, 0x39, 0xa7,
+	0xb7, 0xe5, 0xea, 0xad, 0x92, 0x29, 0x9b, 0xe8, 0xfb, 0x50, 0xf4, 0xb8, 0xb8, 0xe8, 0xfd, 0xb8,
+	0xca, 0x6e, 0x82, 0x4c, 0x89, 0x9a, 0x01, 0x6e, 0xca, 0x65, 0x96, 0x4f, 0xb9, 0xcc, 0xe6, 0xe8,
+	0xae, 0xd7, 0x61, 0x8d, 0x32, 0x88, 0x36, 0xa1, 0xd4, 0x7b, 0x74, 0xda, 0x35, 0xe9, 0x79, 0xab,
+	0xae, 0x50, 0x65, 0xfe, 0xa4, 0x6b, 0xde, 0x7b, 0x6c, 0x3e, 0x6c, 0x3f, 0xea, 0x74, 0xab, 0x9a,
+	0xf1, 0x37, 0x1a, 0x5c, 0x3f, 0x22, 0x7e, 0x92, 0xa7, 0x40, 0xdd, 0xdd, 0x83, 0xfc, 0x33, 0x7b,
+	0xe4, 0x13, 0x97, 0x89, 0xac, 0x7c, 0x1b, 0xe3, 0xb9, 0xf8, 0xf8, 0xe3, 0x19, 0x71, 0x2f, 0x9e,
+	0x58, 0xae, 0x35, 0x26, 0x3e, 0x3d, 0x88, 0x62, 0x34, 0x7a, 0x03, 0xb6, 0xa7, 0xce, 0x74, 0xc6,
+	0xee, 0xf2, 0x40, 0x26, 0x39, 0xa6, 0x2b, 0xaa, 0xb2, 0x43, 0x08, 0xc2, 0xd3, 0x0f, 0x60, 0x2b,
+	0x46, 0x27, 0x58, 0xb6, 0x55, 0xbe, 0x6c, 0x86, 0x0d, 0x37, 0xb2, 0x18, 0x11, 0x47, 0xff, 0x08,
+	0x76, 0x3d, 0xda, 0xdd, 0xf7, 0x68, 0x7f, 0x60, 0x49, 0x48, 0x55, 0x50, 0x4b, 0x59, 0x09, 0xb3,
+	0xe6, 0x25, 0x09, 0x1a, 0x4f, 0x61, 0xe3, 0xd8, 0x39, 0xb7, 0x27, 0x52, 0x24, 0xaa, 0xba, 0xd5,
+	0x62, 0xea, 0x56, 0xd5, 0xa9, 0xb9, 0x98, 0x4e, 0xa5, 0x7d, 0xae, 0xf3, 0xd2, 0x96, 0xd7, 0x6f,
+	0xc9, 0x0c, 0xda, 0xc6, 0x1f, 0x6a, 0xb0, 0xd1, 0x9e, 0xf9, 0xcf, 0x9f, 0x08, 0x40, 0xb0, 0x2d,
+	0xb5, 0xc8, 0xed, 0xcd, 0xb7, 0x65, 0x8e, 0x6d, 0x4b, 0x84, 0xd5, 0x01, 0xea, 0x86, 0xdc, 0x83,
+	0xd2, 0x88, 0x32, 0xdc, 0x9f, 0xb9, 0x23, 0xf9, 0x25, 0x06, 0x38, 0x73, 0x47, 0x86, 0x21, 0xb6,
+	0xc6, 0x06, 0x14, 0x9f, 0xb4, 0x4f, 0x4e, 0x3e, 0x79, 0x6c, 0x1e, 0xf2, 0xd3, 0x65, 0x76, 0x0f,
+	0x7b, 0x66, 0xb7, 0x73, 0x5a, 0xd5, 0x8c, 0x6f, 0x43, 0xfd, 0xee, 0x6c, 0xf4, 0xa2, 0xc3, 0x2c,
+	0x13, 0x55, 0xc7, 0xa2, 0x2a, 0xac, 0x0e, 0xbc, 0x97, 0x82, 0x2b, 0xfa, 0xaf, 0xf1, 0x6b, 0x0d,
+	0x2a, 0x14, 0x99, 0xa2, 0x99, 0xc4, 0x9b, 0x8d, 0x18, 0x92, 0xeb, 0xbc, 0x62, 0x48, 0xeb, 0x26,
+	0xfd, 0x37, 0x22, 0xb2, 0x5c, 0xe2, 0x86, 0x5a, 0xa3, 0xff, 0x0b, 0x33, 0x40, 0x68, 0x68, 0x06,
+	0xa2, 0x26, 0xe9, 0x39, 0x99, 0x10, 0x97, 0x59, 0x53, 0x81, 0x5c, 0xb9, 0x09, 0xb0, 0x1d, 0xf4,
+	0xc8, 0x0b, 0x86, 0x6a, 0x13, 0xe2, 0xba, 0x8e, 0x2b, 0x6e, 0x33, 0xde, 0x30, 0x7e, 0x01, 0x8d,
+	0xc4, 0x64, 0xc4, 0x16, 0x69, 0x42, 0x41, 0x58, 0x5f, 0xe2, 0x16, 0x97, 0x4d, 0xf4, 0x2d, 0x28,
+	0xb8, 0x6c, 0x32, 0x74, 0x93, 0xd2, 0xed, 0xb2, 0x85, 0xa3, 0x93, 0x34, 0x65, 0xbf, 0x41, 0x60,
+	0x37, 0x7a, 0xd1, 0x49, 0x59, 0x7d, 0x0b, 0xaa, 0x83, 0x99, 0xeb, 0x92, 0x89, 0x1f, 0xf2, 0xce,
+	0x05, 0xb7, 0x25, 0xe0, 0x01, 0xe7, 0x07, 0xb0, 0x31, 0x21, 0xaf, 0xfa, 0xb1, 0xad, 0x53, 0x9e,
+	0x90, 0x57, 0xc1, 0xed, 0x79, 0x07, 0xea, 0xf1, 0xcf, 0x88, 0x59, 0x48, 0x01, 0x6a, 0x09, 0x01,
+	0x1a, 0x77, 0xa0, 0x69, 0x12, 0x8f, 0x5f, 0x8a, 0x71, 0xf6, 0x1a, 0x50, 0xa0, 0x38, 0xfd, 0x40,
+	0x1b, 0xe6, 0x69, 0xb3, 0x37, 0x34, 0xee, 0x43, 0x2b, 0x65, 0x90, 0xf8, 0xd8, 0x77, 0x00, 0xd1,
+	0x93, 0xe4, 0xb8, 0x96, 0x7b, 0x11, 0x9f, 0xd6, 0x76, 0xd0, 0x13, 0x70, 0xfd, 0x67, 0x1a, 0x6c,
+	0x9c, 0x31, 0xb2, 0x64, 0xe2, 0xdb, 0xfe, 0x45, 0xe4, 0x10, 0x68, 0xd1, 0x43, 0x40, 0x97, 0xc3,
+	0x9b, 0x3d, 0xfd, 0x9c, 0x0c, 0x7c, 0x21, 0x00, 0xd9, 0x54, 0x79, 0x5d, 0x55, 0x79, 0x8d, 0x59,
+	0xdb, 0x6b, 0x97, 0xb1, 0xb6, 0xef, 0x40, 0x53, 0x98, 0x0b, 0x82, 0x39, 0x3b, 0xd4, 0x7a, 0xf3,
+	0x64, 0x93, 0x32, 0x28, 0x90, 0x0d, 0xd8, 0x01, 0x54, 0xa8, 0x99, 0x4d, 0xac, 0x4e, 0xdf, 0x54,
+	0x10, 0x8c, 0x43, 0x68, 0x1c, 0xdb, 0x93, 0x17, 0x91, 0xfe, 0x60, 0xeb, 0x14, 0x05, 0xe2, 0x85,
+	0x58, 0xd6, 0x18, 0x9d, 0xa0, 0xdb, 0xe8, 0x42, 0x33, 0x49, 0x45, 0x30, 0x74, 0x09, 0x32, 0x1f,
+	0x43, 0xeb, 0x6c, 0x32, 0xca, 0x60, 0xe7, 0x4a, 0x8b, 0x66, 0x5c, 0x03, 0x3d, 0x8d, 0x24, 0xe7,
+	0xcd, 0x68, 0x41, 0xe3, 0x88, 0xf8, 0xaa, 0x0a, 0x0b, 0x0c, 0xb9, 0x23, 0x68, 0x26, 0xbb, 0xc4,
+	0x94, 0xde, 0x80, 0x92, 0xfc, 0x74, 0x28, 0x62, 0x15, 0xd5, 0x0c, 0xfb, 0x8d, 0x2e, 0x6c, 0x0a,
+	0xcd, 0x2d, 0x46, 0x7f, 0x0f, 0x90, 0x35, 0xf3, 0x9f, 0x53, 0x56, 0x06, 0x6c, 0xd3, 0x24, 0x0f,
+	0xce, 0x76, 0x04, 0x81, 0x82, 0x8c, 0x2d, 0x46, 0xc6, 0x99, 0xf9, 0x92, 0xc1, 0x2a, 0x54, 0x24,
+	0x40, 0xcc, 0xa6, 0x01, 0xbb, 0x47, 0xc4, 0xef, 0xf0, 0x63, 0xcd, 0xe8, 0x08, 0xd4, 0x47, 0x50,
+	0x8f, 0x77, 0x7c, 0x29, 0x5e, 0xfe, 0x65, 0x15, 0x2a, 0xd2, 0x61, 0x38, 0xb6, 0x86, 0x74, 0x05,
+	0x5e, 0x53, 0xdc, 0x23, 0x3e, 0x5c, 0xf1, 0x29, 0x82, 0x2e, 0x74, 0x07, 0xf2, 0x23, 0x36, 0x40,
+	0x68, 0xb4, 0x3d, 0x1c, 0xa5, 0x83, 0xf9, 0x9f, 0xee, 0xc4, 0x77, 0x2f, 0x4c, 0x81, 0xaa, 0xff,
+	0x67, 0x0e, 0xca, 0x0a, 0x9c, 0xea, 0x1a, 0x9f, 0x58, 0xe3, 0x80, 0x4d, 0xea, 0xf3, 0x99, 0x0c,
+	0x84, 0x3e, 0x84, 0xbc, 0x08, 0x05, 0x70, 0xfa, 0xb7, 0xe6, 0xd0, 0xc7, 0x2c, 0x42, 0xd0, 0x7e,
+	0x49, 0x5c, 0xeb, 0x9c, 0x98, 0x62, 0x1c, 0xfa, 0x26, 0x6c, 0x85, 0xf1, 0x02, 0x76, 0x13, 0xb3,
+	0xd3, 0xae, 0x99, 0x95, 0x00, 0xcc, 0xee, 0x6c, 0x74, 0x1d, 0xe0, 0x29, 0xf1, 0x7c, 0x1e, 0x7a,
+	0x60, 0xa7, 0x5e, 0x33, 0x4b, 0x14, 0xc2, 0xc8, 0x06, 0xdd, 0x2c, 0x16, 0xd1, 0x5c, 0x0f, 0xbb,
+	0xef, 0x51, 0x00, 0xba, 0x09, 0x65, 0x36, 0xb0, 0xef, 0x3b, 0xbe, 0x35, 0x62, 0xa6, 0x95, 0x66,
+	0x02, 0x03, 0x9d, 0x3a, 0x3e, 0x47, 0x60, 0x43, 0x05, 0x42, 0x81, 0x23, 0x30, 0x10, 0x43, 0xd0,
+	0x4f, 0x61, 0x43, 0x9d, 0x00, 0xbd, 0x78, 0x38, 0x2b, 0xfc, 0xca, 0xe3, 0x0d, 0x7a, 0x32, 0x2c,
+	0x8e, 0x20, 0xcc, 0xdb, 0x82, 0x15, 0xe2, 0x0f, 0x9c, 0xd9, 0x84, 0x87, 0x07, 0xd6, 0x4d, 0xde,
+	0x30, 0x6e, 0xb3, 0x3d, 0x74, 0x68, 0x4d, 0x06, 0x84, 0x8b, 0x4a, 0x1e, 0xbf, 0x16, 0x14, 0xbd,
+	0xe7, 0xce, 0xab, 0xbe, 0x35, 0x1a, 0xc9, 0x7b, 0x8a, 0xb6, 0xdb, 0xa3, 0x91, 0x71, 0x04, 0xf5,
+	0xf8, 0x98, 0x40, 0x19, 0x25, 0x5c, 0xcd, 0xad, 0xd8, 0x8a, 0xa8, 0x0e, 0xe7, 0x5f, 0x69, 0x80,
+	0x14, 0x97, 0x55, 0x7e, 0xfa, 0x26, 0x94, 0x25, 0x4e, 0xa8, 0x0c, 0x41, 0x82, 0x7a, 0x43, 0xea,
+	0xa0, 0xd8, 0x93, 0xc1, 0x68, 0x36, 0x24, 0x7d, 0xba, 0x0b, 0xa4, 0x4d, 0xb7, 0x21, 0x80, 0x74,
+	0x7f, 0x78, 0xd4, 0xf8, 0x0b, 0x91, 0xa4, 0x19, 0xb6, 0xca, 0x8d, 0xbf, 0x00, 0x51, 0xc0, 0x93,
+	0x0e, 0xf6, 0x5a, 0x8a, 0x83, 0xfd, 0x47, 0x5a, 0xc4, 0x3b, 0x0f, 0x66, 0xbd, 0xe4, 0x59, 0xd8,
+	0x83, 0x75, 0xc9, 0xed, 0x6a, 0xb8, 0x8f, 0x39, 0x0c, 0xbd, 0x0d, 0x25, 0x95, 0xcb, 0x4c, 0x63,
+	0x31, 0xc4, 0x32, 0xfe, 0x23, 0x07, 0xdb, 0x21, 0xc6, 0xff, 0x2b, 0x0f, 0xf2, 0x3a, 0x80, 0xb0,
+	0xb7, 0x43, 0x3f, 0xa2, 0x24, 0x20, 0xbd, 0x61, 0xe8, 0x81, 0x15, 0x14, 0x0f, 0x2c, 0xf0, 0x28,
+	0x8b, 0x57, 0xf1, 0x28, 0x4b, 0xa9, 0x1e, 0x25, 0x5c, 0xd9, 0xa3, 0x2c, 0xab, 0x1e, 0xa5, 0xf1,
+	0xc7, 0xeb, 0x00, 0x21, 0x8d, 0x84, 0x8c, 0x75, 0x28, 0x0e, 0x9c, 0xf1, 0x98, 0x4c, 0x7c, 0x4f,
+	0x5a, 0x9a, 0xb2, 0x1d, 0x1e, 0xd3, 0x55, 0xf5, 0x98, 0x4a, 0x95, 0xb6, 0x96, 0x54, 0x69, 0xd7,
+	0x21, 0x4f, 0x35, 0xb0, 0xb0, 0x28, 0x03, 0xb5, 0x2c, 0x80, 0x08, 0x2b, 0xee, 0x1d, 0x8f, 0x2f,
+	0x21, 0x9c, 0xd8, 0x05, 0x8a, 0x5b, 0xf7, 0x66, 0xe8, 0x28, 0x16, 0x12, 0xe8, 0xd4, 0x48, 0xb1,
+	0x27, 0xe7, 0xa1, 0xf3, 0x28, 0x9d, 0xd0, 0xe2, 0x52, 0x4e, 0xe8, 0x3b, 0xd0, 0x48, 0xf3, 0x76,
+	0xe8, 0x9a, 0x97, 0x98, 0x18, 0x76, 0x92, 0xae, 0x4d, 0x6f, 0x18, 0x3f, 0xdf, 0x90, 0x38, 0xdf,
+	0x74, 0xcf, 0x32, 0x2d, 0xc8, 0x57, 0x81, 0x37, 0xa8, 0x69, 0x1b, 0x7c, 0x41, 0xba, 0xa0, 0x1b,
+	0x4c, 0xa8, 0x5b, 0x12, 0xfe, 0x53, 0x0e, 0x46, 0xdf, 0x86, 0xbc, 0xe7, 0x5b, 0xfe, 0xcc, 0x6b,
+	0x6e, 0x0a, 0xb7, 0x45, 0x99, 0xf3, 0x09, 0xeb, 0x31, 0x05, 0x86, 0xea, 0xd0, 0x56, 0x22, 0x0e,
+	0x2d, 0x7a, 0x17, 0x4a, 0x64, 0x68, 0x0b, 0x33, 0x6f, 0x6b, 0xa1, 0x99, 0x57, 0xe4, 0xc8, 0x6d,
+	0x5f, 0xbf, 0x0d, 0x79, 0x2e, 0xd8, 0x54, 0x8f, 0x2a, 0x12, 0x7f, 0x28, 0xc9, 0xf8, 0x03, 0x86,
+	0x3c, 0x67, 0x8c, 0xc6, 0x16, 0x0e, 0xcd, 0xf6, 0xbd, 0xd3, 0xea, 0x0a, 0x75, 0xa5, 0x4f, 0xce,
+	0xee, 0x3e, 0xec, 0x9d, 0x9e, 0x76, 0x0f, 0x79, 0xf0, 0xf3, 0xf8, 0x71, 0xe7, 0x41, 0xf7, 0xb0,
+	0x9a, 0x33, 0xfe, 0x36, 0x07, 0x25, 0x76, 0x1f, 0x1c, 0x3b, 0x83, 0x17, 0x89, 0x1d, 0x19, 0x13,
+	0x71, 0x2e, 0x4d, 0xc4, 0x29, 0xdb, 0xd2, 0xa0, 0x4e, 0xdc, 0xe0, 0x05, 0x19, 0xf6, 0x9f, 0x5e,
+	0x34, 0xd7, 0xd4, 0xed, 0x57, 0xe4, 0xf0, 0xbb, 0x17, 0x54, 0x2a, 0x02, 0xc7, 0xf2, 0x9b, 0xeb,
+	0x8b, 0xa5, 0xc2, 0x91, 0xdb, 0x3e, 0x7a, 0x1d, 0xca, 0xb3, 0x49, 0x48, 0x3e, 0xaf, 0x92, 0x07,
+	0xd9, 0x73, 0xf7, 0x02, 0xfd, 0x48, 0xc1, 0xb3, 0xfc, 0x66, 0x61, 0xe1, 0x27, 0x82, 0xc1, 0x6d,
+	0x16, 0x29, 0xe5, 0xad, 0xbe, 0x4b, 0x2c, 0xcf, 0x99, 0x88, 0x88, 0xfc, 0x06, 0x07, 0x9a, 0x0c,
+	0x66, 0xf4, 0xa0, 0x4a, 0xa5, 0xc6, 0xc4, 0xb7, 0xf4, 0xa5, 0x13, 0x48, 0x2c, 0xa7, 0x48, 0xcc,
+	0xf8, 0x31, 0x6c, 0x2b, 0xa4, 0x02, 0x13, 0x98, 0xdf, 0xec, 0x7d, 0xfa, 0x4d, 0x71, 0x25, 0x00,
+	0x0e, 0x56, 0xcb, 0x2c, 0xb9, 0xf2, 0x5f, 0x63, 0x00, 0xe8, 0x8c, 0xb3, 0xf6, 0xe5, 0x99, 0xa1,
+	0xea, 0x4b, 0xcc, 0x5a, 0x38, 0x2c, 0xbc, 0x65, 0x7c, 0x08, 0xb5, 0xc8, 0x47, 0x2e, 0xcf, 0xe6,
+	0xbb, 0x2c, 0xb6, 0x1c, 0x74, 0x79, 0xcb, 0x32, 0x6a, 0x1c, 0xc2, 0x6e, 0x6c, 0x60, 0x60, 0x53,
+	0x97, 0xc3, 0x8f, 0x4b, 0x63, 0x41, 0xfd, 0x3a, 0x04, 0x5f, 0xf7, 0x8c, 0x7f, 0x5d, 0x53, 0xc3,
+	0x6a, 0x26, 0xc9, 0xc8, 0x45, 0x7d, 0x03, 0x2a, 0xaa, 0x3e, 0x0a, 0x36, 0xfe, 0x46, 0xa8, 0x86,
+	0xa2, 0x11, 0xac, 0xd5, 0xe8, 0x81, 0xdf, 0x87, 0xa2, 0x67, 0xbd, 0x4c, 0xd9, 0xfd, 0x05, 0x06,
+	0xbe, 0x7b, 0x81, 0xde, 0x91, 0x18, 0x4b, 0xed, 0x7d, 0x3e, 0xac, 0xed, 0xa3, 0x37, 0xa1, 0xac,
+	0x30, 0x26, 0xb6, 0x7e, 0x59, 0x51, 0x4a, 0x26, 0x84, 0x2c, 0xa2, 0xfb, 0xb0, 0x25, 0x6f, 0x4f,
+	0x1e, 0xe8, 0x96, 0xaa, 0xfb, 0x00, 0x27, 0x85, 0x80, 0x85, 0xca, 0xe7, 0x7e, 0xba, 0x59, 0xf1,
+	0xd4, 0xa6, 0xc7, 0xe2, 0x01, 0xe2, 0x2a, 0x12, 0xc4, 0xf8, 0x05, 0x5b, 0x34, 0xb7, 0x24, 0x9c,
+	0xa3, 0x0e, 0xa9, 0x25, 0x2c, 0xae, 0x81, 0x00, 0xb3, 0xc4, 0x30, 0x2b, 0x02, 0x2c, 0x11, 0x0f,
+	0x60, 0x83, 0xde, 0x54, 0x01, 0x16, 0x30, 0xac, 0x32, 0x85, 0x49, 0x94, 0xd7, 0xa0, 0xc2, 0xd5,
+	0x6b, 0x80, 0x54, 0x66, 0x48, 0x9b, 0x1c, 0x2a, 0xd0, 0xf4, 0x5f, 0x6a, 0xb0, 0x19, 0xe1, 0x3f,
+	0x66, 0x39, 0x68, 0x29, 0x96, 0x43, 0x8a, 0x35, 0xf3, 0x1a, 0x54, 0xa6, 0x2e, 0x79, 0x69, 0x3b,
+	0x33, 0x4f, 0x18, 0x2c, 0xdc, 0x84, 0xdf, 0x94, 0x50, 0x6e, 0xb5, 0x04, 0x8a, 0x77, 0x4d, 0x0d,
+	0xfc, 0x76, 0x60, 0x2f, 0x12, 0xd4, 0xfb, 0xc8, 0xf6, 0x7c, 0xc7, 0x0d, 0xdc, 0xd0, 0xe4, 0x9e,
+	0xd2, 0x92, 0x7b, 0xca, 0xf8, 0x18, 0xae, 0xa5, 0x13, 0x11, 0xbb, 0xfd, 0x6d, 0x28, 0xb9, 0x24,
+	0x6a, 0x18, 0xd7, 0x52, 0x16, 0xd3, 0x0c, 0xb1, 0x8c, 0x5f, 0x69, 0xb0, 0x6f, 0x12, 0x4a, 0x86,
+	0xa4, 0x20, 0x5e, 0x86, 0x3b, 0xe6, 0x7c, 0x90, 0xc4, 0x6d, 0x20, 0x41, 0xf3, 0x8e, 0x84, 0xf1,
+	0x31, 0x1c, 0xcc, 0x61, 0x42, 0xcc, 0x2e, 0xb6, 0xbd, 0xb5, 0xb9, 0xdb, 0xdb, 0xf8, 0x27, 0x0d,
+	0x76, 0x0e, 0x67, 0xd3, 0x11, 0x73, 0x30, 0x43, 0x1c, 0x4f, 0xb1, 0x7c, 0xb4, 0x34, 0xcb, 0x47,
+	0xda, 0x4c, 0xb9, 0xa4, 0xcd, 0x94, 0x7e, 0x9b, 0xcd, 0x31, 0x4f, 0xd6, 0xe6, 0x98, 0x27, 0x18,
+	0x36, 0x94, 0x61, 0x32, 0x88, 0x1e, 0x99, 0x4e, 0x39, 0x1c, 0xe8, 0x19, 0x6d, 0x16, 0x15, 0x4e,
+	0x9b, 0xd1, 0xd2, 0x5a, 0xf2, 0x53, 0xb8, 0x99, 0x49, 0x42, 0xc8, 0xf8, 0x1d, 0x80, 0xa1, 0xec,
+	0x97, 0x5b, 0x68, 0x17, 0xa7, 0x0e, 0x51, 0x10, 0x8d, 0xdf, 0x86, 0xfa, 0xc9, 0xc5, 0x64, 0x90,
+	0xc2, 0x54, 0x1d, 0xf2, 0x83, 0x99, 0xeb, 0x39, 0x32, 0xba, 0x22, 0x5a, 0x89, 0xe9, 0xe7, 0x16,
+	0x4c, 0xff, 0xbf, 0x35, 0xd8, 0x09, 0xfb, 0xe8, 0xc7, 0x44, 0x44, 0x76, 0xb9, 0xbd, 0xf9, 0x2e,
+	0x14, 0x9c, 0x99, 0x3f, 0x70, 0xc6, 0x32, 0xc4, 0x7c, 0x1d, 0xa7, 0x51, 0xc3, 0x8f, 0x39, 0x92,
+	0x29, 0xb1, 0xb3, 0x2e, 0xbb, 0xf8, 0x66, 0x5c, 0x9b, 0xbf, 0x19, 0xdf, 0x81, 0x82, 0xa0, 0x4c,
+	0xc3, 0xd1, 0xed, 0x4e, 0xa7, 0xfb, 0xe4, 0xb4, 0x1b, 0x04, 0xa7, 0xef, 0x77, 0x3b, 0xdc, 0xf2,
+	0xaa, 0x00, 0x74, 0x1e, 0x3f, 0xba, 0x77, 0xdc, 0x63, 0xed, 0x9c, 0xf1, 0x0f, 0x1a, 0x34, 0x12,
+	0x72, 0x15, 0x2b, 0xf5, 0x56, 0x18, 0xc6, 0x95, 0xcb, 0x94, 0x36, 0xa3, 0x20, 0x98, 0x8b, 0x5e,
+	0x83, 0x82, 0xd4, 0xf3, 0x29, 0xc2, 0x96, 0x7d, 0x74, 0x3b, 0x0f, 0xc9, 0x88, 0x50, 0x7b, 0x34,
+	0x2a, 0x57, 0xee, 0x30, 0x96, 0xcc, 0x1d, 0xd1, 0x7d, 0xa2, 0xc8, 0xd7, 0x53, 0xd6, 0x79, 0x4d,
+	0x5d, 0x67, 0xe3, 0x37, 0x1a, 0x14, 0x3a, 0xcf, 0xc9, 0xe0, 0x85, 0x9d, 0xbc, 0x48, 0xe7, 0x1c,
+	0xb5, 0x3d, 0x58, 0xb7, 0xce, 0xc9, 0xc4, 0x8f, 0x86, 0xce, 0x39, 0x2c, 0xe2, 0x08, 0xad, 0xc5,
+	0x1c, 0xa1, 0x3b, 0x50, 0xb0, 0x27, 0x7d, 0xdf, 0x1e, 0x93, 0x25, 0x6e, 0xce, 0xbc, 0x3d, 0xa1,
+	0x0d, 0xe3, 0x7d, 0x66, 0x77, 0xa8, 0xaa, 0xe7, 0x32, 0x5a, 0xb9, 0x0b, 0xbb, 0xb1, 0xd1, 0x57,
+	0x52, 0x58, 0x7f, 0xae, 0x41, 0x83, 0x47, 0xf2, 0x93, 0x8c, 0x5c, 0x8a, 0x12, 0xbd, 0x62, 0xad,
+	0xd1, 0xc8, 0x79, 0xd5, 0x0f, 0x4e, 0xa8, 0x08, 0x5d, 0x54, 0x18, 0x38, 0x38, 0xcd, 0x14, 0xd1,
+	0x1e, 0xd2, 0xc0, 0xb6, 0x4f, 0x26, 0x83, 0x8b, 0xfe, 0x0b, 0x72, 0x21, 0x76, 0x79, 0x45, 0x01,
+	0x3f, 0x20, 0x17, 0xc6, 0x47, 0xd0, 0x4c, 0xb2, 0x76, 0xa5, 0x59, 0x1e, 0x41, 0xe3, 0x6c, 0x3a,
+	0xfc, 0xf2, 0x93, 0xa4, 0x2c, 0x25, 0x09, 0x5d, 0x89, 0xa5, 0xcf, 0xa0, 0x72, 0x44, 0x55, 0xb3,
+	0x35, 0x56, 0x62, 0xe4, 0xcc, 0xf4, 0x08, 0x63, 0xe4, 0xb4, 0xd9, 0x1b, 0xd2, 0xca, 0x00, 0x19,
+	0xed, 0x89, 0x69, 0x2f, 0x2a, 0x5e, 0x24, 0xfa, 0x4e, 0x14, 0xbd, 0xf5, 0x2b, 0x0d, 0xb6, 0x02,
+	0xea, 0x61, 0x56, 0x23, 0x2b, 0xd2, 0xa8, 0x06, 0x79, 0x72, 0xd9, 0x41, 0x9e, 0xb8, 0xf6, 0x5c,
+	0x5d, 0xa0, 0x3d, 0x3f, 0x81, 0x6d, 0xbe, 0x7e, 0xea, 0x2c, 0xe7, 0xb0, 0x91, 0xb2, 0x31, 0x72,
+	0xa9, 0x1b, 0xe3, 0x2d, 0x40, 0x2a, 0xe1, 0x85, 0x13, 0x34, 0x3e, 0x60, 0x51, 0x3d, 0xa5, 0x1e,
+	0x46, 0xad, 0x3e, 0xf1, 0x88, 0xe5, 0x0e, 0x9e, 0xf7, 0x3d, 0xdf, 0xb5, 0x27, 0xe7, 0xc1, 0x59,
+	0x63, 0xc0, 0x13, 0x06, 0x33, 0x1e, 0x40, 0x23, 0x31, 0x5c, 0x7c, 0xf4, 0xbb, 0xb0, 0xa1, 0x54,
+	0xd6, 0x48, 0xad, 0x18, 0xad, 0xbd, 0x89, 0x60, 0x18, 0x18, 0xb6, 0xf9, 0x16, 0x5a, 0x4e, 0x2a,
+	0x74, 0xb2, 0x2a, 0xfe, 0xe2, 0xc9, 0xbe, 0x1f, 0xac, 0xbd, 0xa7, 0x64, 0xce, 0x82, 0x64, 0xb1,
+	0x2c, 0xe0, 0xe1, 0x81, 0xcf, 0x2d, 0x09, 0xe7, 0x75, 0x3c, 0x9e, 0x28, 0xfa, 0x10, 0xa3, 0xc3,
+	0xa2, 0x0f, 0x1e, 0xdd, 0xd3, 0x92, 0xd1, 0x3d, 0xe3, 0xc7, 0xb0, 0xcb, 0x17, 0x23, 0x1e, 0xea,
+	0x5c, 0x2e, 0x74, 0x68, 0xfc, 0x04, 0xea, 0xf1, 0xf1, 0x97, 0x8a, 0x3d, 0x1a, 0xcf, 0xe1, 0x66,
+	0x5c, 0x4d, 0x04, 0x21, 0x45, 0xc1, 0x4a, 0x17, 0x76, 0xd2, 0xac, 0x25, 0x41, 0x35, 0x35, 0x18,
+	0x89, 0x92, 0xf6, 0x93, 0x61, 0xc3, 0x7e, 0xf6, 0x97, 0x04, 0xd3, 0x5f, 0xd1, 0xa7, 0x7e, 0x0c,
+	0xbb, 0x7c, 0xd5, 0xaf, 0x2e, 0xd5, 0xf8, 0xf8, 0x4b, 0x4b, 0x35, 0xae, 0xe9, 0xbe, 0x3e, 0xa9,
+	0x66, 0x7f, 0xe9, 0xab, 0x95, 0xea, 0x2f, 0x35, 0xb8, 0xd9, 0xfd, 0x62, 0xea, 0xb8, 0x7e, 0xf6,
+	0xac, 0xe6, 0x58, 0xd6, 0xda, 0x1c, 0xcb, 0xfa, 0x9b, 0x90, 0x67, 0x55, 0x94, 0xbe, 0x30, 0xf5,
+	0xb6, 0xb0, 0xec, 0xbc, 0xc7, 0xc0, 0xa6, 0xe8, 0x36, 0x7e, 0x17, 0xf6, 0xb3, 0x59, 0x10, 0xd3,
+	0xa5, 0x05, 0x7a, 0xce, 0x60, 0x46, 0x8d, 0x0b, 0x99, 0x1f, 0x94, 0x6d, 0xea, 0xa1, 0x0e, 0x9c,
+	0x89, 0x4f, 0xb3, 0xe0, 0x41, 0xf1, 0x42, 0xc9, 0x2c, 0x0b, 0x18, 0x2b, 0x45, 0xd0, 0xa1, 0xf8,
+	0xcc, 0x1e, 0x11, 0xb5, 0x7e, 0x4d, 0xb6, 0x8d, 0xbf, 0xd3, 0xe0, 0x66, 0x6f, 0x3c, 0x5f, 0x04,
+	0xe1, 0x5c, 0xb4, 0xb9, 0x73, 0x89, 0xf0, 0x99, 0x8b, 0xf1, 0x79, 0x17, 0x6e, 0x78, 0xce, 0xcc,
+	0x1d, 0x90, 0x7e, 0x96, 0x38, 0x39, 0x6b, 0x3a, 0xc7, 0x3a, 0x49, 0x13, 0xaa, 0x0c, 0x31, 0xae,
+	0x29, 0x15, 0x9a, 0x36, 0xec, 0xf7, 0xc6, 0x0b, 0xe4, 0xf7, 0x15, 0x6d, 0x97, 0x3f, 0x5d, 0x85,
+	0x5a, 0x88, 0xfa, 0xd0, 0x3e, 0x77, 0x2d, 0x96, 0x3f, 0x58, 0xce, 0xfa, 0x57, 0xee, 0xf3, 0x5c,
+	0xe4, 0x3e, 0x4f, 0xf7, 0xe8, 0x68, 0xfd, 0xaf, 0xeb, 0x8c, 0x83, 0xf0, 0xef, 0x9a, 0xa8, 0xff,
+	0x75, 0x9d, 0xb1, 0x0c, 0xfd, 0x5e, 0x07, 0xf0, 0x9d, 0x00, 0x81, 0xa7, 0x26, 0x4a, 0xbe, 0x23,
+	0xbb, 0x69, 0xec, 0x82, 0x46, 0x93, 0xfb, 0x4f, 0xc9, 0x33, 0xc7, 0x25, 0x22, 0x11, 0x57, 0x66,
+	0xb0, 0xbb, 0x0c, 0x44, 0xbd, 0x35, 0x8e, 0x62, 0x3d, 0xf3, 0x89, 0x2b, 0x33, 0x71, 0x0c, 0xd4,
+	0xa6, 0x10, 0x7a, 0x53, 0x0c, 0x5d, 0x67, 0x3a, 0xa5, 0x86, 0xb8, 0x0c, 0xc5, 0x17, 0x99, 0x05,
+	0xbe, 0x25, 0xe0, 0xb2, 0xa8, 0x88, 0xa2, 0x0e, 0x46, 0xd6, 0x38, 0x82, 0x5a, 0xe2, 0xa8, 0x02,
+	0x7e, 0xa2, 0xd4, 0x5f, 0x59, 0xc3, 0xa1, 0x8a, 0x08, 0x0c, 0x71, 0x93, 0x41, 0x55, 0x34, 0xef,
+	0x85, 0xcd, 0x3e, 0x2e, 0xdc, 0x9f, 0x32, 0x2f, 0xd3, 0x12, 0x50, 0x11, 0xe2, 0xfc, 0x6b, 0x0d,
+	0x5a, 0x7c, 0x31, 0xd2, 0x1c, 0xd2, 0x2b, 0x9e, 0xdf, 0xa8, 0x6c, 0x73, 0x71, 0xd9, 0xbe, 0x0e,
+	0x5b, 0xe9, 0x8e, 0xc9, 0xa6, 0x17, 0xf1, 0x48, 0x9a, 0x50, 0x60, 0x81, 0x19, 0xf2, 0x4a, 0x56,
+	0x87, 0x8a, 0xa6, 0x31, 0x02, 0x3d, 0x8d, 0xe9, 0x20, 0x79, 0x0d, 0x63, 0xb9, 0xbf, 0xe4, 0x3d,
+	0xbb, 0x83, 0x53, 0x36, 0x9f, 0xa9, 0xe0, 0xd1, 0xaf, 0x59, 0x53, 0x9a, 0x96, 0x1a, 0x0a, 0x63,
+	0x50, 0x36, 0xa9, 0x95, 0x11, 0x16, 0xe8, 0x28, 0x56, 0x46, 0x56, 0x61, 0x4b, 0x60, 0x52, 0x45,
+	0x52, 0xea, 0x73, 0x06, 0x04, 0x66, 0xcc, 0xf2, 0x1f, 0x50, 0xf1, 0x17, 0x7f, 0x60, 0x87, 0xe5,
+	0x4f, 0x85, 0x17, 0x17, 0x94, 0x32, 0xbc, 0x0f, 0xb5, 0x08, 0x34, 0xb8, 0xd4, 0x4a, 0x03, 0x0a,
+	0xeb, 0xdb, 0x81, 0xf4, 0x8a, 0x58, 0x60, 0x99, 0x45, 0xd6, 0xd5, 0x9b, 0x78, 0xc6, 0x10, 0x76,
+	0xf8, 0x2c, 0x65, 0x57, 0x60, 0x05, 0x16, 0xe5, 0x70, 0xc1, 0x4a, 0x38, 0xba, 0x20, 0x46, 0x2f,
+	0x6f, 0x9e, 0xbe, 0x2f, 0x2d, 0xa2, 0xe0, 0x2b, 0x82, 0xcb, 0x65, 0x3e, 0x63, 0xbc, 0x17, 0x73,
+	0xec, 0x82, 0x8d, 0x4d, 0x15, 0xbf, 0x28, 0x7f, 0x0a, 0x64, 0x56, 0x34, 0xcb, 0x83, 0xb0, 0x14,
+	0xc2, 0xf8, 0x08, 0xea, 0xf1, 0xb1, 0xe2, 0xd3, 0x71, 0xdb, 0x5d, 0x5b, 0x60, 0xbb, 0xd7, 0xb9,
+	0x73, 0xfa, 0x9c, 0x04, 0xb6, 0x20, 0x97, 0xff, 0xf7, 0x60, 0x37, 0x06, 0x5f, 0xc6, 0x46, 0xfc,
+	0x13, 0x0d, 0xb6, 0xee, 0xcf, 0x86, 0xe7, 0xa4, 0xcd, 0x72, 0xce, 0xec, 0x7e, 0x48, 0xfa, 0xe5,
+	0xc5, 0xcf, 0x29, 0x4a, 0xa8, 0x2f, 0x0b, 0xac, 0x9d, 0x4c, 0xaa, 0xad, 0x26, 0x52, 0x06, 0xd7,
+	0x01, 0xac, 0xd1, 0x48, 0x7d, 0x62, 0x51, 0x34, 0x4b, 0xd6, 0x48, 0xbe, 0x9b, 0x08, 0x14, 0xee,
+	0xba, 0x9a, 0xde, 0xf8, 0x14, 0xf4, 0x23, 0xe2, 0xc7, 0xd8, 0xf2, 0x94, 0x1a, 0x81, 0x80, 0x1d,
+	0x6d, 0x2e, 0x3b, 0x89, 0x04, 0x94, 0xf1, 0x73, 0xd8, 0x4b, 0xa5, 0x2c, 0x44, 0xf5, 0x01, 0x6c,
+	0x73, 0xd2, 0x56, 0xd8, 0x29, 0xc4, 0x56, 0xc5, 0xb1, 0x51, 0x66, 0xf5, 0xf3, 0x18, 0x19, 0xe3,
+	0x33, 0xb8, 0xc6, 0xb7, 0x57, 0x1c, 0x55, 0x70, 0xfe, 0x23, 0xa8, 0xc6, 0xc9, 0x8b, 0xdd, 0x96,
+	0xa4, 0xbe, 0x15, 0xa3, 0x6e, 0xfc, 0x1c, 0xae, 0x67, 0x10, 0x17, 0xcc, 0x7f, 0x29, 0xea, 0x8f,
+	0xe0, 0xda, 0x21, 0x8b, 0xe3, 0x64, 0xb0, 0x8e, 0xa1, 0x16, 0x27, 0x1e, 0xca, 0x7f, 0x3b, 0x46,
+	0xad, 0x37, 0x34, 0x6e, 0xc2, 0xf5, 0x0c, 0x7a, 0xa2, 0x8c, 0xe8, 0x7f, 0x35, 0x80, 0xf6, 0x6c,
+	0x68, 0xfb, 0xbc, 0xda, 0x26, 0x65, 0xcf, 0x59, 0x03, 0xdf, 0x71, 0x95, 0x3d, 0xc7, 0xda, 0x3d,
+	0x96, 0x6f, 0x1a, 0x13, 0xff, 0xb9, 0x13, 0x14, 0xc8, 0xf1, 0x16, 0x5d, 0x7c, 0x5e, 0x78, 0xc5,
+	0xad, 0x2f, 0x6e, 0x99, 0x00, 0x07, 0x9d, 0x8a, 0x62, 0x51, 0x81, 0x10, 0x3e, 0xa0, 0xe0, 0x00,
+	0x4e, 0x55, 0xb9, 0x9c, 0x4b, 0xa6, 0x68, 0xd1, 0x1d, 0x1a, 0xde, 0xc8, 0x25, 0x93, 0x37, 0x62,
+	0xc5, 0x78, 0xc5, 0xcb, 0x14, 0xe3, 0xfd, 0x0f, 0x2f, 0x3f, 0x61, 0x73, 0x3f, 0x76, 0xce, 0x95,
+	0x68, 0xad, 0xca, 0xbd, 0x36, 0x9f, 0xfb, 0x5c, 0x8c, 0x7b, 0x55, 0x5c, 0xab, 0x51, 0x71, 0xfd,
+	0x10, 0xc0, 0xf3, 0x2d, 0xd7, 0xe7, 0x41, 0xb0, 0x25, 0xea, 0x06, 0x19, 0x36, 0x6d, 0xd3, 0xbc,
+	0x13, 0x99, 0x0c, 0xf9, 0xc0, 0x25, 0xf2, 0x4e, 0x64, 0x32, 0x64, 0xc3, 0x68, 0x01, 0xbc, 0x3d,
+	0xb6, 0x7d, 0x51, 0xc1, 0xcf, 0x1b, 0xe2, 0x7e, 0x08, 0xa7, 0x1d, 0xdc, 0x0f, 0x05, 0x32, 0xf1,
+	0xdd, 0xb0, 0x8c, 0xb0, 0x8c, 0xc3, 0x6d, 0x61, 0xca, 0x3e, 0xe3, 0xef, 0x35, 0x51, 0x9a, 0x4c,
+	0x53, 0x73, 0xce, 0x8c, 0x55, 0xde, 0x52, 0x3d, 0x2f, 0xca, 0x73, 0x5f, 0x10, 0x56, 0x6f, 0xf9,
+	0xcc, 0xb2, 0x47, 0x33, 0x97, 0x78, 0xc2, 0x4a, 0x08, 0xda, 0xe8, 0x2e, 0x6c, 0x8d, 0x2c, 0x5a,
+	0x27, 0xc5, 0x01, 0xcb, 0xbd, 0x57, 0xda, 0xa4, 0x43, 0xee, 0xf1, 0x11, 0x6d, 0x1f, 0x7d, 0x00,
+	0x1b, 0x22, 0x3f, 0x3c, 0x9b, 0xf8, 0xf6, 0x68, 0x09, 0x51, 0x96, 0x39, 0xfe, 0x19, 0x45, 0x17,
+	0x55, 0x80, 0xea, 0x1c, 0x02, 0xd5, 0xdd, 0x85, 0x66, 0xb2, 0x2b, 0x2c, 0x6c, 0x1c, 0x09, 0x58,
+	0x50, 0x04, 0xa8, 0x62, 0x9a, 0x41, 0xb7, 0xf1, 0x26, 0x34, 0x3b, 0x23, 0x62, 0xb9, 0x91, 0xee,
+	0xb0, 0x9a, 0x39, 0x2a, 0x2e, 0x63, 0x0f, 0x5a, 0x29, 0xd8, 0xe2, 0x74, 0xfe, 0x65, 0x0e, 0xf2,
+	0xed, 0xa9, 0xfd, 0x80, 0x5c, 0x2c, 0xf5, 0x8a, 0xe0, 0x35, 0xc8, 0x7b, 0x03, 0x67, 0x2a, 0x8a,
+	0x88, 0x2a, 0xb4, 0x4e, 0x91, 0x0d, 0xa6, 0x97, 0xd8, 0x94, 0x98, 0xa2, 0x93, 0x5e, 0x06, 0xf2,
+	0xd4, 0x88, 0x5c, 0x67, 0x29, 0x38, 0x19, 0x77, 0x2f, 0x62, 0x87, 0x6a, 0xfd, 0x12, 0x87, 0x8a,
+	0x0e, 0x75, 0xc9, 0x4b, 0x47, 0x24, 0xef, 0xf3, 0x8b, 0x87, 0x0a, 0xec, 0xb6, 0x6f, 0xfc, 0x08,
+	0xd6, 0x19, 0x97, 0xf4, 0xc9, 0xc0, 0x71, 0xfb, 0xf0, 0xb0, 0x6b, 0xf6, 0xcd, 0x6e, 0x9b, 0x06,
+	0xe3, 0x2b, 0x00, 0xa7, 0xdd, 0xf6, 0xc3, 0x13, 0xde, 0xd6, 0xd4, 0x67, 0x3a, 0x9f, 0x98, 0xbd,
+	0x53, 0xfa, 0x18, 0xec, 0x5d, 0xa8, 0x71, 0xa5, 0xcc, 0xe7, 0x2b, 0xa5, 0xbd, 0x4f, 0xad, 0x3f,
+	0xbb, 0x2f, 0x25, 0x4e, 0x5f, 0x63, 0x09, 0x84, 0xbc, 0xc5, 0xfe, 0x1a, 0xf7, 0xa5, 0xbd, 0x23,
+	0x07, 0x8a, 0xe5, 0x5e, 0x38, 0x52, 0xae, 0x64, 0x2e, 0x5c, 0xc9, 0x1a, 0x6c, 0xd3, 0x93, 0xc5,
+	0xba, 0x83, 0x3d, 0xf5, 0x03, 0x40, 0x2a, 0x50, 0x90, 0x37, 0xa0, 0x28, 0xc8, 0xcb, 0xdd, 0x14,
+	0xd0, 0x2f, 0x70, 0xfa, 0x9e, 0x71, 0x07, 0x6a, 0x26, 0x93, 0x4e, 0x74, 0x4e, 0xd7, 0x00, 0xc4,
+	0xd0, 0x50, 0xf1, 0x17, 0xf9, 0x98, 0xde, 0x90, 0x5a, 0x25, 0xd1, 0x41, 0x62, 0x23, 0xdd, 0x97,
+	0x91, 0x62, 0xe5, 0x71, 0x61, 0x78, 0xa7, 0x94, 0x95, 0x57, 0x1e, 0x62, 0xbe, 0x1b, 0x58, 0xc5,
+	0x54, 0x11, 0x8c, 0x07, 0xd0, 0x4a, 0xa1, 0x15, 0x98, 0x51, 0x97, 0x23, 0xd6, 0xe4, 0xd5, 0xaa,
+	0x21, 0x24, 0x90, 0xdc, 0xef, 0x41, 0x23, 0xd1, 0x13, 0xc6, 0x14, 0x15, 0x1a, 0x61, 0x4c, 0x51,
+	0xfd, 0x4a, 0x04, 0x83, 0x3e, 0x0c, 0xb5, 0x06, 0xbe, 0xfd, 0x92, 0xf4, 0x63, 0xcf, 0x5c, 0xf8,
+	0xfa, 0xd5, 0x78, 0x67, 0x27, 0xf2, 0x72, 0xb3, 0x0d, 0xcd, 0x13, 0x32, 0x22, 0x03, 0x3f, 0x45,
+	0x66, 0xc9, 0xf7, 0x32, 0x5a, 0xda, 0xe3, 0xcf, 0x07, 0xd0, 0x4a, 0x21, 0x71, 0x45, 0x51, 0xfd,
+	0x46, 0x83, 0x6b, 0x9d, 0x91, 0x33, 0x51, 0xd9, 0x3c, 0x21, 0xfe, 0x6c, 0x2a, 0x99, 0xba, 0x0d,
+	0xbb, 0x22, 0xa0, 0x90, 0xca, 0x5b, 0x8d, 0x77, 0x46, 0x26, 0x99, 0xaa, 0x46, 0xde, 0x83, 0x96,
+	0x0c, 0xa7, 0x27, 0xcd, 0x30, 0x5e, 0x44, 0xd9, 0x10, 0x08, 0x71, 0x13, 0xce, 0xf8, 0x47, 0x0d,
+	0xae, 0x67, 0x30, 0x79, 0xb5, 0x69, 0x47, 0x5f, 0x30, 0xe6, 0xb2, 0x5f, 0x30, 0x66, 0x3f, 0xbf,
+	0x59, 0xbd, 0xe4, 0xf3, 0x9b, 0x7b, 0xb0, 0xcd, 0x8d, 0xa6, 0xa5, 0x92, 0x0f, 0xf4, 0x49, 0x87,
+	0xe5, 0x0d, 0xac, 0xa1, 0x4c, 0xe7, 0xc8, 0x26, 0x75, 0xd0, 0x54, 0x3a, 0xe2, 0x28, 0x1e, 0x01,
+	0x12, 0x49, 0xf5, 0x2f, 0x49, 0xfe, 0xbb, 0x50, 0x8b, 0x10, 0x5a, 0x1c, 0xf8, 0x36, 0x61, 0x97,
+	0x33, 0x74, 0xe9, 0xa2, 0xdb, 0x6c, 0x2e, 0x9a, 0x50, 0x8f, 0xd3, 0x14, 0x13, 0x3d, 0x81, 0xba,
+	0xe0, 0xef, 0x2b, 0xfc, 0xdc, 0x87, 0xd0, 0x48, 0x10, 0xbd, 0x5c, 0xdc, 0xf6, 0x33, 0x68, 0x72,
+	0x86, 0xd5, 0x0c, 0x44, 0x78, 0xac, 0x95, 0x54, 0x84, 0x72, 0xac, 0x15, 0xe8, 0x5c, 0xf6, 0xf6,
+	0xa0, 0x95, 0x42, 0x5c, 0x08, 0xe4, 0xe7, 0xd0, 0x12, 0xbc, 0x7f, 0x1d, 0x9f, 0x3e, 0x06, 0x3d,
+	0x8d, 0x7a, 0x78, 0xea, 0x14, 0x42, 0xc1, 0xa9, 0xcb, 0x7a, 0x01, 0x1d, 0x9e, 0x01, 0x35, 0x7a,
+	0x91, 0xf5, 0x48, 0x65, 0x99, 0x33, 0xa0, 0x46, 0x35, 0x94, 0x33, 0xf0, 0x25, 0xc9, 0x87, 0x67,
+	0x60, 0xd9, 0xa8, 0xc9, 0x4f, 0xa0, 0xc1, 0x19, 0xba, 0x6a, 0x5e, 0x59, 0x87, 0x66, 0x92, 0x80,
+	0x98, 0xd7, 0x87, 0xd0, 0x14, 0xec, 0x5c, 0x95, 0x7a, 0x0f, 0x5a, 0x29, 0x14, 0xae, 0x94, 0x40,
+	0x75, 0xe1, 0x66, 0x9c, 0xd1, 0xaf, 0x28, 0x94, 0x9f, 0xbd, 0x1e, 0x06, 0xec, 0x67, 0x7f, 0x53,
+	0x08, 0xc9, 0x4b, 0x29, 0x6d, 0xfa, 0xda, 0x19, 0xfb, 0x3c, 0xa5, 0x94, 0xe9, 0xeb, 0x8a, 0x8a,
+	0xbf, 0x03, 0x3b, 0x5c, 0x08, 0xb1, 0x20, 0x1a, 0xb5, 0xbb, 0x39, 0x24, 0x9c, 0x47, 0x49, 0x40,
+	0x7a, 0x43, 0xfa, 0xa2, 0x27, 0x36, 0x4c, 0x08, 0xec, 0xfb, 0xb0, 0x2b, 0x78, 0xbf, 0x1c, 0xc1,
+	0x0f, 0xa0, 0x1e, 0x1f, 0x77, 0x99, 0x38, 0xdb, 0x2e, 0xd4, 0x68, 0xc1, 0x4a, 0x3c, 0xc0, 0x58,
+	0x87, 0x9d, 0x28, 0x58, 0x70, 0xc9, 0x2d, 0x39, 0x26, 0x09, 0xfa, 0x3a, 0xea, 0xcc, 0x1d, 0xc9,
+	0x11, 0x6f, 0x40, 0x23, 0xd1, 0x23, 0x18, 0xa9, 0xc2, 0x2a, 0x7d, 0x32, 0x2a, 0xfc, 0xa1, 0x99,
+	0x3b, 0x12, 0xef, 0x9a, 0x18, 0x72, 0xc7, 0x99, 0x3c, 0xb3, 0xa5, 0x67, 0x6e, 0xfc, 0xbe, 0x06,
+	0xf5, 0x78, 0x8f, 0xa0, 0xf2, 0x03, 0x68, 0xda, 0x93, 0x73, 0xe2, 0x31, 0xcd, 0xe9, 0x4d, 0x5d,
+	0x62, 0x0d, 0x63, 0x87, 0xac, 0x1e, 0xf4, 0x9f, 0x84, 0xdd, 0xac, 0xdc, 0xab, 0x36, 0x9d, 0x79,
+	0xcf, 0xe3, 0x83, 0xb8, 0x35, 0xb4, 0x4d, 0xbb, 0x22, 0xf8, 0xf4, 0x75, 0x61, 0xf3, 0x64, 0xf6,
+	0x74, 0x6c, 0xa7, 0x70, 0x48, 0x6d, 0xa9, 0x81, 0x33, 0x0c, 0xea, 0xbd, 0xe9, 0xff, 0x73, 0x59,
+	0xcb, 0x5d, 0x85, 0xb5, 0xd5, 0x2c, 0xd6, 0xf6, 0xa0, 0x95, 0xc2, 0x19, 0x97, 0xd0, 0xb7, 0xbf,
+	0x01, 0x95, 0x68, 0x86, 0x8a, 0xfe, 0xaa, 0xc6, 0xfd, 0x93, 0xc7, 0x8f, 0xf8, 0xef, 0x6b, 0xfc,
+	0xac, 0xfd, 0xf0, 0xb8, 0xaa, 0xdd, 0xfe, 0xf7, 0x6f, 0x41, 0xc1, 0xe4, 0x3f, 0x0f, 0x83, 0x6e,
+	0xc1, 0x3a, 0x73, 0x49, 0x91, 0xf0, 0x73, 0xc5, 0x24, 0xf5, 0x0a, 0x8e, 0x3c, 0x70, 0x33, 0x56,
+	0xd0, 0x1b, 0x90, 0xe7, 0x6f, 0xd3, 0x10, 0xeb, 0x0b, 0xbd, 0x5d, 0x7d, 0x0b, 0xc7, 0x1e, 0xad,
+	0xad, 0xa0, 0x1e, 0xcb, 0x9e, 0x47, 0x5e, 0xda, 0xa1, 0x26, 0xce, 0x78, 0x97, 0xa7, 0xb7, 0x70,
+	0xd6, 0xb3, 0x3c, 0x63, 0x05, 0x75, 0xa0, 0x12, 0x7d, 0xe8, 0x86, 0xea, 0x38, 0xf5, 0x49, 0x9c,
+	0xde, 0xc0, 0xe9, 0x2f, 0xe2, 0x02, 0x22, 0xca, 0x73, 0x26, 0x4e, 0x24, 0xf9, 0x26, 0x4a, 0x6f,
+	0x24, 0xe0, 0x01, 0x91, 0xf7, 0xa0, 0xac, 0x3c, 0x0d, 0x42, 0x35, 0x9c, 0x7c, 0xd7, 0xa4, 0xef,
+	0xe0, 0x94, 0xd7, 0x43, 0xc6, 0x0a, 0xfa, 0x10, 0x36, 0x23, 0x11, 0x69, 0xb4, 0x8b, 0xd3, 0x8a,
+	0x9e, 0xf4, 0x3a, 0x4e, 0xad, 0x66, 0xe2, 0x22, 0x8d, 0x27, 0xdd, 0x51, 0x13, 0x67, 0xd4, 0x2c,
+	0xe9, 0x2d, 0x9c, 0x55, 0x32, 0xc4, 0x49, 0xc5, 0x33, 0xcd, 0xa8, 0x89, 0x33, 0x2a, 0x83, 0xf4,
+	0x16, 0xce, 0x2a, 0xf5, 0x31, 0x56, 0x68, 0x98, 0x46, 0x99, 0xb0, 0x87, 0x22, 0xf3, 0x0f, 0x16,
+	0x78, 0x17, 0xa7, 0xfd, 0x6a, 0x89, 0xb1, 0x82, 0xde, 0x86, 0xa2, 0xfc, 0x69, 0x0d, 0x54, 0xc5,
+	0xb1, 0x1f, 0xde, 0xd0, 0xb7, 0x71, 0xfc, 0x77, 0x37, 0x8c, 0x15, 0xf4, 0x59, 0x2c, 0xb6, 0x1f,
+	0x3e, 0xf0, 0xba, 0x31, 0xff, 0x27, 0x04, 0xf4, 0x9b, 0x78, 0xfe, 0xcb, 0x7e, 0x63, 0x05, 0x61,
+	0x28, 0x88, 0xaa, 0x0f, 0xb4, 0x85, 0xa3, 0x75, 0x49, 0x7a, 0x15, 0xc7, 0x4a, 0x89, 0x8c, 0x15,
+	0xf4, 0x2e, 0x40, 0x58, 0x81, 0x83, 0x10, 0x4e, 0xd4, 0xf9, 0xe8, 0x35, 0x9c, 0x2c, 0xd1, 0x31,
+	0x56, 0xd0, 0x3d, 0x56, 0x9c, 0xa2, 0x96, 0xd2, 0xa0, 0x06, 0x8e, 0x41, 0x24, 0x89, 0x26, 0xce,
+	0xa8, 0xba, 0xe1, 0x0c, 0x84, 0x55, 0x31, 0x08, 0xe1, 0x44, 0x49, 0x8d, 0x5e, 0xc3, 0xc9, 0xb2,
+	0x99, 0x40, 0xf2, 0xa7, 0xec, 0x61, 0x5a, 0x30, 0xb3, 0xa8, 0xe4, 0x23, 0x89, 0x0d, 0x7e, 0x88,
+	0xa2, 0x15, 0x2a, 0xa8, 0x8e, 0x53, 0x4b, 0x5e, 0xf4, 0x06, 0x4e, 0x2f, 0x65, 0x31, 0x56, 0x90,
+	0x95, 0x2c, 0x66, 0x93, 0x0b, 0x81, 0xf6, 0xf1, 0x82, 0x02, 0x16, 0xfd, 0x00, 0x2f, 0x2a, 0x3c,
+	0xe1, 0x7c, 0x46, 0x6b, 0x3e, 0x50, 0x1d, 0xa7, 0x16, 0x91, 0xe8, 0x0d, 0x9c, 0x5e, 0x1c, 0xc2,
+	0xf9, 0xcc, 0xaa, 0xc6, 0x40, 0xfb, 0x78, 0x41, 0x49, 0x88, 0x7e, 0x80, 0x17, 0x95, 0x72, 0x18,
+	0x2b, 0xe8, 0x31, 0xa0, 0x64, 0x26, 0x14, 0xe9, 0x38, 0x33, 0xa7, 0xab, 0xef, 0xe1, 0xec, 0xd4,
+	0xa9, 0xb1, 0x82, 0xbe, 0x07, 0xa5, 0xe0, 0xa1, 0x0a, 0xda, 0xc6, 0xf1, 0xf7, 0x2f, 0x3a, 0xc2,
+	0x89, 0x77, 0x2c, 0x5c, 0xad, 0x29, 0x2f, 0x47, 0x50, 0x0d, 0x27, 0x1f, 0xab, 0xe8, 0x3b, 0x38,
+	0xe5, 0x71, 0x49, 0xa0, 0xd6, 0xc2, 0xa7, 0x1f, 0x5c, 0xad, 0x25, 0xde, 0x90, 0xe8, 0xf5, 0x38,
+	0x38, 0xa0, 0x70, 0x06, 0x3b, 0x69, 0x55, 0xf5, 0xe8, 0x1a, 0x9e, 0x53, 0xb1, 0xaf, 0x5f, 0xc7,
+	0xf3, 0x4a, 0xf1, 0x8d, 0x15, 0x34, 0x4c, 0x35, 0xb0, 0xc5, 0x76, 0x38, 0xc0, 0x8b, 0x8a, 0xee,
+	0x75, 0x03, 0x2f, 0x2c, 0x89, 0x37, 0x56, 0xd0, 0x2f, 0x98, 0xc9, 0x93, 0x5a, 0xe8, 0x7e, 0x13,
+	0x67, 0xf4, 0xc8, 0x2f, 0xec, 0xe3, 0x05, 0xe5, 0xe0, 0x5c, 0x4b, 0xc4, 0x2a, 0x90, 0x51, 0x03,
+	0xa7, 0xd7, 0x7a, 0xeb, 0x4d, 0x9c, 0x51, 0xac, 0xcc, 0x37, 0x73, 0x56, 0xad, 0x0d, 0xda, 0xc7,
+	0x0b, 0x2a, 0x81, 0xf4, 0x03, 0xbc, 0xa8, 0x50, 0x87, 0x7f, 0x22, 0xab, 0x1c, 0x05, 0xed, 0xe3,
+	0x05, 0x95, 0x36, 0xfa, 0x01, 0x5e, 0x54, 0xcb, 0xa2, 0x2a, 0x5b, 0x66, 0x05, 0x20, 0x1c, 0x36,
+	0xe2, 0xca, 0x36, 0x76, 0xfb, 0x07, 0x4a, 0x52, 0x0c, 0x4c, 0x24, 0xec, 0xf5, 0x5a, 0x04, 0xa6,
+	0xca, 0x3f, 0xf6, 0x13, 0x1f, 0xa8, 0x81, 0xd3, 0x7f, 0xc1, 0x44, 0x6f, 0xe2, 0x8c, 0x5f, 0x03,
+	0x11, 0x9a, 0x33, 0xf2, 0x1b, 0x1b, 0x54, 0x73, 0xa6, 0xfd, 0xb6, 0x87, 0xde, 0x48, 0xc0, 0x03,
+	0x22, 0xc7, 0xb0, 0x9d, 0xf8, 0xf9, 0x0c, 0xd4, 0xc2, 0x59, 0xbf, 0xc3, 0xa1, 0xeb, 0x38, 0xf3,
+	0xd7, 0x36, 0x38, 0xb5, 0xc4, 0x0f, 0x4e, 0xa0, 0x16, 0xce, 0xfa, 0xe5, 0x0a, 0x5d, 0xc7, 0x99,
+	0xbf, 0x4f, 0xc1, 0x2d, 0x8a, 0xf8, 0x8f, 0x45, 0xa0, 0x26, 0xce, 0xf8, 0x15, 0x0a, 0xbd, 0x85,
+	0xb3, 0x7e, 0x59, 0x82, 0x6b, 0xc5, 0xe4, 0xaf, 0x3b, 0x20, 0x1d, 0x67, 0xfe, 0x8a, 0x84, 0xbe,
+	0x87, 0xe7, 0xfc, 0x1c, 0x84, 0x34, 0xdb, 0xa4, 0x23, 0xc3, 0xcd, 0xb6, 0x98, 0xb7, 0xa3, 0xef,
+	0x44, 0x81, 0xaa, 0x7e, 0x8b, 0x94, 0x30, 0xa0, 0x5d, 0x9c, 0x56, 0x38, 0xa1, 0xd7, 0x71, 0x6a,
+	0xa5, 0x43, 0x60, 0x79, 0xaa, 0x27, 0x38, 0x66, 0xe2, 0x79, 0x11, 0xcb, 0x33, 0xfd, 0xfc, 0x0a,
+	0xeb, 0x31, 0xa8, 0x36, 0x10, 0xd6, 0x63, 0xbc, 0x2a, 0x41, 0xaf, 0xc7, 0xc1, 0xaa, 0x9d, 0xa6,
+	0xba, 0x73, 0x68, 0x07, 0xa7, 0x38, 0x7d, 0xfa, 0x2e, 0x4e, 0xf5, 0xf9, 0xa4, 0xb9, 0xa2, 0xfa,
+	0x76, 0xdc, 0x5c, 0x49, 0xf1, 0x03, 0xf5, 0x66, 0xb2, 0x23, 0x2e, 0x8d, 0xd0, 0x75, 0x41, 0x75,
+	0x1c, 0x05, 0x44, 0xa5, 0x91, 0xf4, 0x71, 0xf8, 0xd6, 0x4d, 0xb8, 0x40, 0xa8, 0x85, 0xb3, 0x1c,
+	0x36, 0x5d, 0xc7, 0x99, 0x1e, 0x93, 0xb1, 0x82, 0x4c, 0x96, 0x29, 0x8d, 0x47, 0xb8, 0xd1, 0x1e,
+	0xce, 0x2e, 0x8a, 0xd0, 0xaf, 0xe1, 0x39, 0x75, 0x0d, 0xc6, 0x0a, 0xfa, 0x54, 0x56, 0xbe, 0xc4,
+	0x70, 0xd0, 0x75, 0x3c, 0xaf, 0x64, 0x41, 0xbf, 0x81, 0xe7, 0x16, 0x1d, 0x70, 0xca, 0xa9, 0x99,
+	0x7e, 0x74, 0x1d, 0xcf, 0xab, 0x28, 0xd0, 0x6f, 0xe0, 0xf9, 0x05, 0x02, 0xf2, 0x98, 0xc8, 0x8c,
+	0x31, 0x3f, 0x26, 0xb1, 0xb4, 0xb9, 0xbe, 0x13, 0x05, 0xc6, 0xdc, 0xbd, 0x48, 0x4a, 0x95, 0xbb,
+	0x7b, 0x69, 0x09, 0x58, 0xbd, 0x95, 0xd2, 0xa3, 0x2e, 0x6e, 0x22, 0x51, 0x8a, 0x5a, 0x38, 0x2b,
+	0xd5, 0xaa, 0xeb, 0x38, 0x3b, 0xaf, 0xca, 0xb6, 0xbd, 0x9a, 0xf8, 0x43, 0x3b, 0x38, 0x25, 0x81,
+	0xa8, 0xef, 0xe2, 0xb4, 0xec, 0x20, 0xbf, 0x38, 0xc2, 0xb4, 0x1e, 0x42, 0x38, 0x91, 0xf8, 0xd3,
+	0x6b, 0x38, 0x99, 0xf7, 0xe3, 0xdf, 0x55, 0x13, 0x74, 0x68, 0x07, 0xa7, 0x24, 0xf9, 0xf4, 0x5d,
+	0x9c, 0x9a, 0xc5, 0xe3, 0x42, 0x88, 0xe7, 0xde, 0x50, 0x0b, 0x27, 0x60, 0x8a, 0x10, 0xb2, 0x52,
+	0x75, 0xc1, 0xe1, 0x55, 0xfa, 0x84, 0xaf, 0x91, 0x92, 0x8e, 0xd3, 0x9b, 0xc9, 0x8e, 0xc8, 0xb9,
+	0x8b, 0xa7, 0xb9, 0xe8, 0xb9, 0xcb, 0xc8, 0x9e, 0xe9, 0x7a, 0x5a, 0x57, 0xe4, 0x8c, 0xa4, 0x65,
+	0x90, 0xe8, 0x19, 0x99, 0x93, 0xfe, 0xd2, 0x6f, 0x64, 0x75, 0xab, 0xab, 0x16, 0x26, 0x64, 0x10,
+	0xc2, 0x89, 0x2c, 0x8f, 0x5e, 0xc3, 0x29, 0x19, 0x1b, 0x76, 0x04, 0x94, 0x54, 0x0b, 0xaa, 0xe1,
+	0x64, 0x06, 0x47, 0xdf, 0xc1, 0x29, 0xd9, 0x18, 0xae, 0xd9, 0xa2, 0x09, 0x12, 0x54, 0xc7, 0xa9,
+	0x59, 0x18, 0xbd, 0x81, 0x33, 0x32, 0x29, 0x6c, 0xa5, 0x62, 0x69, 0x0f, 0xd4, 0xc0, 0xe9, 0xd9,
+	0x15, 0xbd, 0x89, 0x33, 0x32, 0x24, 0x7c, 0xa5, 0x12, 0xf9, 0x09, 0xd4, 0xc2, 0x59, 0x09, 0x11,
+	0x5d, 0xc7, 0xd9, 0xe9, 0x0c, 0x76, 0x23, 0x27, 0x53, 0x0e, 0x48, 0xc7, 0x99, 0x59, 0x0e, 0x7d,
+	0x0f, 0x67, 0xe7, 0x28, 0xd4, 0x05, 0x12, 0xf6, 0x58, 0x22, 0x05, 0xa1, 0xd7, 0x22, 0xb0, 0x94,
+	0x05, 0x62, 0x23, 0x6b, 0x58, 0x69, 0x25, 0x16, 0x28, 0x36, 0xb6, 0x07, 0xd5, 0x78, 0xcc, 0x1a,
+	0x35, 0x71, 0x46, 0x92, 0x40, 0x6f, 0xe1, 0xcc, 0xe8, 0xbf, 0xb4, 0xc4, 0xa2, 0xde, 0x01, 0xb7,
+	0xc4, 0x52, 0x73, 0x02, 0xba, 0x8e, 0x33, 0x83, 0xfd, 0xdc, 0x72, 0xce, 0x0a, 0xa6, 0xa3, 0x7d,
+	0xbc, 0x20, 0xb6, 0xaf, 0x1f, 0xe0, 0x85, 0x91, 0xf8, 0x74, 0x6f, 0x28, 0xf8, 0xc6, 0x01, 0xce,
+	0xec, 0x9b, 0xe3, 0x0d, 0xa5, 0x7c, 0xe5, 0x43, 0xd8, 0x8c, 0x44, 0xb6, 0xd1, 0x2e, 0x4e, 0x0b,
+	0x90, 0xeb, 0x75, 0x9c, 0x1e, 0x00, 0x67, 0x87, 0x28, 0x1a, 0xca, 0x46, 0x75, 0x9c, 0x1a, 0x13,
+	0xd7, 0x1b, 0x38, 0x3d, 0xe6, 0x6d, 0xac, 0x3c, 0xcd, 0xb3, 0x0a, 0x94, 0x3b, 0xff, 0x37, 0x00,
+	0x52, 0xff, 0xde, 0x53, 0x03, 0x5b, 0x00, 0x00,
 }
@@ -0,0 +1,62 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+)
+
+func (s *robocupGrpcServer) GetScoreSheetHistory(ctx context.Context, req *serv.GetScoreSheetHistoryRequest) (*serv.GetScoreSheetHistoryResponse, error) {
+	revisions, err := s.Store.FetchScoreSheetRevisions(ctx, req.GetScoreSheetId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching score sheet history")
+	}
+	return &serv.GetScoreSheetHistoryResponse{
+		Revisions: revisions,
+	}, nil
+}
+
+// RestoreScoreSheetRevision saves the values, timings, comments and team of an earlier revision
+// as a new revision of the sheet. The sheet's status is left as it is.
+func (s *robocupGrpcServer) RestoreScoreSheetRevision(ctx context.Context, req *serv.RestoreScoreSheetRevisionRequest) (*serv.RestoreScoreSheetRevisionResponse, error) {
+	if req.GetVersion() == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "The version the score sheet was read at is required")
+	}
+	revisions, err := s.Store.FetchScoreSheetRevisions(ctx, req.GetScoreSheetId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching score sheet history")
+	}
+	var saved *serv.ScoreSheet
+	for _, revision := range revisions {
+		if revision.GetId() == req.GetRevisionId() {
+			saved = revision.GetScoreSheet()
+		}
+	}
+	if saved == nil {
+		return nil, grpc.Errorf(codes.NotFound, "Revision %s not found", req.GetRevisionId())
+	}
+	scoreSheet, err := s.Store.UpdateScoreSheet(ctx, req.GetScoreSheetId(), func(scoreSheet *serv.ScoreSheet) error {
+		if scoreSheet.GetTemplateVersion() != saved.GetTemplateVersion() {
+			return grpc.Errorf(codes.FailedPrecondition, "The revision was scored against version %d of the template but the sheet has since moved to version %d", saved.GetTemplateVersion(), scoreSheet.GetTemplateVersion())
+		}
+		values := map[string]float64{}
+		for _, section := range saved.GetSections() {
+			values[section.GetId()] = section.GetValue()
+		}
+		for _, section := range scoreSheet.GetSections() {
+			section.Value = values[section.GetId()]
+		}
+		scoreSheet.Team.Id = saved.GetTeam().GetId()
+		scoreSheet.Timings = saved.GetTimings()
+		scoreSheet.Comments = saved.GetComments()
+		scoreSheet.Version = req.GetVersion()
+		return nil
+	})
+	if err != nil {
+		return nil, statusError(err, "Internal error encountered while restoring score sheet revision")
+	}
+	return &serv.RestoreScoreSheetRevisionResponse{
+		ScoreSheet: scoreSheet,
+	}, nil
+}
@@ -0,0 +1,51 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"strings"
+)
+
+func (s *robocupGrpcServer) GetRoundLocks(ctx context.Context, req *serv.GetRoundLocksRequest) (*serv.GetRoundLocksResponse, error) {
+	if err := s.checkDivisionCompetition(ctx, req.GetDivisionId()); err != nil {
+		return nil, err
+	}
+	locks, err := s.Store.FetchRoundLocks(ctx, req.GetDivisionId(), nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching round locks")
+	}
+	return &serv.GetRoundLocksResponse{
+		RoundLocks: locks,
+	}, nil
+}
+
+func (s *robocupGrpcServer) LockRound(ctx context.Context, req *serv.LockRoundRequest) (*serv.LockRoundResponse, error) {
+	if err := s.checkDivisionCompetition(ctx, req.GetDivisionId()); err != nil {
+		return nil, err
+	}
+	lock, err := s.Store.LockRound(ctx, req.GetDivisionId(), req.GetRound(), currentUser(ctx).GetId())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while locking round")
+	}
+	return &serv.LockRoundResponse{
+		RoundLock: lock,
+	}, nil
+}
+
+func (s *robocupGrpcServer) UnlockRound(ctx context.Context, req *serv.UnlockRoundRequest) (*serv.UnlockRoundResponse, error) {
+	if strings.TrimSpace(req.GetReason()) == "" {
+		return nil, grpc.Errorf(codes.InvalidArgument, "A reason is required to unlock a round")
+	}
+	if err := s.checkDivisionCompetition(ctx, req.GetDivisionId()); err != nil {
+		return nil, err
+	}
+	lock, err := s.Store.UnlockRound(ctx, req.GetDivisionId(), req.GetRound(), currentUser(ctx).GetId(), req.GetReason())
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while unlocking round")
+	}
+	return &serv.UnlockRoundResponse{
+		RoundLock: lock,
+	}, nil
+}
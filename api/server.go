@@ -2,6 +2,7 @@ package api
 
 import (
 	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/rcj-go/api/auth"
 	sheetStore "github.com/davefinster/rcj-go/api/sheets"
 	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
 	"github.com/gin-gonic/gin"
@@ -10,6 +11,7 @@ import (
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
 	// For postgres support
 	"bytes"
 	"context"
@@ -23,6 +25,7 @@ import (
 	"net/http"
 	"os"
 	"sort"
+	"time"
 
 	"archive/zip"
 	serv "github.com/davefinster/rcj-go/api/proto"
@@ -31,12 +34,16 @@ import (
 )
 
 type robocupGrpcServer struct {
-	Store  *crdbStore.CockroachStore
-	Sheets *sheetStore.SheetStore
+	Store           *crdbStore.CockroachStore
+	Sheets          *sheetStore.SheetStore
+	SessionDuration time.Duration
+	// TrustForwardedFor takes client addresses from X-Forwarded-For, for use behind a reverse proxy
+	TrustForwardedFor bool
+	Authenticators    *Authenticators
 }
 
 func (s *robocupGrpcServer) GetScoreSheetTemplates(ctx context.Context, req *serv.GetScoreSheetTemplatesRequest) (*serv.GetScoreSheetTemplatesResponse, error) {
-	templates, err := s.Store.FetchScoreSheetTemplates(ctx, nil)
+	templates, err := s.Store.FetchScoreSheetTemplates(ctx, nil, nil)
 	if err != nil {
 		return nil, grpc.Errorf(codes.Internal, "Internal error encountered whilefetching templates")
 	}
@@ -111,11 +118,12 @@ func (s *Server) getScoreSheetExcel(c *gin.Context) {
 	innerSql, innerArgs, _ := psql.Select(
 		"score_sheets.round as round",
 		"score_sheet_sections.section as section",
-		"AVG(score_sheet_sections.value * score_sheet_template_sections.multiplier) as avg",
+		fmt.Sprintf("AVG(%s) as avg", crdbStore.WeightedScore),
 	).From("score_sheet_sections").
 		Join("score_sheets ON score_sheet_sections.score_sheet = score_sheets.id").
 		Join("score_sheet_template_sections ON score_sheet_sections.section = score_sheet_template_sections.id").
-		Where(sq.Eq{"score_sheets.team": teamId}).
+		Where(sq.Eq{"score_sheets.team": teamId, "score_sheets.deleted_at": nil}).
+		Where(crdbStore.CountedScoreSheets).
 		GroupBy("score_sheets.team, score_sheets.round, section").
 		OrderBy("round, section").ToSql()
 	outerSql, _, _ := psql.Select(
@@ -144,7 +152,8 @@ func (s *Server) getScoreSheetExcel(c *gin.Context) {
 		"round",
 		"concat_agg(comments) as comments",
 	).From("score_sheets").
-		Where(sq.Eq{"score_sheets.team": teamId}).
+		Where(sq.Eq{"score_sheets.team": teamId, "score_sheets.deleted_at": nil}).
+		Where(crdbStore.CountedScoreSheets).
 		GroupBy("team, round").
 		OrderBy("round").ToSql()
 	var commentList []struct {
@@ -193,7 +202,7 @@ func (s *Server) getScoreSheetExcel(c *gin.Context) {
 		"divisions.final_rounds as final_rounds").From("teams").
 		Join("institutions ON teams.institution = institutions.id").
 		Join("divisions ON teams.division = divisions.id").
-		Where(sq.Eq{"divisions.id": teamData.DivisionID})
+		Where(sq.Eq{"divisions.id": teamData.DivisionID, "teams.deleted_at": nil})
 	ladderSql, ladderArgs, _ := ladderQuery.ToSql()
 	ladderList := []*danceLadderEntry{}
 	err = s.DB.Select(&ladderList, ladderSql, ladderArgs...)
@@ -203,10 +212,12 @@ func (s *Server) getScoreSheetExcel(c *gin.Context) {
 	}
 	innerScoreSql, _, _ := psql.Select(
 		"score_sheets.id as id",
-		"SUM(score_sheet_sections.value * score_sheet_template_sections.multiplier) as total",
+		fmt.Sprintf("SUM(%s) as total", crdbStore.WeightedScore),
 	).From("score_sheets").
 		Join("score_sheet_sections ON score_sheet_sections.score_sheet = score_sheets.id").
 		Join("score_sheet_template_sections ON score_sheet_sections.section = score_sheet_template_sections.id").
+		Where(sq.Eq{"score_sheets.deleted_at": nil}).
+		Where(crdbStore.CountedScoreSheets).
 		GroupBy("score_sheets.id").ToSql()
 	scoreQuery := psql.Select(
 		"score_sheets.team as team",
@@ -355,11 +366,12 @@ func (s *Server) getScoreSheetExcelForDivision(c *gin.Context) {
 		"score_sheets.team as team",
 		"score_sheets.round as round",
 		"score_sheet_sections.section as section",
-		"AVG(score_sheet_sections.value * score_sheet_template_sections.multiplier) as avg",
+		fmt.Sprintf("AVG(%s) as avg", crdbStore.WeightedScore),
 	).From("score_sheet_sections").
 		Join("score_sheets ON score_sheet_sections.score_sheet = score_sheets.id").
 		Join("score_sheet_template_sections ON score_sheet_sections.section = score_sheet_template_sections.id").
-		Where(sq.Eq{"score_sheets.division": divisionId}).
+		Where(sq.Eq{"score_sheets.division": divisionId, "score_sheets.deleted_at": nil}).
+		Where(crdbStore.CountedScoreSheets).
 		GroupBy("score_sheets.team, score_sheets.round, section").
 		OrderBy("round, section").ToSql()
 	outerSql, _, _ := psql.Select(
@@ -390,7 +402,8 @@ func (s *Server) getScoreSheetExcelForDivision(c *gin.Context) {
 		"round",
 		"concat_agg(comments) as comments",
 	).From("score_sheets").
-		Where(sq.Eq{"division": divisionId}).
+		Where(sq.Eq{"division": divisionId, "deleted_at": nil}).
+		Where(crdbStore.CountedScoreSheets).
 		GroupBy("team, round").
 		OrderBy("round").ToSql()
 	var commentList []struct {
@@ -415,7 +428,7 @@ func (s *Server) getScoreSheetExcelForDivision(c *gin.Context) {
 	).From("teams").
 		Join("institutions ON teams.institution = institutions.id").
 		Join("divisions ON teams.division = divisions.id").
-		Where(sq.Eq{"teams.division": divisionId}).ToSql()
+		Where(sq.Eq{"teams.division": divisionId, "teams.deleted_at": nil}).ToSql()
 	var teamData []struct {
 		ID                string `db:"id"`
 		Name              string `db:"name"`
@@ -441,7 +454,7 @@ func (s *Server) getScoreSheetExcelForDivision(c *gin.Context) {
 		"divisions.final_rounds as final_rounds").From("teams").
 		Join("institutions ON teams.institution = institutions.id").
 		Join("divisions ON teams.division = divisions.id").
-		Where(sq.Eq{"divisions.id": divisionId})
+		Where(sq.Eq{"divisions.id": divisionId, "teams.deleted_at": nil})
 	ladderSql, ladderArgs, _ := ladderQuery.ToSql()
 	ladderList := []*danceLadderEntry{}
 	err = s.DB.Select(&ladderList, ladderSql, ladderArgs...)
@@ -451,10 +464,12 @@ func (s *Server) getScoreSheetExcelForDivision(c *gin.Context) {
 	}
 	innerScoreSql, _, _ := psql.Select(
 		"score_sheets.id as id",
-		"SUM(score_sheet_sections.value * score_sheet_template_sections.multiplier) as total",
+		fmt.Sprintf("SUM(%s) as total", crdbStore.WeightedScore),
 	).From("score_sheets").
 		Join("score_sheet_sections ON score_sheet_sections.score_sheet = score_sheets.id").
 		Join("score_sheet_template_sections ON score_sheet_sections.section = score_sheet_template_sections.id").
+		Where(sq.Eq{"score_sheets.deleted_at": nil}).
+		Where(crdbStore.CountedScoreSheets).
 		GroupBy("score_sheets.id").ToSql()
 	scoreQuery := psql.Select(
 		"score_sheets.team as team",
@@ -616,35 +631,35 @@ func (s *Server) getScoreSheetExcelForDivision(c *gin.Context) {
 	c.Writer.Write(zipBuf.Bytes())
 }
 
-type fullUser struct {
-	ID       string `db:"id" json:"id"`
-	Name     string `db:"name" json:"name"`
-	Username string `db:"username" json:"username"`
-	IsAdmin  bool   `db:"is_admin" json:"isAdmin"`
-	Password string `db:"hashed_password" json:"-"`
-}
-
 type counter struct {
 	UserCount int `db:"user_count"`
 }
 
+const sessionCookieName = "rcj-auth"
+
+const authenticatedUserKey = "rcj-user"
+
 func (s *Server) Authenticate() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		fmt.Printf("asdf\n")
-		psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
-		cookie, err := c.Cookie("rcj-auth")
+		cookie, err := c.Cookie(sessionCookieName)
 		if err != nil || len(cookie) == 0 {
 			c.AbortWithStatus(http.StatusUnauthorized)
 			return
 		}
-		sql, args, _ := psql.Select("id", "name", "username", "is_admin").
-			From("users").Where(sq.Eq{"id": cookie}).ToSql()
-		user := fullUser{}
-		err = s.DB.Get(&user, sql, args...)
-		if err != nil || user.ID == "" {
+		user, err := s.Store.FetchSessionUser(c.Request.Context(), cookie)
+		if err != nil {
+			c.AbortWithStatus(http.StatusInternalServerError)
+			return
+		}
+		if user == nil {
 			c.AbortWithStatus(http.StatusUnauthorized)
 			return
 		}
+		if user.GetMustChangePassword() {
+			c.AbortWithStatus(http.StatusForbidden)
+			return
+		}
+		c.Set(authenticatedUserKey, user)
 		c.Next()
 	}
 }
@@ -701,7 +716,7 @@ func (s *robocupGrpcServer) SyncCheckins(ctx context.Context, req *serv.SyncChec
 	if err != nil {
 		return nil, err
 	}
-	divisions, err := s.Store.FetchDivisions(ctx, nil)
+	divisions, err := s.Store.FetchDivisions(ctx, nil, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -713,20 +728,44 @@ func (s *robocupGrpcServer) SyncCheckins(ctx context.Context, req *serv.SyncChec
 }
 
 func (s *robocupGrpcServer) Login(ctx context.Context, req *serv.LoginRequest) (*serv.LoginResponse, error) {
-	user, err := s.Store.AuthenticateUserWithCredentials(req.GetUsername(), req.GetPassword())
+	provider := req.GetProvider()
+	if provider == "" {
+		provider = auth.LocalProvider
+	}
+	authenticator, ok := s.Authenticators.Password[provider]
+	if !ok {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Unknown login provider %s", provider)
+	}
+	address := s.clientAddress(ctx)
+	if err := s.startLoginAttempt(ctx, req.GetUsername(), address); err != nil {
+		return nil, err
+	}
+	identity, err := authenticator.Authenticate(ctx, req.GetUsername(), req.GetPassword())
+	if finishErr := s.finishLoginAttempt(ctx, req.GetUsername(), address, err == nil && identity == nil); finishErr != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while performing login")
+	}
 	if err != nil {
 		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while performing login")
 	}
+	if identity == nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "Invalid credentials")
+	}
+	user, err := s.resolveIdentity(ctx, identity)
+	if err != nil {
+		return nil, statusError(err, "Internal error encountered while performing login")
+	}
 	if user == nil {
 		return nil, grpc.Errorf(codes.Unauthenticated, "Invalid credentials")
 	}
-	cookie := http.Cookie{
-		Name:     "rcj-auth",
-		Value:    user.Id,
-		Path:     "/",
-		HttpOnly: true,
-		MaxAge:   0,
+	err = s.Store.ClearLoginAttempts(ctx, []string{usernameLoginKey(req.GetUsername())})
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while performing login")
+	}
+	token, expiresAt, err := s.Store.CreateSession(ctx, user.GetId(), s.SessionDuration)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while performing login")
 	}
+	cookie := s.newSessionCookie(token, expiresAt)
 	header := metadata.Pairs("set-cookie", cookie.String())
 	grpc.SendHeader(ctx, header)
 	return &serv.LoginResponse{
@@ -734,6 +773,24 @@ func (s *robocupGrpcServer) Login(ctx context.Context, req *serv.LoginRequest) (
 	}, nil
 }
 
+func (s *robocupGrpcServer) Logout(ctx context.Context, req *serv.LogoutRequest) (*serv.LogoutResponse, error) {
+	meta, _ := metadata.FromIncomingContext(ctx)
+	err := s.Store.RevokeSession(ctx, sessionToken(meta))
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while performing logout")
+	}
+	cookie := http.Cookie{
+		Name:     sessionCookieName,
+		Value:    "",
+		Path:     "/",
+		HttpOnly: true,
+		MaxAge:   -1,
+	}
+	header := metadata.Pairs("set-cookie", cookie.String())
+	grpc.SendHeader(ctx, header)
+	return &serv.LogoutResponse{}, nil
+}
+
 func (s *robocupGrpcServer) GetCurrentUser(ctx context.Context, req *serv.GetCurrentUserRequest) (*serv.GetCurrentUserResponse, error) {
 	meta, _ := metadata.FromIncomingContext(ctx)
 	userIds := meta.Get("user-id")
@@ -750,7 +807,7 @@ func (s *robocupGrpcServer) GetCurrentUser(ctx context.Context, req *serv.GetCur
 }
 
 func (s *robocupGrpcServer) GetDanceLadder(ctx context.Context, req *serv.GetDanceLadderRequest) (*serv.GetDanceLadderResponse, error) {
-	ladders, err := s.Store.FetchDanceLadders(req.GetShowAll())
+	ladders, err := s.Store.FetchDanceLadders(ctx, req.GetShowAll())
 	if err != nil {
 		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while performing login")
 	}
@@ -760,19 +817,33 @@ func (s *robocupGrpcServer) GetDanceLadder(ctx context.Context, req *serv.GetDan
 }
 
 func (s *robocupGrpcServer) GetDivision(ctx context.Context, req *serv.GetDivisionRequest) (*serv.GetDivisionResponse, error) {
+	if req.GetAssignedOnly() {
+		userID := currentUser(ctx).GetId()
+		divisionID := req.GetDivisionId()
+		assignments, err := s.Store.FetchJudgeAssignments(ctx, &crdbStore.FetchJudgeAssignmentsOptions{
+			JudgeID:    &userID,
+			DivisionID: &divisionID,
+		}, nil)
+		if err != nil {
+			return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching data")
+		}
+		if len(assignments) == 0 {
+			return nil, grpc.Errorf(codes.PermissionDenied, "Not assigned to division %s", divisionID)
+		}
+	}
 	group, context := errgroup.WithContext(ctx)
 	var div *serv.Division
 	var teams []*serv.Team
 	var scoreSheetTemplates []*serv.ScoreSheetTemplate
 	group.Go(func() error {
-		fetchedDiv, err := s.Store.FetchDivision(req.GetDivisionId())
+		fetchedDiv, err := s.Store.FetchDivision(req.GetDivisionId(), nil)
 		if err != nil {
 			return err
 		}
 		div = fetchedDiv
 		fetchedTemplates, err := s.Store.FetchScoreSheetTemplates(context, &crdbStore.FetchScoreSheetTemplateOptions{
 			IDs: []string{fetchedDiv.GetInterviewTemplateId(), fetchedDiv.GetPerformanceTemplateId()},
-		})
+		}, nil)
 		if err != nil {
 			return err
 		}
@@ -822,7 +893,10 @@ func (s *robocupGrpcServer) GetCheckins(ctx context.Context, req *serv.GetChecki
 }
 
 func (s *robocupGrpcServer) CreateCheckin(ctx context.Context, req *serv.CreateCheckinRequest) (*serv.CreateCheckinResponse, error) {
-	checkin, err := s.Store.CreateCheckin(ctx, func(newCheckin *serv.Checkin) error {
+	if err := requireCompetition(ctx); err != nil {
+		return nil, err
+	}
+	checkin, err := s.Store.CreateCheckin(withIdempotencyKey(ctx, req.GetIdempotencyKey()), func(newCheckin *serv.Checkin) error {
 		meta, _ := metadata.FromIncomingContext(ctx)
 		userIds := meta.Get("user-id")
 		userId := userIds[0]
@@ -841,7 +915,12 @@ func (s *robocupGrpcServer) CreateCheckin(ctx context.Context, req *serv.CreateC
 }
 
 func (s *robocupGrpcServer) GetDivisions(ctx context.Context, req *serv.GetDivisionsRequest) (*serv.GetDivisionsResponse, error) {
-	divs, err := s.Store.FetchDivisions(ctx, nil)
+	opts := &crdbStore.FetchDivisionsOptions{}
+	if req.GetAssignedOnly() {
+		userID := currentUser(ctx).GetId()
+		opts.JudgeID = &userID
+	}
+	divs, err := s.Store.FetchDivisions(ctx, opts, nil)
 	if err != nil {
 		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while getting divisions")
 	}
@@ -864,15 +943,12 @@ func gRPCMiddleware(server *grpcweb.WrappedGrpcServer) gin.HandlerFunc {
 }
 
 func (s *robocupGrpcServer) UpdateScoreSheet(ctx context.Context, req *serv.UpdateScoreSheetRequest) (*serv.UpdateScoreSheetResponse, error) {
-	scoreSheet, err := s.Store.UpdateScoreSheet(ctx, req.ScoreSheet.Id, func(scoreSheet *serv.ScoreSheet) error {
-		scoreSheet.Team.Id = req.ScoreSheet.GetTeam().GetId()
-		scoreSheet.Timings = req.ScoreSheet.GetTimings()
-		scoreSheet.Comments = req.ScoreSheet.GetComments()
-		scoreSheet.Sections = req.ScoreSheet.GetSections()
-		return nil
-	})
+	if req.GetScoreSheet().GetVersion() == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "The version the score sheet was read at is required")
+	}
+	scoreSheet, err := s.updateScoreSheet(ctx, req.GetScoreSheet())
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while updating score sheet")
+		return nil, statusError(err, "Internal error encountered while updating score sheet")
 	}
 	return &serv.UpdateScoreSheetResponse{
 		ScoreSheet: scoreSheet,
@@ -880,6 +956,13 @@ func (s *robocupGrpcServer) UpdateScoreSheet(ctx context.Context, req *serv.Upda
 }
 
 func (s *robocupGrpcServer) CreateScoreSheetTemplate(ctx context.Context, req *serv.CreateScoreSheetTemplateRequest) (*serv.CreateScoreSheetTemplateResponse, error) {
+	if err := requireCompetition(ctx); err != nil {
+		return nil, err
+	}
+	defaultSectionMultipliers(req.GetScoreSheetTemplate())
+	if err := validateScoreSheetTemplate(req.GetScoreSheetTemplate(), "score_sheet_template"); err != nil {
+		return nil, err
+	}
 	template, err := s.Store.CreateScoreSheetTemplate(ctx, func(newTemplate *serv.ScoreSheetTemplate) error {
 		proto.Merge(newTemplate, req.GetScoreSheetTemplate())
 		return nil
@@ -892,25 +975,110 @@ func (s *robocupGrpcServer) CreateScoreSheetTemplate(ctx context.Context, req *s
 	}, nil
 }
 
-func (s *robocupGrpcServer) CreateScoreSheet(ctx context.Context, req *serv.CreateScoreSheetRequest) (*serv.CreateScoreSheetResponse, error) {
-	scoreSheet, err := s.Store.CreateScoreSheet(ctx, func(newScoreSheet *serv.ScoreSheet) error {
-		meta, _ := metadata.FromIncomingContext(ctx)
-		userIds := meta.Get("user-id")
-		userId := userIds[0]
-		proto.Merge(newScoreSheet, req.GetScoreSheet())
-		newScoreSheet.Author = &serv.User{
-			Id: userId,
+func (s *robocupGrpcServer) UpdateScoreSheetTemplate(ctx context.Context, req *serv.UpdateScoreSheetTemplateRequest) (*serv.UpdateScoreSheetTemplateResponse, error) {
+	if err := requireCompetition(ctx); err != nil {
+		return nil, err
+	}
+	defaultSectionMultipliers(req.GetScoreSheetTemplate())
+	if err := validateScoreSheetTemplate(req.GetScoreSheetTemplate(), "score_sheet_template"); err != nil {
+		return nil, err
+	}
+	template, err := s.Store.UpdateScoreSheetTemplate(ctx, req.GetScoreSheetTemplate().GetId(), func(template *serv.ScoreSheetTemplate) error {
+		if template.GetCompetitionId() != crdbStore.CompetitionFromContext(ctx) {
+			return crdbStore.ErrNotFound
 		}
+		template.Name = req.GetScoreSheetTemplate().GetName()
+		template.Type = req.GetScoreSheetTemplate().GetType()
+		template.Timings = req.GetScoreSheetTemplate().GetTimings()
+		template.Sections = req.GetScoreSheetTemplate().GetSections()
 		return nil
 	})
 	if err != nil {
-		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while creating score sheet")
+		return nil, storeStatusError(err, "Internal error encountered while updating score sheet template")
+	}
+	return &serv.UpdateScoreSheetTemplateResponse{
+		ScoreSheetTemplate: template,
+	}, nil
+}
+
+func (s *robocupGrpcServer) CreateScoreSheet(ctx context.Context, req *serv.CreateScoreSheetRequest) (*serv.CreateScoreSheetResponse, error) {
+	if req.GetAllowDuplicate() && !userHasAnyRole(currentUser(ctx), adminOnly) {
+		return nil, grpc.Errorf(codes.PermissionDenied, "Only admins may create duplicate score sheets")
+	}
+	opts := &crdbStore.CreateScoreSheetOptions{
+		AllowDuplicate: req.GetAllowDuplicate(),
+	}
+	scoreSheet, err := s.createScoreSheet(withIdempotencyKey(ctx, req.GetIdempotencyKey()), req.GetScoreSheet(), opts)
+	if err != nil {
+		return nil, statusError(err, "Internal error encountered while creating score sheet")
 	}
 	return &serv.CreateScoreSheetResponse{
 		ScoreSheet: scoreSheet,
 	}, nil
 }
 
+// createScoreSheet validates the requested sheet and creates it with the current user as author.
+func (s *robocupGrpcServer) createScoreSheet(ctx context.Context, requested *serv.ScoreSheet, opts *crdbStore.CreateScoreSheetOptions) (*serv.ScoreSheet, error) {
+	if requested.GetStatus() == serv.ScoreSheet_LOCKED {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Score sheets are locked with LockRound")
+	}
+	return s.Store.CreateScoreSheet(ctx, opts, func(newScoreSheet *serv.ScoreSheet) error {
+		meta, _ := metadata.FromIncomingContext(ctx)
+		userIds := meta.Get("user-id")
+		userId := userIds[0]
+		proto.Merge(newScoreSheet, requested)
+		newScoreSheet.Author = &serv.User{
+			Id: userId,
+		}
+		templateSections := map[string]*serv.ScoreSheetTemplateSection{}
+		if newScoreSheet.GetScoreSheetTemplateId() != "" {
+			// A missing template is reported with the other problems by the store
+			template, err := s.fetchTemplate(ctx, newScoreSheet.GetScoreSheetTemplateId())
+			if err != nil && grpc.Code(err) != codes.NotFound {
+				return err
+			}
+			for _, section := range template.GetSections() {
+				templateSections[section.GetId()] = section
+			}
+		}
+		err := validateScoreSheet(newScoreSheet, func(section *serv.ScoreSheetSection) *serv.ScoreSheetTemplateSection {
+			return templateSections[section.GetSectionId()]
+		})
+		if err != nil {
+			return err
+		}
+		return s.checkJudgeAssignment(ctx, newScoreSheet)
+	})
+}
+
+// updateScoreSheet applies the requested team, timings, comments, status and section values to
+// the sheet, provided it is still at the requested version.
+func (s *robocupGrpcServer) updateScoreSheet(ctx context.Context, requested *serv.ScoreSheet) (*serv.ScoreSheet, error) {
+	if requested.GetStatus() == serv.ScoreSheet_LOCKED {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Score sheets are locked with LockRound")
+	}
+	return s.Store.UpdateScoreSheet(ctx, requested.GetId(), func(scoreSheet *serv.ScoreSheet) error {
+		if err := s.checkJudgeAssignment(ctx, scoreSheet); err != nil {
+			return err
+		}
+		// Values are checked against the template version the sheet is pinned to
+		scoredSections := map[string]*serv.ScoreSheetTemplateSection{}
+		for _, section := range scoreSheet.GetSections() {
+			scoredSections[section.GetId()] = scoreSheetSectionTemplate(section)
+		}
+		scoreSheet.Team.Id = requested.GetTeam().GetId()
+		scoreSheet.Timings = requested.GetTimings()
+		scoreSheet.Comments = requested.GetComments()
+		scoreSheet.Status = requested.GetStatus()
+		scoreSheet.Version = requested.GetVersion()
+		scoreSheet.EditedAt = requested.GetEditedAt()
+		scoreSheet.Sections = requested.GetSections()
+		return validateScoreSheet(scoreSheet, func(section *serv.ScoreSheetSection) *serv.ScoreSheetTemplateSection {
+			return scoredSections[section.GetId()]
+		})
+	})
+}
+
 func (s *robocupGrpcServer) CreateUser(ctx context.Context, req *serv.CreateUserRequest) (*serv.CreateUserResponse, error) {
 	user, err := s.Store.CreateUser(ctx, func(newUser *serv.User) error {
 		proto.Merge(newUser, req.GetUser())
@@ -925,8 +1093,17 @@ func (s *robocupGrpcServer) CreateUser(ctx context.Context, req *serv.CreateUser
 }
 
 func (s *robocupGrpcServer) UpdateUser(ctx context.Context, req *serv.UpdateUserRequest) (*serv.UpdateUserResponse, error) {
+	// Passwords are only changed through ChangePassword and ResetUserPassword
+	if req.GetUser() != nil {
+		req.User.Password = ""
+	}
 	user, err := s.Store.UpdateUser(ctx, req.GetUser().GetId(), func(existingUser *serv.User) error {
 		proto.Merge(existingUser, req.GetUser())
+		// Roles replace rather than append to the existing set
+		if len(req.GetUser().GetRoles()) > 0 {
+			existingUser.Roles = req.GetUser().GetRoles()
+			existingUser.IsAdmin = req.GetUser().GetIsAdmin()
+		}
 		return nil
 	})
 	if err != nil {
@@ -938,6 +1115,9 @@ func (s *robocupGrpcServer) UpdateUser(ctx context.Context, req *serv.UpdateUser
 }
 
 func (s *robocupGrpcServer) CreateDivision(ctx context.Context, req *serv.CreateDivisionRequest) (*serv.CreateDivisionResponse, error) {
+	if err := requireCompetition(ctx); err != nil {
+		return nil, err
+	}
 	division, err := s.Store.CreateDivision(ctx, func(newDivision *serv.Division) error {
 		proto.Merge(newDivision, req.GetDivision())
 		return nil
@@ -950,6 +1130,30 @@ func (s *robocupGrpcServer) CreateDivision(ctx context.Context, req *serv.Create
 	}, nil
 }
 
+func (s *robocupGrpcServer) UpdateDivision(ctx context.Context, req *serv.UpdateDivisionRequest) (*serv.UpdateDivisionResponse, error) {
+	if err := s.checkDivisionCompetition(ctx, req.GetDivision().GetId()); err != nil {
+		return nil, err
+	}
+	if req.GetDivision().GetCompetitionRounds() < 0 || req.GetDivision().GetFinalRounds() < 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Rounds cannot be negative")
+	}
+	division, err := s.Store.UpdateDivision(ctx, req.GetDivision().GetId(), func(division *serv.Division) error {
+		division.Name = req.GetDivision().GetName()
+		division.League = req.GetDivision().GetLeague()
+		division.CompetitionRounds = req.GetDivision().GetCompetitionRounds()
+		division.FinalRounds = req.GetDivision().GetFinalRounds()
+		division.InterviewTemplateId = req.GetDivision().GetInterviewTemplateId()
+		division.PerformanceTemplateId = req.GetDivision().GetPerformanceTemplateId()
+		return nil
+	})
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while updating division")
+	}
+	return &serv.UpdateDivisionResponse{
+		Division: division,
+	}, nil
+}
+
 func (s *robocupGrpcServer) GetTeams(ctx context.Context, req *serv.GetTeamsRequest) (*serv.GetTeamsResponse, error) {
 	opts := &crdbStore.FetchTeamsOptions{
 		PopulateMembers: req.GetPopulateMembers(),
@@ -973,7 +1177,7 @@ func (s *robocupGrpcServer) GetTeam(ctx context.Context, req *serv.GetTeamReques
 			return err
 		}
 		team = fetchedTeam
-		fetchedDiv, err := s.Store.FetchDivision(fetchedTeam.GetDivision())
+		fetchedDiv, err := s.Store.FetchDivision(fetchedTeam.GetDivision(), nil)
 		if err != nil {
 			return err
 		}
@@ -1022,7 +1226,10 @@ func (s *robocupGrpcServer) GetScoreSheets(ctx context.Context, req *serv.GetSco
 }
 
 func (s *robocupGrpcServer) CreateTeam(ctx context.Context, req *serv.CreateTeamRequest) (*serv.CreateTeamResponse, error) {
-	team, err := s.Store.CreateTeam(ctx, nil, func(team *serv.Team) error {
+	if err := s.checkDivisionCompetition(ctx, req.GetTeam().GetDivision()); err != nil {
+		return nil, err
+	}
+	team, err := s.Store.CreateTeam(withIdempotencyKey(ctx, req.GetIdempotencyKey()), nil, func(team *serv.Team) error {
 		proto.Merge(team, req.GetTeam())
 		return nil
 	})
@@ -1036,7 +1243,14 @@ func (s *robocupGrpcServer) CreateTeam(ctx context.Context, req *serv.CreateTeam
 }
 
 func (s *robocupGrpcServer) UpdateTeam(ctx context.Context, req *serv.UpdateTeamRequest) (*serv.UpdateTeamResponse, error) {
+	if err := s.checkDivisionCompetition(ctx, req.GetTeam().GetDivision()); err != nil {
+		return nil, err
+	}
+	if req.GetTeam().GetVersion() == 0 {
+		return nil, grpc.Errorf(codes.InvalidArgument, "The version the team was read at is required")
+	}
 	team, err := s.Store.UpdateTeam(ctx, req.GetTeam().GetId(), func(team *serv.Team) error {
+		team.Version = req.Team.GetVersion()
 		team.Name = req.Team.GetName()
 		team.Division = req.Team.GetDivision()
 		team.Institution = req.Team.Institution
@@ -1045,7 +1259,7 @@ func (s *robocupGrpcServer) UpdateTeam(ctx context.Context, req *serv.UpdateTeam
 	})
 	if err != nil {
 		fmt.Printf("%+v\n", err)
-		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while updating team")
+		return nil, statusError(err, "Internal error encountered while updating team")
 	}
 	return &serv.UpdateTeamResponse{
 		Team: team,
@@ -1093,47 +1307,139 @@ func (s *robocupGrpcServer) GetSheetTeams(ctx context.Context, req *serv.GetShee
 	}, nil
 }
 
+// sessionToken extracts the session cookie from the incoming request metadata.
+func sessionToken(meta metadata.MD) string {
+	rawCookieSet := meta.Get("cookie")
+	if len(rawCookieSet) == 0 {
+		return ""
+	}
+	header := http.Header{}
+	for _, rawCookies := range rawCookieSet {
+		header.Add("Cookie", rawCookies)
+	}
+	request := http.Request{Header: header}
+	cook, err := request.Cookie(sessionCookieName)
+	if cook == nil || err != nil {
+		return ""
+	}
+	return cook.Value
+}
+
 func (s *robocupGrpcServer) AuthFuncOverride(ctx context.Context, fullMethodName string) (context.Context, error) {
-	if fullMethodName == "/Robocup/Login" {
+	if publicMethods[fullMethodName] {
 		return ctx, nil
 	}
-	psql := sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
 	meta, _ := metadata.FromIncomingContext(ctx)
-	userId := ""
-	rawCookieSet := meta.Get("cookie")
-	if len(rawCookieSet) > 0 {
-		rawCookies := rawCookieSet[0]
-		header := http.Header{}
-		header.Add("Cookie", rawCookies)
-		request := http.Request{Header: header}
-		cook, err := request.Cookie("rcj-auth")
-		if cook != nil && err == nil {
-			userId = cook.Value
-		}
+	if len(meta.Get("authorization")) > 0 {
+		return s.authenticateApiKey(ctx, meta, fullMethodName)
 	}
-	if userId == "" {
+	token := sessionToken(meta)
+	if token == "" {
 		return nil, grpc.Errorf(codes.Unauthenticated, "Not logged in")
 	}
-	sql, args, _ := psql.Select("id", "name", "username", "is_admin").
-		From("users").Where(sq.Eq{"id": userId}).ToSql()
-	var users []fullUser
-	err := s.Store.DB.Select(&users, sql, args...)
+	user, err := s.Store.FetchSessionUser(ctx, token)
 	if err != nil {
 		return nil, grpc.Errorf(codes.Internal, "Error: %+v", err)
 	}
-	if len(users) == 0 {
-		return nil, grpc.Errorf(codes.Unauthenticated, "User id not found")
+	if user == nil {
+		return nil, grpc.Errorf(codes.Unauthenticated, "Session expired or invalid")
+	}
+	if user.GetMustChangePassword() && !passwordChangeMethods[fullMethodName] {
+		return nil, grpc.Errorf(codes.FailedPrecondition, "Password change required")
+	}
+	if !methodAllowed(user, fullMethodName) {
+		return nil, grpc.Errorf(codes.PermissionDenied, "Not permitted to call %s", fullMethodName)
+	}
+	// Never trust a user-id or roles supplied by the client
+	authMeta := meta.Copy()
+	authMeta.Set("user-id", user.GetId())
+	roleNames := []string{}
+	for _, role := range user.GetRoles() {
+		roleNames = append(roleNames, role.String())
+	}
+	authMeta.Set("user-role", roleNames...)
+	competitionID, err := s.activeCompetition(ctx, meta, token)
+	if err != nil {
+		return nil, statusError(err, "Internal error encountered while selecting competition")
+	}
+	ctx = crdbStore.WithAuditActor(ctx, user.GetId(), fullMethodName)
+	ctx = crdbStore.WithCompetition(ctx, competitionID)
+	return metadata.NewIncomingContext(ctx, authMeta), nil
+}
+
+// currentUser returns the authenticated user established by AuthFuncOverride, populated with its ID and roles.
+func currentUser(ctx context.Context) *serv.User {
+	meta, _ := metadata.FromIncomingContext(ctx)
+	user := &serv.User{}
+	if userIds := meta.Get("user-id"); len(userIds) > 0 {
+		user.Id = userIds[0]
+	}
+	for _, roleName := range meta.Get("user-role") {
+		user.Roles = append(user.Roles, serv.User_Role(serv.User_Role_value[roleName]))
+	}
+	return user
+}
+
+// statusError passes through errors that already carry a gRPC status, such as
+// those returned by handlers, and otherwise reports an internal error.
+func statusError(err error, message string) error {
+	if verr, ok := err.(*crdbStore.ValidationError); ok {
+		return validationStatus(verr)
+	}
+	if conflict, ok := err.(*crdbStore.ConflictError); ok {
+		return status.Error(codes.FailedPrecondition, conflict.Message)
+	}
+	if stale, ok := err.(*crdbStore.StaleError); ok {
+		return staleStatus(stale)
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
 	}
-	userIdMeta := metadata.Pairs("user-id", userId)
-	return metadata.NewIncomingContext(ctx, metadata.Join(meta, userIdMeta)), nil
+	return status.Error(codes.Internal, message)
 }
 
 type ServerConfig struct {
 	AppSecretPath    string `json:"appSecretPath"`
 	ConnectionString string `json:"ConnectionString"`
 	GinMode          string `json:"ginMode"`
+	SessionHours     int    `json:"sessionHours"`
+	// BootstrapAdminUsername and BootstrapAdminPassword seed the first admin account when
+	// the users table is empty. They may also be supplied via RCJ_BOOTSTRAP_ADMIN_USERNAME
+	// and RCJ_BOOTSTRAP_ADMIN_PASSWORD.
+	BootstrapAdminUsername string `json:"bootstrapAdminUsername"`
+	BootstrapAdminPassword string `json:"bootstrapAdminPassword"`
+	TrustForwardedFor      bool   `json:"trustForwardedFor"`
+	// LDAP and OIDC configure additional login backends alongside the local users table
+	LDAP []auth.LDAPConfig `json:"ldap"`
+	OIDC []auth.OIDCConfig `json:"oidc"`
+}
+
+// redacted returns a copy of the config with secrets removed, suitable for logging.
+func (c ServerConfig) redacted() ServerConfig {
+	if c.BootstrapAdminPassword != "" {
+		c.BootstrapAdminPassword = "<redacted>"
+	}
+	ldapConfigs := make([]auth.LDAPConfig, len(c.LDAP))
+	for idx, ldapConfig := range c.LDAP {
+		if ldapConfig.BindPassword != "" {
+			ldapConfig.BindPassword = "<redacted>"
+		}
+		ldapConfigs[idx] = ldapConfig
+	}
+	c.LDAP = ldapConfigs
+	oidcConfigs := make([]auth.OIDCConfig, len(c.OIDC))
+	for idx, oidcConfig := range c.OIDC {
+		if oidcConfig.ClientSecret != "" {
+			oidcConfig.ClientSecret = "<redacted>"
+		}
+		oidcConfigs[idx] = oidcConfig
+	}
+	c.OIDC = oidcConfigs
+	return c
 }
 
+const defaultSessionHours = 24
+
 var config ServerConfig
 
 func (s *Server) getHealth(c *gin.Context) {
@@ -1151,7 +1457,13 @@ func (s *Server) Start() error {
 	if err != nil {
 		panic(err)
 	}
-	fmt.Printf("Running with config %+v\n", config)
+	if username := os.Getenv("RCJ_BOOTSTRAP_ADMIN_USERNAME"); username != "" {
+		config.BootstrapAdminUsername = username
+	}
+	if password := os.Getenv("RCJ_BOOTSTRAP_ADMIN_PASSWORD"); password != "" {
+		config.BootstrapAdminPassword = password
+	}
+	fmt.Printf("Running with config %+v\n", config.redacted())
 	db, err := sqlx.Connect("postgres", config.ConnectionString)
 	if err != nil {
 		return err
@@ -1165,6 +1477,15 @@ func (s *Server) Start() error {
 		return err
 	}
 	s.Store = store
+	if config.BootstrapAdminUsername != "" && config.BootstrapAdminPassword != "" {
+		created, err := store.BootstrapAdmin(context.Background(), config.BootstrapAdminUsername, config.BootstrapAdminPassword)
+		if err != nil {
+			return err
+		}
+		if created {
+			fmt.Printf("Created bootstrap admin user %s\n", config.BootstrapAdminUsername)
+		}
+	}
 	if config.GinMode == "release" {
 		gin.SetMode(gin.ReleaseMode)
 	}
@@ -1173,17 +1494,30 @@ func (s *Server) Start() error {
 	)
 	sheets := sheetStore.NewSheetStore(config.AppSecretPath)
 	sheets.DB = dbObj
-	serv.RegisterRobocupServer(grpcServer, &robocupGrpcServer{
-		Store:  store,
-		Sheets: sheets,
-	})
+	sessionHours := config.SessionHours
+	if sessionHours <= 0 {
+		sessionHours = defaultSessionHours
+	}
+	s.GRPC = &robocupGrpcServer{
+		Store:             store,
+		Sheets:            sheets,
+		SessionDuration:   time.Duration(sessionHours) * time.Hour,
+		TrustForwardedFor: config.TrustForwardedFor,
+		Authenticators:    newAuthenticators(config, &auth.LocalAuthenticator{Store: store}),
+	}
+	serv.RegisterRobocupServer(grpcServer, s.GRPC)
 	wrapped := grpcweb.WrapServer(grpcServer)
 	s.Engine = gin.Default()
 	s.Engine.Use(gRPCMiddleware(wrapped))
 	s.Engine.GET("/healthz", s.getHealth)
+	s.Engine.GET("/auth/:provider/login", s.redirectLogin)
+	s.Engine.GET("/auth/:provider/callback", s.redirectCallback)
 	authorised := s.Engine.Group("/api", s.Authenticate())
-	authorised.GET("/division/:id/excel", s.getScoreSheetExcelForDivision)
-	authorised.GET("/team/:id/excel", s.getScoreSheetExcel)
+	authorised.GET("/division/:id/excel", s.Authorize(officials...), s.getScoreSheetExcelForDivision)
+	authorised.GET("/team/:id/excel", s.Authorize(officials...), s.getScoreSheetExcel)
+	authorised.POST("/users/bulk", s.Authorize(adminOnly...), s.postBulkUsers)
+	authorised.GET("/templates/:id/export", s.Authorize(officials...), s.getTemplateExport)
+	authorised.POST("/templates/import", s.Authorize(adminOnly...), s.postTemplateImport)
 	return s.Engine.Run()
 
 }
@@ -0,0 +1,189 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/cockroach-go/crdb"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+// apiKeyPrefix makes keys recognisable in config files and logs.
+const apiKeyPrefix = "rcj_"
+
+type FetchApiKeysOptions struct {
+	IDs []string
+	// KeyHash restricts the results to the unrevoked key with the given hash
+	KeyHash *string
+}
+
+func (s *CockroachStore) FetchApiKeys(ctx context.Context, opts *FetchApiKeysOptions, txx *sqlx.Tx) ([]*rcjpb.ApiKey, error) {
+	query := s.PSQL.Select("id", "name", "scopes", "created_by", "created_at", "revoked_at").
+		From("api_keys").OrderBy("created_at")
+	if opts != nil {
+		if len(opts.IDs) > 0 {
+			query = query.Where(sq.Eq{"id": opts.IDs})
+		}
+		if opts.KeyHash != nil {
+			query = query.Where(sq.Eq{"key_hash": opts.KeyHash, "revoked_at": nil})
+		}
+	}
+	sql, args, _ := query.ToSql()
+	type dbApiKey struct {
+		ID        string         `db:"id"`
+		Name      string         `db:"name"`
+		Scopes    pq.StringArray `db:"scopes"`
+		CreatedBy string         `db:"created_by"`
+		CreatedAt time.Time      `db:"created_at"`
+		RevokedAt *time.Time     `db:"revoked_at"`
+	}
+	dbKeys := []dbApiKey{}
+	var err error
+	if txx != nil {
+		err = txx.Select(&dbKeys, sql, args...)
+	} else {
+		err = s.DB.SelectContext(ctx, &dbKeys, sql, args...)
+	}
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching API keys: %+v", err))
+	}
+	results := make([]*rcjpb.ApiKey, len(dbKeys))
+	for idx, entry := range dbKeys {
+		apiKey := &rcjpb.ApiKey{
+			Id:        entry.ID,
+			Name:      entry.Name,
+			CreatedBy: entry.CreatedBy,
+			CreatedAt: &tspb.Timestamp{
+				Seconds: entry.CreatedAt.Unix(),
+				Nanos:   int32(entry.CreatedAt.Nanosecond()),
+			},
+		}
+		for _, scope := range entry.Scopes {
+			if value, ok := rcjpb.ApiKey_Scope_value[scope]; ok {
+				apiKey.Scopes = append(apiKey.Scopes, rcjpb.ApiKey_Scope(value))
+			}
+		}
+		if entry.RevokedAt != nil {
+			apiKey.RevokedAt = &tspb.Timestamp{
+				Seconds: entry.RevokedAt.Unix(),
+				Nanos:   int32(entry.RevokedAt.Nanosecond()),
+			}
+		}
+		results[idx] = apiKey
+	}
+	return results, nil
+}
+
+// FetchApiKeyByToken returns the unrevoked key matching the presented secret, or nil if there is none.
+func (s *CockroachStore) FetchApiKeyByToken(ctx context.Context, token string) (*rcjpb.ApiKey, error) {
+	if token == "" {
+		return nil, nil
+	}
+	keyHash := hashSessionToken(token)
+	keys, err := s.FetchApiKeys(ctx, &FetchApiKeysOptions{
+		KeyHash: &keyHash,
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(keys) == 0 {
+		return nil, nil
+	}
+	// Keys act as their creator, so they stop working once the creator is deleted
+	sql, args, _ := s.PSQL.Select("count(*)").From("users").
+		Where(sq.Eq{"id": keys[0].GetCreatedBy(), "deleted_at": nil}).ToSql()
+	var count int
+	err = s.DB.GetContext(ctx, &count, sql, args...)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching API key: %+v", err))
+	}
+	if count == 0 {
+		return nil, nil
+	}
+	return keys[0], nil
+}
+
+// CreateApiKey stores a new key and returns it along with the secret, which is only persisted as a hash.
+func (s *CockroachStore) CreateApiKey(ctx context.Context, handler func(*rcjpb.ApiKey) error) (*rcjpb.ApiKey, string, error) {
+	random, err := newSessionToken()
+	if err != nil {
+		return nil, "", errors.New(fmt.Sprintf("Error generating API key: %+v", err))
+	}
+	token := apiKeyPrefix + random
+	var keyID string
+	err = crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		apiKey := &rcjpb.ApiKey{}
+		handlerError := handler(apiKey)
+		if handlerError != nil {
+			return handlerError
+		}
+		scopes := []string{}
+		for _, scope := range apiKey.GetScopes() {
+			scopes = append(scopes, scope.String())
+		}
+		sql, args, _ := s.PSQL.Insert("api_keys").
+			Columns("name", "key_hash", "scopes", "created_by").
+			Values(apiKey.GetName(), hashSessionToken(token), pq.StringArray(scopes), apiKey.GetCreatedBy()).
+			Suffix("RETURNING \"id\"").ToSql()
+		rows, err := tx.Query(sql, args...)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			rows.Scan(&keyID)
+		}
+		rows.Close()
+		apiKey.Id = keyID
+		return s.recordAudit(ctx, tx, "ApiKey", keyID, nil, apiKey)
+	})
+	if err != nil {
+		return nil, "", err
+	}
+	keys, err := s.FetchApiKeys(ctx, &FetchApiKeysOptions{
+		IDs: []string{keyID},
+	}, nil)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(keys) != 1 {
+		return nil, "", errors.New("Error fetching API key: Not found")
+	}
+	return keys[0], token, nil
+}
+
+func (s *CockroachStore) RevokeApiKey(ctx context.Context, id string) error {
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		keys, err := s.FetchApiKeys(ctx, &FetchApiKeysOptions{
+			IDs: []string{id},
+		}, tx)
+		if err != nil {
+			return err
+		}
+		if len(keys) == 0 || keys[0].GetRevokedAt() != nil {
+			return nil
+		}
+		sql, args, _ := s.PSQL.Update("api_keys").
+			Set("revoked_at", sq.Expr("current_timestamp()")).
+			Where(sq.Eq{"id": id}).ToSql()
+		_, err = tx.Exec(sql, args...)
+		if err != nil {
+			return err
+		}
+		revoked, err := s.FetchApiKeys(ctx, &FetchApiKeysOptions{
+			IDs: []string{id},
+		}, tx)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "ApiKey", id, keys[0], revoked[0])
+	})
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error revoking API key: %+v", err))
+	}
+	return nil
+}
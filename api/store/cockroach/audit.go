@@ -0,0 +1,152 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+type auditContextKey struct{}
+
+type auditActor struct {
+	userID string
+	method string
+}
+
+// WithAuditActor attaches the user and RPC responsible for any writes made with the returned
+// context. Writes made without an actor are recorded with an empty actor and method.
+func WithAuditActor(ctx context.Context, userID, method string) context.Context {
+	return context.WithValue(ctx, auditContextKey{}, auditActor{
+		userID: userID,
+		method: method,
+	})
+}
+
+var auditMarshaler = &jsonpb.Marshaler{OrigName: true}
+
+func auditSnapshot(msg proto.Message) (interface{}, error) {
+	if msg == nil {
+		return nil, nil
+	}
+	str, err := auditMarshaler.MarshalToString(msg)
+	if err != nil {
+		return nil, err
+	}
+	return str, nil
+}
+
+// recordAudit appends an entry to the audit log as part of the transaction performing the change.
+// before is nil for creations and after is nil for deletions.
+func (s *CockroachStore) recordAudit(ctx context.Context, txx *sqlx.Tx, entityType, entityID string, before, after proto.Message) error {
+	actor, _ := ctx.Value(auditContextKey{}).(auditActor)
+	beforeJSON, err := auditSnapshot(before)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error recording audit entry: %+v", err))
+	}
+	afterJSON, err := auditSnapshot(after)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error recording audit entry: %+v", err))
+	}
+	var actorID interface{}
+	if actor.userID != "" {
+		actorID = actor.userID
+	}
+	sql, args, _ := s.PSQL.Insert("audit_log").
+		Columns("actor", "method", "entity_type", "entity_id", "before", "after").
+		Values(actorID, actor.method, entityType, entityID, beforeJSON, afterJSON).ToSql()
+	_, err = txx.Exec(sql, args...)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error recording audit entry: %+v", err))
+	}
+	return nil
+}
+
+type FetchAuditLogOptions struct {
+	EntityType *string
+	EntityID   *string
+	ActorID    *string
+	Start      *time.Time
+	End        *time.Time
+	Limit      uint64
+}
+
+// FetchAuditLog returns audit entries, newest first.
+func (s *CockroachStore) FetchAuditLog(ctx context.Context, opts *FetchAuditLogOptions) ([]*rcjpb.AuditEntry, error) {
+	query := s.PSQL.Select(
+		"id",
+		"actor",
+		"method",
+		"entity_type",
+		"entity_id",
+		"before::STRING as before",
+		"after::STRING as after",
+		"created_at",
+	).From("audit_log").OrderBy("created_at DESC")
+	if opts != nil {
+		if opts.EntityType != nil {
+			query = query.Where(sq.Eq{"entity_type": opts.EntityType})
+		}
+		if opts.EntityID != nil {
+			query = query.Where(sq.Eq{"entity_id": opts.EntityID})
+		}
+		if opts.ActorID != nil {
+			query = query.Where(sq.Eq{"actor": opts.ActorID})
+		}
+		if opts.Start != nil {
+			query = query.Where(sq.GtOrEq{"created_at": opts.Start})
+		}
+		if opts.End != nil {
+			query = query.Where(sq.Lt{"created_at": opts.End})
+		}
+		if opts.Limit > 0 {
+			query = query.Limit(opts.Limit)
+		}
+	}
+	sql, args, _ := query.ToSql()
+	type dbEntry struct {
+		ID         string    `db:"id"`
+		Actor      *string   `db:"actor"`
+		Method     string    `db:"method"`
+		EntityType string    `db:"entity_type"`
+		EntityID   string    `db:"entity_id"`
+		Before     *string   `db:"before"`
+		After      *string   `db:"after"`
+		CreatedAt  time.Time `db:"created_at"`
+	}
+	dbEntries := []dbEntry{}
+	err := s.DB.SelectContext(ctx, &dbEntries, sql, args...)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching audit log: %+v", err))
+	}
+	results := make([]*rcjpb.AuditEntry, len(dbEntries))
+	for idx, entry := range dbEntries {
+		auditEntry := &rcjpb.AuditEntry{
+			Id:         entry.ID,
+			Method:     entry.Method,
+			EntityType: entry.EntityType,
+			EntityId:   entry.EntityID,
+			CreatedAt: &tspb.Timestamp{
+				Seconds: entry.CreatedAt.Unix(),
+				Nanos:   int32(entry.CreatedAt.Nanosecond()),
+			},
+		}
+		if entry.Actor != nil {
+			auditEntry.ActorId = *entry.Actor
+		}
+		if entry.Before != nil {
+			auditEntry.Before = *entry.Before
+		}
+		if entry.After != nil {
+			auditEntry.After = *entry.After
+		}
+		results[idx] = auditEntry
+	}
+	return results, nil
+}
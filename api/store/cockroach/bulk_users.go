@@ -0,0 +1,63 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/cockroach-go/crdb"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/elithrar/simple-scrypt"
+	"github.com/jmoiron/sqlx"
+)
+
+// BulkCreateUsers creates every user in a single transaction, so either all or none are created.
+// If any of the usernames already exist nothing is created and the existing usernames are returned.
+func (s *CockroachStore) BulkCreateUsers(ctx context.Context, users []*rcjpb.User) ([]*rcjpb.User, []string, error) {
+	// scrypt is deliberately slow, so hash up front rather than on every transaction retry
+	hashes := make([]string, len(users))
+	usernames := make([]string, len(users))
+	for idx, user := range users {
+		hash, err := scrypt.GenerateFromPassword([]byte(user.GetPassword()), scrypt.DefaultParams)
+		if err != nil {
+			return nil, nil, err
+		}
+		hashes[idx] = string(hash)
+		usernames[idx] = user.GetUsername()
+	}
+	var userIDs []string
+	var existing []string
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		userIDs = make([]string, len(users))
+		existing = []string{}
+		sql, args, _ := s.PSQL.Select("username").From("users").Where(sq.Eq{"username": usernames}).ToSql()
+		err := tx.Select(&existing, sql, args...)
+		if err != nil {
+			return err
+		}
+		if len(existing) > 0 {
+			return nil
+		}
+		for idx, user := range users {
+			userIDs[idx], err = s.insertUser(ctx, tx, user, hashes[idx])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, nil, errors.New(fmt.Sprintf("Error creating users: %+v", err))
+	}
+	if len(existing) > 0 {
+		return nil, existing, nil
+	}
+	created := make([]*rcjpb.User, len(userIDs))
+	for idx, userID := range userIDs {
+		created[idx], err = s.FetchUser(userID, nil)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	return created, nil, nil
+}
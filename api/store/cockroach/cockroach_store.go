@@ -9,10 +9,11 @@ import (
 	"github.com/davefinster/cockroach-go/crdb"
 	rcjpb "github.com/davefinster/rcj-go/api/proto"
 	"github.com/elithrar/simple-scrypt"
+	"github.com/golang/protobuf/proto"
 	tspb "github.com/golang/protobuf/ptypes/timestamp"
 	"github.com/jmoiron/sqlx"
 	"github.com/jmoiron/sqlx/types"
-	_ "github.com/lib/pq"
+	"github.com/lib/pq"
 	"github.com/shopspring/decimal"
 	"golang.org/x/sync/errgroup"
 	"sort"
@@ -37,71 +38,84 @@ func NewCockroachStore(postgres string) (*CockroachStore, error) {
 	}, nil
 }
 
-func (s *CockroachStore) createInitialUser(username, password string) error {
+// BootstrapAdmin creates an admin account with the given credentials if no users exist yet.
+// It reports whether the account was created. The count and insert share a transaction so
+// concurrent server instances cannot both seed an admin.
+func (s *CockroachStore) BootstrapAdmin(ctx context.Context, username, password string) (bool, error) {
 	hash, err := scrypt.GenerateFromPassword([]byte(password), scrypt.DefaultParams)
 	if err != nil {
-		return err
-	}
-	userSql, userArgs, _ := s.PSQL.Insert("users").
-		Columns("name", "username", "hashed_password", "is_admin").
-		Values(username, username, string(hash), true).ToSql()
-	_, err = s.DB.Exec(userSql, userArgs...)
+		return false, err
+	}
+	created := false
+	err = crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		created = false
+		countSql, countArgs, _ := s.PSQL.Select("count(*) as user_count").From("users").ToSql()
+		userCount := struct {
+			UserCount int `db:"user_count"`
+		}{}
+		err := tx.Get(&userCount, countSql, countArgs...)
+		if err != nil {
+			return err
+		}
+		if userCount.UserCount > 0 {
+			return nil
+		}
+		userSql, userArgs, _ := s.PSQL.Insert("users").
+			Columns("name", "username", "hashed_password", "is_admin").
+			Values(username, username, string(hash), true).Suffix("RETURNING \"id\"").ToSql()
+		userRows, err := tx.Query(userSql, userArgs...)
+		if err != nil {
+			return err
+		}
+		var userID string
+		for userRows.Next() {
+			userRows.Scan(&userID)
+		}
+		userRows.Close()
+		err = s.setUserRoles(tx, userID, []rcjpb.User_Role{rcjpb.User_ADMIN})
+		if err != nil {
+			return err
+		}
+		created = true
+		return nil
+	})
 	if err != nil {
-		return err
+		return false, errors.New(fmt.Sprintf("Error bootstrapping admin user: %+v", err))
 	}
-	return nil
+	return created, nil
 }
 
 func (s *CockroachStore) AuthenticateUserWithCredentials(username, password string) (*rcjpb.User, error) {
-	countSql, countArgs, _ := s.PSQL.Select("count(*) as user_count").From("users").ToSql()
-	userCount := struct {
-		UserCount int `db:"user_count"`
-	}{}
-	err := s.DB.Get(&userCount, countSql, countArgs...)
-	if err != nil {
-		return nil, errors.New(fmt.Sprintf("Error counting users: %+v", err))
-	}
-	if userCount.UserCount == 0 {
-		if err = s.createInitialUser(username, password); err != nil {
-			return nil, errors.New(fmt.Sprintf("Error creating initial user: %+v", err))
-		}
-	}
-	sql, args, _ := s.PSQL.Select("id", "name", "username", "hashed_password", "is_admin").
-		From("users").Where(sq.Eq{"username": username}).ToSql()
-	user := struct {
+	// Users provisioned from external identities have no local password
+	sql, args, _ := s.PSQL.Select("id", "hashed_password").
+		From("users").Where(sq.Eq{"username": username, "deleted_at": nil}).Where(sq.NotEq{"hashed_password": ""}).Limit(1).ToSql()
+	users := []struct {
 		ID       string `db:"id"`
-		Name     string `db:"name"`
-		Username string `db:"username"`
-		IsAdmin  bool   `db:"is_admin"`
 		Password string `db:"hashed_password"`
 	}{}
-	err = s.DB.Get(&user, sql, args...)
+	err := s.DB.Select(&users, sql, args...)
 	if err != nil {
 		return nil, errors.New(fmt.Sprintf("Error fetching user: %+v", err))
 	}
-	if user.ID == "" {
-		return nil, errors.New("Error fetching user: Not found")
+	if len(users) == 0 {
+		return nil, nil
 	}
-	err = scrypt.CompareHashAndPassword([]byte(user.Password), []byte(password))
+	err = scrypt.CompareHashAndPassword([]byte(users[0].Password), []byte(password))
 	if err != nil {
 		return nil, nil
 	}
-	return &rcjpb.User{
-		Id:       user.ID,
-		Name:     user.Name,
-		Username: user.Username,
-		IsAdmin:  user.IsAdmin,
-	}, nil
+	return s.FetchUser(users[0].ID, nil)
 }
 
 func (s *CockroachStore) FetchUser(id string, txx *sqlx.Tx) (*rcjpb.User, error) {
-	sql, args, _ := s.PSQL.Select("id", "name", "username", "is_admin").
-		From("users").Where(sq.Eq{"id": id}).ToSql()
+	sql, args, _ := s.PSQL.Select("id", "name", "username", "is_admin", "must_change_password").
+		From("users").Where(sq.Eq{"id": id, "deleted_at": nil}).ToSql()
 	user := struct {
-		ID       string `db:"id"`
-		Name     string `db:"name"`
-		Username string `db:"username"`
-		IsAdmin  bool   `db:"is_admin"`
+		ID                 string `db:"id"`
+		Name               string `db:"name"`
+		Username           string `db:"username"`
+		IsAdmin            bool   `db:"is_admin"`
+		MustChangePassword bool   `db:"must_change_password"`
 	}{}
 	var err error
 	if txx != nil {
@@ -115,16 +129,25 @@ func (s *CockroachStore) FetchUser(id string, txx *sqlx.Tx) (*rcjpb.User, error)
 	if user.ID == "" {
 		return nil, errors.New("Error fetching user: Not found")
 	}
-	return &rcjpb.User{
-		Id:       user.ID,
-		Name:     user.Name,
-		Username: user.Username,
-		IsAdmin:  user.IsAdmin,
-	}, nil
+	roles, err := s.fetchUserRoles([]string{user.ID}, txx)
+	if err != nil {
+		return nil, err
+	}
+	pbUser := &rcjpb.User{
+		Id:                 user.ID,
+		Name:               user.Name,
+		Username:           user.Username,
+		IsAdmin:            user.IsAdmin,
+		Roles:              roles[user.ID],
+		MustChangePassword: user.MustChangePassword,
+	}
+	pbUser.Roles = userRoles(pbUser)
+	pbUser.IsAdmin = hasRole(pbUser.Roles, rcjpb.User_ADMIN)
+	return pbUser, nil
 }
 
-func (s *CockroachStore) FetchDanceLadders(showAll bool) ([]*rcjpb.DivisionLadder, error) {
-	sql, args, _ := s.PSQL.Select(
+func (s *CockroachStore) FetchDanceLadders(ctx context.Context, showAll bool) ([]*rcjpb.DivisionLadder, error) {
+	query := s.PSQL.Select(
 		"teams.id as id",
 		"teams.name as name",
 		"institutions.id as institution_id",
@@ -135,7 +158,8 @@ func (s *CockroachStore) FetchDanceLadders(showAll bool) ([]*rcjpb.DivisionLadde
 		"divisions.final_rounds as final_rounds").From("teams").
 		Join("institutions ON teams.institution = institutions.id").
 		Join("divisions ON teams.division = divisions.id").
-		Where(sq.Eq{"divisions.league": "On Stage"}).ToSql()
+		Where(sq.Eq{"divisions.league": "On Stage", "teams.deleted_at": nil})
+	sql, args, _ := competitionScope(ctx, query, "teams.competition").ToSql()
 	type ladderTeam struct {
 		ID                string `db:"id"`
 		Name              string `db:"name"`
@@ -147,16 +171,18 @@ func (s *CockroachStore) FetchDanceLadders(showAll bool) ([]*rcjpb.DivisionLadde
 		FinalRounds       int    `db:"final_rounds"`
 	}
 	list := []ladderTeam{}
-	err := s.DB.Select(&list, sql, args...)
+	err := s.DB.SelectContext(ctx, &list, sql, args...)
 	if err != nil {
 		return nil, errors.New(fmt.Sprintf("Error fetching teams: %+v", err))
 	}
 	innerScoreSql, _, _ := s.PSQL.Select(
 		"score_sheets.id as id",
-		"SUM(score_sheet_sections.value * score_sheet_template_sections.multiplier) as total",
+		fmt.Sprintf("SUM(%s) as total", WeightedScore),
 	).From("score_sheets").
 		Join("score_sheet_sections ON score_sheet_sections.score_sheet = score_sheets.id").
 		Join("score_sheet_template_sections ON score_sheet_sections.section = score_sheet_template_sections.id").
+		Where(sq.Eq{"score_sheets.deleted_at": nil}).
+		Where(CountedScoreSheets).
 		GroupBy("score_sheets.id").ToSql()
 	scoreSql, scoreArgs, _ := s.PSQL.Select(
 		"score_sheets.team as team",
@@ -172,7 +198,7 @@ func (s *CockroachStore) FetchDanceLadders(showAll bool) ([]*rcjpb.DivisionLadde
 		Count   int             `db:"count"`
 	}
 	scores := []scoreCalc{}
-	err = s.DB.Select(&scores, scoreSql, scoreArgs...)
+	err = s.DB.SelectContext(ctx, &scores, scoreSql, scoreArgs...)
 	if err != nil {
 		return nil, errors.New(fmt.Sprintf("Error fetching scores: %+v", err))
 	}
@@ -274,7 +300,7 @@ func (s *CockroachStore) FetchDanceLadders(showAll bool) ([]*rcjpb.DivisionLadde
 	return results, nil
 }
 
-func (s *CockroachStore) FetchDivision(id string) (*rcjpb.Division, error) {
+func (s *CockroachStore) FetchDivision(id string, txx *sqlx.Tx) (*rcjpb.Division, error) {
 	sql, args, _ := s.PSQL.Select(
 		"id",
 		"name",
@@ -283,7 +309,8 @@ func (s *CockroachStore) FetchDivision(id string) (*rcjpb.Division, error) {
 		"final_rounds",
 		"interview_template",
 		"performance_template",
-	).From("divisions").Where(sq.Eq{"id": id}).ToSql()
+		"competition",
+	).From("divisions").Where(sq.Eq{"id": id, "deleted_at": nil}).ToSql()
 	division := struct {
 		ID                  string  `db:"id"`
 		Name                string  `db:"name"`
@@ -292,8 +319,14 @@ func (s *CockroachStore) FetchDivision(id string) (*rcjpb.Division, error) {
 		FinalRounds         int     `db:"final_rounds"`
 		InterviewTemplate   *string `db:"interview_template"`
 		PerformanceTemplate *string `db:"performance_template"`
+		Competition         string  `db:"competition"`
 	}{}
-	err := s.DB.Get(&division, sql, args...)
+	var err error
+	if txx != nil {
+		err = txx.Get(&division, sql, args...)
+	} else {
+		err = s.DB.Get(&division, sql, args...)
+	}
 	if err != nil {
 		return nil, errors.New(fmt.Sprintf("Error fetching division: %+v", err))
 	}
@@ -312,6 +345,7 @@ func (s *CockroachStore) FetchDivision(id string) (*rcjpb.Division, error) {
 		League:            league,
 		CompetitionRounds: int32(division.CompetitionRounds),
 		FinalRounds:       int32(division.FinalRounds),
+		CompetitionId:     division.Competition,
 	}
 	if division.InterviewTemplate != nil {
 		returnDiv.InterviewTemplateId = *division.InterviewTemplate
@@ -336,8 +370,10 @@ func (s *CockroachStore) FetchTeams(ctx context.Context, options *FetchTeamsOpti
 		"institutions.name as institution",
 		"teams.import_id as import_id",
 		"teams.division as division",
+		"teams.version as version",
 	).From("teams").
-		Join("institutions ON teams.institution = institutions.id")
+		Join("institutions ON teams.institution = institutions.id").
+		Where(sq.Eq{"teams.deleted_at": nil})
 	if options != nil {
 		if options.Division != nil {
 			query = query.Where(sq.Eq{"teams.division": options.Division})
@@ -346,7 +382,7 @@ func (s *CockroachStore) FetchTeams(ctx context.Context, options *FetchTeamsOpti
 			query = query.Where(sq.Eq{"teams.import_id": options.ImportID})
 		}
 	}
-	sql, args, _ := query.ToSql()
+	sql, args, _ := competitionScope(ctx, query, "teams.competition").ToSql()
 	teams := []struct {
 		ID            string `db:"id"`
 		Name          string `db:"name"`
@@ -354,6 +390,7 @@ func (s *CockroachStore) FetchTeams(ctx context.Context, options *FetchTeamsOpti
 		Institution   string `db:"institution"`
 		ImportID      string `db:"import_id"`
 		Division      string `db:"division"`
+		Version       int32  `db:"version"`
 	}{}
 	err := s.DB.SelectContext(ctx, &teams, sql, args...)
 	if err != nil {
@@ -370,6 +407,7 @@ func (s *CockroachStore) FetchTeams(ctx context.Context, options *FetchTeamsOpti
 			},
 			ImportId: dbTeam.ImportID,
 			Division: dbTeam.Division,
+			Version:  dbTeam.Version,
 			Members:  []*rcjpb.Member{},
 		}
 	}
@@ -382,13 +420,14 @@ func (s *CockroachStore) FetchTeams(ctx context.Context, options *FetchTeamsOpti
 		"team_members.gender as gender",
 		"teams.division as division",
 		"teams.id as team",
-	).From("team_members").Join("teams ON teams.id = team_members.team")
+	).From("team_members").Join("teams ON teams.id = team_members.team").
+		Where(sq.Eq{"teams.deleted_at": nil})
 	if options != nil {
 		if options.Division != nil {
 			memberQuery = memberQuery.Where(sq.Eq{"teams.division": options.Division})
 		}
 	}
-	mSql, mArgs, _ := memberQuery.ToSql()
+	mSql, mArgs, _ := competitionScope(ctx, memberQuery, "teams.competition").ToSql()
 	type dbMember struct {
 		ID       string `db:"id"`
 		Name     string `db:"name"`
@@ -426,49 +465,73 @@ type FetchScoreSheetTemplateOptions struct {
 	IDs []string
 }
 
-func (s *CockroachStore) FetchScoreSheetTemplates(ctx context.Context, options *FetchScoreSheetTemplateOptions) ([]*rcjpb.ScoreSheetTemplate, error) {
+func (s *CockroachStore) FetchScoreSheetTemplates(ctx context.Context, options *FetchScoreSheetTemplateOptions, txx *sqlx.Tx) ([]*rcjpb.ScoreSheetTemplate, error) {
 	query := s.PSQL.Select(
 		"id",
 		"name",
 		"type",
 		"timings",
-	).From("score_sheet_templates")
+		"competition",
+		"version",
+	).From("score_sheet_templates").Where(sq.Eq{"deleted_at": nil})
 	sectionQuery := s.PSQL.Select(
 		"id",
 		"title",
 		"description",
 		"max_value",
 		"multiplier",
+		"display_order",
+		"kind",
+		"step",
+		"points",
+		"levels",
 		"score_sheet_template",
-	).From("score_sheet_template_sections").OrderBy("display_order")
+	).From("score_sheet_template_sections").
+		Where("version = (SELECT version FROM score_sheet_templates WHERE score_sheet_templates.id = score_sheet_template_sections.score_sheet_template)").
+		OrderBy("display_order")
 	if options != nil {
 		if len(options.IDs) > 0 {
 			query = query.Where(sq.Eq{"id": options.IDs})
 			sectionQuery = sectionQuery.Where(sq.Eq{"score_sheet_template": options.IDs})
 		}
 	}
-	group, _ := errgroup.WithContext(ctx)
+	query = competitionScope(ctx, query, "competition")
+	if competitionID := CompetitionFromContext(ctx); competitionID != "" {
+		sectionQuery = sectionQuery.Where(sq.Expr("score_sheet_template IN (SELECT id FROM score_sheet_templates WHERE competition = ?)", competitionID))
+	}
 	type dbTemplate struct {
-		ID           string `db:"id"`
-		Name         string `db:"name"`
-		Type         string `db:"type"`
-		TimingString string `db:"timings"`
+		ID          string         `db:"id"`
+		Name        string         `db:"name"`
+		Type        string         `db:"type"`
+		Timings     pq.StringArray `db:"timings"`
+		Competition string         `db:"competition"`
+		Version     int            `db:"version"`
 	}
 	type dbSection struct {
-		ID                 string `db:"id"`
-		Title              string `db:"title"`
-		Description        string `db:"description"`
-		MaxValue           int    `db:"max_value"`
-		Multiplier         int    `db:"multiplier"`
-		ScoreSheetTemplate string `db:"score_sheet_template"`
+		ID                 string         `db:"id"`
+		Title              string         `db:"title"`
+		Description        string         `db:"description"`
+		MaxValue           int            `db:"max_value"`
+		Multiplier         int            `db:"multiplier"`
+		DisplayOrder       int            `db:"display_order"`
+		Kind               string         `db:"kind"`
+		Step               float64        `db:"step"`
+		Points             float64        `db:"points"`
+		Levels             types.JSONText `db:"levels"`
+		ScoreSheetTemplate string         `db:"score_sheet_template"`
 	}
 	dbTemplates := []*dbTemplate{}
 	dbSections := []*dbSection{}
 	var lock sync.Mutex
-	group.Go(func() error {
+	fetchTemplates := func() error {
 		sql, args, _ := query.ToSql()
 		innerTemplates := []*dbTemplate{}
-		err := s.DB.Select(&innerTemplates, sql, args...)
+		var err error
+		if txx != nil {
+			err = txx.Select(&innerTemplates, sql, args...)
+		} else {
+			err = s.DB.Select(&innerTemplates, sql, args...)
+		}
 		if err != nil {
 			return err
 		}
@@ -476,11 +539,16 @@ func (s *CockroachStore) FetchScoreSheetTemplates(ctx context.Context, options *
 		lock.Lock()
 		dbTemplates = innerTemplates
 		return nil
-	})
-	group.Go(func() error {
+	}
+	fetchSections := func() error {
 		sql, args, _ := sectionQuery.ToSql()
 		innerSections := []*dbSection{}
-		err := s.DB.Select(&innerSections, sql, args...)
+		var err error
+		if txx != nil {
+			err = txx.Select(&innerSections, sql, args...)
+		} else {
+			err = s.DB.Select(&innerSections, sql, args...)
+		}
 		if err != nil {
 			return err
 		}
@@ -488,8 +556,20 @@ func (s *CockroachStore) FetchScoreSheetTemplates(ctx context.Context, options *
 		lock.Lock()
 		dbSections = innerSections
 		return nil
-	})
-	err := group.Wait()
+	}
+	var err error
+	if txx != nil {
+		// Statements on a transaction cannot run concurrently
+		err = fetchTemplates()
+		if err == nil {
+			err = fetchSections()
+		}
+	} else {
+		group, _ := errgroup.WithContext(ctx)
+		group.Go(fetchTemplates)
+		group.Go(fetchSections)
+		err = group.Wait()
+	}
 	if err != nil {
 		return nil, errors.New(fmt.Sprintf("Error fetching: %+v", err))
 	}
@@ -499,30 +579,34 @@ func (s *CockroachStore) FetchScoreSheetTemplates(ctx context.Context, options *
 			sectionMap[dbSection.ScoreSheetTemplate] = []*rcjpb.ScoreSheetTemplateSection{}
 		}
 		section := &rcjpb.ScoreSheetTemplateSection{
-			Id:          dbSection.ID,
-			Title:       dbSection.Title,
-			Description: dbSection.Description,
-			MaxValue:    int32(dbSection.MaxValue),
-			Multiplier:  int32(dbSection.Multiplier),
+			Id:           dbSection.ID,
+			Title:        dbSection.Title,
+			Description:  dbSection.Description,
+			MaxValue:     int32(dbSection.MaxValue),
+			Multiplier:   int32(dbSection.Multiplier),
+			DisplayOrder: int32(dbSection.DisplayOrder),
+			Kind:         sectionKind(dbSection.Kind),
+			Step:         dbSection.Step,
+			Points:       dbSection.Points,
+			Levels:       unmarshalLevels(dbSection.Levels),
 		}
 		sectionMap[dbSection.ScoreSheetTemplate] = append(sectionMap[dbSection.ScoreSheetTemplate], section)
 	}
 	templates := make([]*rcjpb.ScoreSheetTemplate, len(dbTemplates))
 	for idx, dbTemplate := range dbTemplates {
 		template := &rcjpb.ScoreSheetTemplate{
-			Id:   dbTemplate.ID,
-			Name: dbTemplate.Name,
+			Id:            dbTemplate.ID,
+			Name:          dbTemplate.Name,
+			CompetitionId: dbTemplate.Competition,
+			Version:       int32(dbTemplate.Version),
 		}
 		if strings.ToLower(dbTemplate.Type) == "interview" {
 			template.Type = rcjpb.ScoreSheetTemplate_INTERVIEW
 		} else {
 			template.Type = rcjpb.ScoreSheetTemplate_PERFORMANCE
 		}
-		cleanTimingString := dbTemplate.TimingString[1 : len(dbTemplate.TimingString)-1]
-		if len(cleanTimingString) > 0 {
-			for _, str := range strings.Split(cleanTimingString, ",") {
-				template.Timings = append(template.Timings, str[1:len(str)-1])
-			}
+		if len(dbTemplate.Timings) > 0 {
+			template.Timings = []string(dbTemplate.Timings)
 		}
 		if sections, ok := sectionMap[dbTemplate.ID]; ok {
 			template.Sections = sections
@@ -536,6 +620,7 @@ func (s *CockroachStore) FetchScoreSheet(ctx context.Context, id string, txx *sq
 	sheetQuery := s.PSQL.Select(
 		"score_sheets.id as id",
 		"score_sheets.template as template",
+		"score_sheets.template_version as template_version",
 		"score_sheet_templates.type as type",
 		"score_sheets.comments as comments",
 		"score_sheets.timings as timings",
@@ -543,6 +628,9 @@ func (s *CockroachStore) FetchScoreSheet(ctx context.Context, id string, txx *sq
 		"score_sheets.division as division",
 		"teams.name as team",
 		"score_sheets.round as round",
+		"score_sheets.status as status",
+		"score_sheets.version as version",
+		"score_sheets.edited_at as edited_at",
 		"institutions.id as team_institution_id",
 		"institutions.name as team_institution_name",
 		"users.id as author",
@@ -552,13 +640,18 @@ func (s *CockroachStore) FetchScoreSheet(ctx context.Context, id string, txx *sq
 		Join("score_sheet_templates ON score_sheets.template = score_sheet_templates.id").
 		Join("teams ON score_sheets.team = teams.id").
 		Join("institutions ON teams.institution = institutions.id").
-		Join("users ON users.id = score_sheets.author").Where(sq.Eq{"score_sheets.id": id})
+		Join("users ON users.id = score_sheets.author").
+		Where(sq.Eq{"score_sheets.id": id, "score_sheets.deleted_at": nil})
 	sectionQuery := s.PSQL.Select(
 		"score_sheet_sections.id as id",
 		"score_sheet_template_sections.title as title",
 		"score_sheet_template_sections.description as description",
 		"score_sheet_template_sections.max_value as max_value",
 		"score_sheet_template_sections.multiplier as multiplier",
+		"score_sheet_template_sections.kind as kind",
+		"score_sheet_template_sections.step as step",
+		"score_sheet_template_sections.points as points",
+		"score_sheet_template_sections.levels as levels",
 		"score_sheet_sections.section as section",
 		"score_sheet_sections.value as value",
 	).From("score_sheet_sections").
@@ -568,6 +661,7 @@ func (s *CockroachStore) FetchScoreSheet(ctx context.Context, id string, txx *sq
 	type dbScoreSheet struct {
 		ID              string         `db:"id"`
 		TemplateID      string         `db:"template"`
+		TemplateVersion int            `db:"template_version"`
 		Type            string         `db:"type"`
 		Comments        string         `db:"comments"`
 		Timings         types.JSONText `db:"timings"`
@@ -575,6 +669,9 @@ func (s *CockroachStore) FetchScoreSheet(ctx context.Context, id string, txx *sq
 		Division        string         `db:"division"`
 		Team            string         `db:"team"`
 		Round           int            `db:"round"`
+		Status          string         `db:"status"`
+		Version         int            `db:"version"`
+		EditedAt        *time.Time     `db:"edited_at"`
 		InstitutionID   string         `db:"team_institution_id"`
 		InstitutionName string         `db:"team_institution_name"`
 		AuthorID        string         `db:"author"`
@@ -582,20 +679,23 @@ func (s *CockroachStore) FetchScoreSheet(ctx context.Context, id string, txx *sq
 		AuthorUsername  string         `db:"author_username"`
 	}
 	type dbScoreSheetSection struct {
-		ID          string  `db:"id"`
-		Title       string  `db:"title"`
-		Description string  `db:"description"`
-		MaxValue    int     `db:"max_value"`
-		Multiplier  int     `db:"multiplier"`
-		SectionID   string  `db:"section"`
-		Value       float32 `db:"value"`
+		ID          string         `db:"id"`
+		Title       string         `db:"title"`
+		Description string         `db:"description"`
+		MaxValue    int            `db:"max_value"`
+		Multiplier  int            `db:"multiplier"`
+		Kind        string         `db:"kind"`
+		Step        float64        `db:"step"`
+		Points      float64        `db:"points"`
+		Levels      types.JSONText `db:"levels"`
+		SectionID   string         `db:"section"`
+		Value       float32        `db:"value"`
 	}
 	var scoreSheet *dbScoreSheet
 	sections := []*dbScoreSheetSection{}
 	var lock sync.Mutex
 
-	group, _ := errgroup.WithContext(ctx)
-	group.Go(func() error {
+	fetchSheet := func() error {
 		sql, args, _ := sheetQuery.ToSql()
 		fetchedSheet := dbScoreSheet{}
 		var err error
@@ -611,8 +711,8 @@ func (s *CockroachStore) FetchScoreSheet(ctx context.Context, id string, txx *sq
 		lock.Lock()
 		scoreSheet = &fetchedSheet
 		return nil
-	})
-	group.Go(func() error {
+	}
+	fetchSections := func() error {
 		sql, args, _ := sectionQuery.ToSql()
 		fetchedSections := []*dbScoreSheetSection{}
 		var err error
@@ -628,8 +728,20 @@ func (s *CockroachStore) FetchScoreSheet(ctx context.Context, id string, txx *sq
 		lock.Lock()
 		sections = fetchedSections
 		return nil
-	})
-	err := group.Wait()
+	}
+	var err error
+	if txx != nil {
+		// Statements on a transaction cannot run concurrently
+		err = fetchSheet()
+		if err == nil {
+			err = fetchSections()
+		}
+	} else {
+		group, _ := errgroup.WithContext(ctx)
+		group.Go(fetchSheet)
+		group.Go(fetchSections)
+		err = group.Wait()
+	}
 	if err != nil {
 		fmt.Printf("ERR %+v\n", err)
 		return nil, errors.New(fmt.Sprintf("Error fetching: %+v", err))
@@ -652,8 +764,11 @@ func (s *CockroachStore) FetchScoreSheet(ctx context.Context, id string, txx *sq
 	score := &rcjpb.ScoreSheet{
 		Id:                   scoreSheet.ID,
 		ScoreSheetTemplateId: scoreSheet.TemplateID,
+		TemplateVersion:      int32(scoreSheet.TemplateVersion),
 		Comments:             scoreSheet.Comments,
 		Round:                int32(scoreSheet.Round),
+		Status:               scoreSheetStatus(scoreSheet.Status),
+		Version:              int32(scoreSheet.Version),
 		Team: &rcjpb.Team{
 			Id:   scoreSheet.TeamID,
 			Name: scoreSheet.Team,
@@ -672,6 +787,12 @@ func (s *CockroachStore) FetchScoreSheet(ctx context.Context, id string, txx *sq
 		Timings:    pbTimings,
 		DivisionId: scoreSheet.Division,
 	}
+	if scoreSheet.EditedAt != nil {
+		score.EditedAt = &tspb.Timestamp{
+			Seconds: scoreSheet.EditedAt.Unix(),
+			Nanos:   int32(scoreSheet.EditedAt.Nanosecond()),
+		}
+	}
 	if scoreSheet.Type == "Interview" {
 		score.Type = rcjpb.ScoreSheetTemplate_INTERVIEW
 	} else {
@@ -686,20 +807,54 @@ func (s *CockroachStore) FetchScoreSheet(ctx context.Context, id string, txx *sq
 			Multiplier:  int32(section.Multiplier),
 			SectionId:   section.SectionID,
 			Value:       float64(section.Value),
+			Kind:        sectionKind(section.Kind),
+			Step:        section.Step,
+			Points:      section.Points,
+			Levels:      unmarshalLevels(section.Levels),
 		}
 		score.Sections[idx] = pbSection
 	}
 	return score, nil
 }
 
-func (s *CockroachStore) CreateScoreSheet(ctx context.Context, handler func(*rcjpb.ScoreSheet) error) (*rcjpb.ScoreSheet, error) {
+type CreateScoreSheetOptions struct {
+	// ID is a client generated ID to create the sheet with. The database generates one if empty.
+	ID string
+	// AllowDuplicate skips the check that the author has not already scored the team in the
+	// same round with the same template.
+	AllowDuplicate bool
+}
+
+func (s *CockroachStore) CreateScoreSheet(ctx context.Context, opts *CreateScoreSheetOptions, handler func(*rcjpb.ScoreSheet) error) (*rcjpb.ScoreSheet, error) {
 	var scoreSheetID string
 	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		replayedID, err := s.replayedID(ctx, tx, "score_sheets", "author", "created_at")
+		if err != nil {
+			return err
+		}
+		if replayedID != "" {
+			scoreSheetID = replayedID
+			return nil
+		}
 		scoreSheet := &rcjpb.ScoreSheet{}
 		handlerError := handler(scoreSheet)
 		if handlerError != nil {
 			return handlerError
 		}
+		err = s.validateScoreSheet(tx, scoreSheet, true)
+		if err != nil {
+			return err
+		}
+		err = s.checkRoundOpen(tx, scoreSheet.GetDivisionId(), scoreSheet.GetRound())
+		if err != nil {
+			return err
+		}
+		if opts == nil || !opts.AllowDuplicate {
+			err = s.checkDuplicateScoreSheet(tx, scoreSheet)
+			if err != nil {
+				return err
+			}
+		}
 
 		type scoreSheetTiming struct {
 			Name  string `json:"name"`
@@ -717,17 +872,29 @@ func (s *CockroachStore) CreateScoreSheet(ctx context.Context, handler func(*rcj
 			return err
 		}
 
+		// Sheets are pinned to the template version current at the time they are created
+		columns := []string{"division", "team", "template", "template_version", "timings", "comments", "round", "author", "status", "idempotency_key", "edited_at"}
+		values := []interface{}{
+			scoreSheet.GetDivisionId(),
+			scoreSheet.GetTeam().GetId(),
+			scoreSheet.GetScoreSheetTemplateId(),
+			sq.Expr("(SELECT version FROM score_sheet_templates WHERE id = ?)", scoreSheet.GetScoreSheetTemplateId()),
+			string(b),
+			scoreSheet.GetComments(),
+			scoreSheet.GetRound(),
+			scoreSheet.GetAuthor().GetId(),
+			scoreSheetStatusNames[scoreSheet.GetStatus()],
+			idempotencyKey(ctx),
+			editedAt(scoreSheet),
+		}
+		if opts != nil && opts.ID != "" {
+			columns = append(columns, "id")
+			values = append(values, opts.ID)
+		}
 		ssSql, ssArgs, _ := s.PSQL.Insert("score_sheets").
-			Columns("division", "team", "template", "timings", "comments", "round", "author").
-			Values(
-				scoreSheet.GetDivisionId(),
-				scoreSheet.GetTeam().GetId(),
-				scoreSheet.GetScoreSheetTemplateId(),
-				string(b),
-				scoreSheet.GetComments(),
-				scoreSheet.GetRound(),
-				scoreSheet.GetAuthor().GetId(),
-			).Suffix("RETURNING \"id\"").ToSql()
+			Columns(columns...).
+			Values(values...).
+			Suffix("RETURNING \"id\"").ToSql()
 
 		ssRows, err := tx.Query(ssSql, ssArgs...)
 		if err != nil {
@@ -748,7 +915,15 @@ func (s *CockroachStore) CreateScoreSheet(ctx context.Context, handler func(*rcj
 		if err != nil {
 			return err
 		}
-		return nil
+		created, err := s.FetchScoreSheet(ctx, scoreSheetID, tx)
+		if err != nil {
+			return err
+		}
+		err = s.recordRevision(ctx, tx, created)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "ScoreSheet", scoreSheetID, nil, created)
 	})
 	if err != nil {
 		return nil, err
@@ -756,16 +931,43 @@ func (s *CockroachStore) CreateScoreSheet(ctx context.Context, handler func(*rcj
 	return s.FetchScoreSheet(ctx, scoreSheetID, nil)
 }
 
+// UpdateScoreSheet applies the handler's changes to the score sheet. The handler must leave Version as the
+// version the caller read; if the score sheet has changed since, a StaleError holding the current copy
+// is returned.
 func (s *CockroachStore) UpdateScoreSheet(ctx context.Context, scoreSheetId string, handler func(*rcjpb.ScoreSheet) error) (*rcjpb.ScoreSheet, error) {
 	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
 		scoreSheet, err := s.FetchScoreSheet(ctx, scoreSheetId, tx)
 		if err != nil {
 			return err
 		}
+		if scoreSheet.GetStatus() == rcjpb.ScoreSheet_LOCKED {
+			return &ConflictError{
+				Message: "Score sheet is locked",
+			}
+		}
+		err = s.checkRoundOpen(tx, scoreSheet.GetDivisionId(), scoreSheet.GetRound())
+		if err != nil {
+			return err
+		}
+		original := proto.Clone(scoreSheet)
 		handlerError := handler(scoreSheet)
 		if handlerError != nil {
 			return handlerError
 		}
+		err = checkVersion("Score sheet", scoreSheet.GetVersion(), original.(*rcjpb.ScoreSheet).GetVersion(), original)
+		if err != nil {
+			return err
+		}
+		err = s.validateScoreSheet(tx, scoreSheet, false)
+		if err != nil {
+			return err
+		}
+		if scoreSheet.GetTeam().GetId() != original.(*rcjpb.ScoreSheet).GetTeam().GetId() {
+			err = s.checkDuplicateScoreSheet(tx, scoreSheet)
+			if err != nil {
+				return err
+			}
+		}
 		type scoreSheetTiming struct {
 			Name  string `json:"name"`
 			Value string `json:"value"`
@@ -782,9 +984,13 @@ func (s *CockroachStore) UpdateScoreSheet(ctx context.Context, scoreSheetId stri
 			return err
 		}
 		ssUpdateFields := map[string]interface{}{
-			"team":     scoreSheet.Team.GetId(),
-			"timings":  string(b),
-			"comments": scoreSheet.GetComments(),
+			"team":       scoreSheet.Team.GetId(),
+			"timings":    string(b),
+			"comments":   scoreSheet.GetComments(),
+			"status":     scoreSheetStatusNames[scoreSheet.GetStatus()],
+			"version":    sq.Expr("version + 1"),
+			"edited_at":  editedAt(scoreSheet),
+			"updated_at": sq.Expr("current_timestamp()"),
 		}
 		ssSql, ssArgs, _ := s.PSQL.Update("score_sheets").SetMap(ssUpdateFields).Where(sq.Eq{"id": scoreSheetId}).ToSql()
 		_, err = tx.Exec(ssSql, ssArgs...)
@@ -798,7 +1004,15 @@ func (s *CockroachStore) UpdateScoreSheet(ctx context.Context, scoreSheetId stri
 				return err
 			}
 		}
-		return nil
+		updated, err := s.FetchScoreSheet(ctx, scoreSheetId, tx)
+		if err != nil {
+			return err
+		}
+		err = s.recordRevision(ctx, tx, updated)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "ScoreSheet", scoreSheetId, original, updated)
 	})
 	if err != nil {
 		return nil, err
@@ -815,10 +1029,11 @@ type FetchScoreSheetSummaryOptions struct {
 func (s *CockroachStore) FetchScoreSheetSummary(ctx context.Context, opts *FetchScoreSheetSummaryOptions, txx *sqlx.Tx) ([]*rcjpb.ScoreSheet, error) {
 	innerQuery := s.PSQL.Select(
 		"score_sheets.id as id",
-		"SUM(score_sheet_sections.value * score_sheet_template_sections.multiplier) as total",
+		fmt.Sprintf("SUM(%s) as total", WeightedScore),
 	).From("score_sheets").
 		Join("score_sheet_sections ON score_sheet_sections.score_sheet = score_sheets.id").
-		Join("score_sheet_template_sections ON score_sheet_sections.section = score_sheet_template_sections.id")
+		Join("score_sheet_template_sections ON score_sheet_sections.section = score_sheet_template_sections.id").
+		Where(sq.Eq{"score_sheets.deleted_at": nil})
 	if opts != nil {
 		if opts.TeamID != nil {
 			innerQuery = innerQuery.Where(sq.Eq{"score_sheets.team": opts.TeamID})
@@ -827,6 +1042,9 @@ func (s *CockroachStore) FetchScoreSheetSummary(ctx context.Context, opts *Fetch
 			innerQuery = innerQuery.Where(sq.Eq{"score_sheets.author": opts.AuthorID})
 		}
 	}
+	if competitionID := CompetitionFromContext(ctx); competitionID != "" {
+		innerQuery = innerQuery.Where(sq.Expr("score_sheets.division IN (SELECT id FROM divisions WHERE competition = ?)", competitionID))
+	}
 	innerSql, innerArgs, _ := innerQuery.GroupBy("score_sheets.id").ToSql()
 	ssSql, _, _ := s.PSQL.
 		Select(
@@ -835,6 +1053,7 @@ func (s *CockroachStore) FetchScoreSheetSummary(ctx context.Context, opts *Fetch
 			"divisions.name as division",
 			"score_sheet_templates.name as template",
 			"score_sheets.round as round",
+			"score_sheets.status as status",
 			"score_sheet_templates.type as type",
 			"t1.total as total",
 			"users.id as author_id",
@@ -859,6 +1078,7 @@ func (s *CockroachStore) FetchScoreSheetSummary(ctx context.Context, opts *Fetch
 		Template        string          `db:"template"`
 		Type            string          `db:"type"`
 		Round           int             `db:"round"`
+		Status          string          `db:"status"`
 		Total           decimal.Decimal `db:"total"`
 		Author          string          `db:"author"`
 		AuthorID        string          `db:"author_id"`
@@ -878,6 +1098,7 @@ func (s *CockroachStore) FetchScoreSheetSummary(ctx context.Context, opts *Fetch
 		scoreSheet := &rcjpb.ScoreSheet{
 			Id:         entry.ID,
 			Round:      int32(entry.Round),
+			Status:     scoreSheetStatus(entry.Status),
 			DivisionId: entry.DivisionID,
 			Total:      fl,
 			Author: &rcjpb.User{
@@ -910,9 +1131,10 @@ func (s *CockroachStore) FetchTeam(ctx context.Context, id string, txx *sqlx.Tx)
 		"teams.institution as institution_id",
 		"institutions.name as institution",
 		"teams.division as division_id",
+		"teams.version as version",
 	).From("teams").
 		Join("institutions ON teams.institution = institutions.id").
-		Where(sq.Eq{"teams.id": id}).ToSql()
+		Where(sq.Eq{"teams.id": id, "teams.deleted_at": nil}).ToSql()
 	memSql, memArgs, _ := s.PSQL.Select("id", "name", "gender").
 		From("team_members").Where(sq.Eq{"team": id}).ToSql()
 	type dbTeam struct {
@@ -921,6 +1143,7 @@ func (s *CockroachStore) FetchTeam(ctx context.Context, id string, txx *sqlx.Tx)
 		InstitutionID string `db:"institution_id" json:"institution_id"`
 		Institution   string `db:"institution" json:"institution"`
 		DivisionID    string `db:"division_id" json:"division_id"`
+		Version       int32  `db:"version"`
 	}
 	dbObj := dbTeam{}
 	type dbMember struct {
@@ -929,26 +1152,23 @@ func (s *CockroachStore) FetchTeam(ctx context.Context, id string, txx *sqlx.Tx)
 		Gender string `db:"gender"`
 	}
 	dbMemberObj := []dbMember{}
-	group, _ := errgroup.WithContext(ctx)
-	group.Go(func() error {
-		var err error
-		if txx != nil {
-			err = txx.Get(&dbObj, sql, args...)
-		} else {
-			err = s.DB.Get(&dbObj, sql, args...)
-		}
-		return err
-	})
-	group.Go(func() error {
-		var err error
-		if txx != nil {
+	var err error
+	if txx != nil {
+		// Statements on a transaction cannot run concurrently
+		err = txx.Get(&dbObj, sql, args...)
+		if err == nil {
 			err = txx.Select(&dbMemberObj, memSql, memArgs...)
-		} else {
-			err = s.DB.Select(&dbMemberObj, memSql, memArgs...)
 		}
-		return err
-	})
-	err := group.Wait()
+	} else {
+		group, _ := errgroup.WithContext(ctx)
+		group.Go(func() error {
+			return s.DB.Get(&dbObj, sql, args...)
+		})
+		group.Go(func() error {
+			return s.DB.Select(&dbMemberObj, memSql, memArgs...)
+		})
+		err = group.Wait()
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -960,6 +1180,7 @@ func (s *CockroachStore) FetchTeam(ctx context.Context, id string, txx *sqlx.Tx)
 			Name: dbObj.Institution,
 		},
 		Division: dbObj.DivisionID,
+		Version:  dbObj.Version,
 		Members:  []*rcjpb.Member{},
 	}
 	for _, dbMember := range dbMemberObj {
@@ -978,12 +1199,24 @@ func (s *CockroachStore) FetchTeam(ctx context.Context, id string, txx *sqlx.Tx)
 	return team, nil
 }
 
-func (s *CockroachStore) FetchDivisions(ctx context.Context, txx *sqlx.Tx) ([]*rcjpb.Division, error) {
-	sql, args, _ := s.PSQL.Select("id", "name", "league").From("divisions").ToSql()
+type FetchDivisionsOptions struct {
+	// JudgeID restricts the results to divisions the judge is assigned to
+	JudgeID *string
+}
+
+func (s *CockroachStore) FetchDivisions(ctx context.Context, opts *FetchDivisionsOptions, txx *sqlx.Tx) ([]*rcjpb.Division, error) {
+	query := s.PSQL.Select("id", "name", "league", "competition").From("divisions").Where(sq.Eq{"deleted_at": nil})
+	if opts != nil {
+		if opts.JudgeID != nil {
+			query = query.Where(sq.Expr("id IN (SELECT division FROM judge_assignments WHERE judge = ?)", *opts.JudgeID))
+		}
+	}
+	sql, args, _ := competitionScope(ctx, query, "competition").ToSql()
 	type division struct {
-		ID     string `db:"id"`
-		Name   string `db:"name"`
-		League string `db:"league"`
+		ID          string `db:"id"`
+		Name        string `db:"name"`
+		League      string `db:"league"`
+		Competition string `db:"competition"`
 	}
 	var err error
 	divs := []division{}
@@ -998,9 +1231,10 @@ func (s *CockroachStore) FetchDivisions(ctx context.Context, txx *sqlx.Tx) ([]*r
 	protoDivs := []*rcjpb.Division{}
 	for _, entry := range divs {
 		protoDiv := &rcjpb.Division{
-			Id:     entry.ID,
-			Name:   entry.Name,
-			League: rcjpb.Division_ONSTAGE,
+			Id:            entry.ID,
+			Name:          entry.Name,
+			League:        rcjpb.Division_ONSTAGE,
+			CompetitionId: entry.Competition,
 		}
 		if entry.League == "Rescue" {
 			protoDiv.League = rcjpb.Division_RESCUE
@@ -1012,7 +1246,16 @@ func (s *CockroachStore) FetchDivisions(ctx context.Context, txx *sqlx.Tx) ([]*r
 	return protoDivs, nil
 }
 
-func (s *CockroachStore) innerCreateTeam(txx *sqlx.Tx, team *rcjpb.Team) (string, error) {
+func (s *CockroachStore) innerCreateTeam(ctx context.Context, txx *sqlx.Tx, team *rcjpb.Team) (string, error) {
+	replayedID, err := s.replayedID(ctx, txx, "teams", "created_by", "created_at")
+	if err != nil || replayedID != "" {
+		return replayedID, err
+	}
+	actor, _ := ctx.Value(auditContextKey{}).(auditActor)
+	var createdBy interface{}
+	if actor.userID != "" {
+		createdBy = actor.userID
+	}
 	institutionID := ""
 	if team.Institution.GetId() == "" {
 		instSql, instArgs, _ := s.PSQL.Insert("institutions").Columns("name").Values(team.Institution.GetName()).Suffix("RETURNING \"id\"").ToSql()
@@ -1027,9 +1270,18 @@ func (s *CockroachStore) innerCreateTeam(txx *sqlx.Tx, team *rcjpb.Team) (string
 	} else {
 		institutionID = team.Institution.GetId()
 	}
+	// Teams belong to the competition of their division
 	teamSql, teamArgs, _ := s.PSQL.Insert("teams").
-		Columns("name", "institution", "division", "import_id").
-		Values(team.GetName(), institutionID, team.GetDivision(), team.GetImportId()).
+		Columns("name", "institution", "division", "import_id", "competition", "created_by", "idempotency_key").
+		Values(
+			team.GetName(),
+			institutionID,
+			team.GetDivision(),
+			team.GetImportId(),
+			sq.Expr("(SELECT competition FROM divisions WHERE id = ?)", team.GetDivision()),
+			createdBy,
+			idempotencyKey(ctx),
+		).
 		Suffix("RETURNING \"id\"").ToSql()
 	teamRows, teamErr := txx.Query(teamSql, teamArgs...)
 	if teamErr != nil {
@@ -1050,11 +1302,19 @@ func (s *CockroachStore) innerCreateTeam(txx *sqlx.Tx, team *rcjpb.Team) (string
 		}
 		memberQuery = memberQuery.Values(member.GetName(), gender, teamID)
 	}
-	mSql, mArgs, _ := memberQuery.Suffix("RETURNING \"id\"").ToSql()
-	_, mErr := txx.Query(mSql, mArgs...)
+	mSql, mArgs, _ := memberQuery.ToSql()
+	_, mErr := txx.Exec(mSql, mArgs...)
 	if mErr != nil {
 		return "", mErr
 	}
+	created, err := s.FetchTeam(ctx, teamID, txx)
+	if err != nil {
+		return "", err
+	}
+	err = s.recordAudit(ctx, txx, "Team", teamID, nil, created)
+	if err != nil {
+		return "", err
+	}
 	return teamID, nil
 }
 
@@ -1067,7 +1327,7 @@ func (s *CockroachStore) CreateTeam(ctx context.Context, txx *sqlx.Tx, handler f
 	teamID := ""
 	if txx == nil {
 		err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
-			createdTeamID, teamErr := s.innerCreateTeam(tx, team)
+			createdTeamID, teamErr := s.innerCreateTeam(ctx, tx, team)
 			if teamErr != nil {
 				return teamErr
 			}
@@ -1078,7 +1338,7 @@ func (s *CockroachStore) CreateTeam(ctx context.Context, txx *sqlx.Tx, handler f
 			return nil, err
 		}
 	} else {
-		createdTeamID, err := s.innerCreateTeam(txx, team)
+		createdTeamID, err := s.innerCreateTeam(ctx, txx, team)
 		if err != nil {
 			return nil, err
 		}
@@ -1088,7 +1348,7 @@ func (s *CockroachStore) CreateTeam(ctx context.Context, txx *sqlx.Tx, handler f
 }
 
 func (s *CockroachStore) FetchInstitutions(ctx context.Context, search string) ([]*rcjpb.Institution, error) {
-	sql, args, _ := s.PSQL.Select("id", "name").From("institutions").ToSql()
+	sql, args, _ := s.PSQL.Select("id", "name").From("institutions").Where(sq.Eq{"deleted_at": nil}).ToSql()
 	type institution struct {
 		ID   string `db:"id"`
 		Name string `db:"name"`
@@ -1109,17 +1369,25 @@ func (s *CockroachStore) FetchInstitutions(ctx context.Context, search string) (
 	return protoInsts, nil
 }
 
+// UpdateTeam applies the handler's changes to the team. The handler must leave Version as the
+// version the caller read; if the team has changed since, a StaleError holding the current copy
+// is returned.
 func (s *CockroachStore) UpdateTeam(ctx context.Context, teamID string, handler func(*rcjpb.Team) error) (*rcjpb.Team, error) {
 	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
 		team, err := s.FetchTeam(ctx, teamID, tx)
 		if err != nil {
 			return err
 		}
+		original := proto.Clone(team)
 		originalMembers := team.GetMembers()
 		handlerErr := handler(team)
 		if handlerErr != nil {
 			return handlerErr
 		}
+		err = checkVersion("Team", team.GetVersion(), original.(*rcjpb.Team).GetVersion(), original)
+		if err != nil {
+			return err
+		}
 		institutionID := ""
 		if team.Institution.GetId() == "" {
 			instSql, instArgs, _ := s.PSQL.Insert("institutions").Columns("name").Values(team.Institution.GetName()).Suffix("RETURNING \"id\"").ToSql()
@@ -1138,6 +1406,7 @@ func (s *CockroachStore) UpdateTeam(ctx context.Context, teamID string, handler
 			"name":        team.Name,
 			"institution": institutionID,
 			"division":    team.Division,
+			"version":     sq.Expr("version + 1"),
 		}).Where(sq.Eq{"id": teamID}).ToSql()
 		_, teamErr := tx.Exec(teamSql, teamArgs...)
 		if teamErr != nil {
@@ -1188,7 +1457,11 @@ func (s *CockroachStore) UpdateTeam(ctx context.Context, teamID string, handler
 				}
 			}
 		}
-		return nil
+		updated, err := s.FetchTeam(ctx, teamID, tx)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "Team", teamID, original, updated)
 	})
 	if err != nil {
 		return nil, err
@@ -1204,6 +1477,10 @@ func (s *CockroachStore) CreateDivision(ctx context.Context, handler func(*rcjpb
 		if handlerError != nil {
 			return handlerError
 		}
+		division.CompetitionId = CompetitionFromContext(ctx)
+		if division.CompetitionId == "" {
+			return errors.New("Error creating division: No competition selected")
+		}
 		leagueStr := "On Stage"
 		if division.GetLeague() == rcjpb.Division_RESCUE {
 			leagueStr = "Rescue"
@@ -1217,6 +1494,7 @@ func (s *CockroachStore) CreateDivision(ctx context.Context, handler func(*rcjpb
 			"final_rounds",
 			"interview_template",
 			"performance_template",
+			"competition",
 		).Values(
 			division.GetName(),
 			leagueStr,
@@ -1224,21 +1502,85 @@ func (s *CockroachStore) CreateDivision(ctx context.Context, handler func(*rcjpb
 			division.GetFinalRounds(),
 			division.GetInterviewTemplateId(),
 			division.GetPerformanceTemplateId(),
+			division.GetCompetitionId(),
 		).Suffix("RETURNING \"id\"").ToSql()
 		rows, err := tx.Query(sql, args...)
 		if err != nil {
 			return err
 		}
-		defer rows.Close()
 		for rows.Next() {
 			rows.Scan(&divisionID)
 		}
-		return nil
+		rows.Close()
+		division.Id = divisionID
+		return s.recordAudit(ctx, tx, "Division", divisionID, nil, division)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.FetchDivision(divisionID, nil)
+}
+
+// UpdateDivision applies the handler's changes to the division. The total number of rounds
+// cannot drop below the highest round that has already been scored.
+func (s *CockroachStore) UpdateDivision(ctx context.Context, divisionID string, handler func(*rcjpb.Division) error) (*rcjpb.Division, error) {
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		division, err := s.FetchDivision(divisionID, tx)
+		if err != nil {
+			return err
+		}
+		original := proto.Clone(division)
+		handlerError := handler(division)
+		if handlerError != nil {
+			return handlerError
+		}
+		roundSql, roundArgs, _ := s.PSQL.Select("COALESCE(MAX(round), 0)").From("score_sheets").
+			Where(sq.Eq{"division": divisionID, "deleted_at": nil}).ToSql()
+		var scoredRound int32
+		err = tx.Get(&scoredRound, roundSql, roundArgs...)
+		if err != nil {
+			return err
+		}
+		if division.GetCompetitionRounds()+division.GetFinalRounds() < scoredRound {
+			return &ConflictError{
+				Message: fmt.Sprintf("Round %d of division %s has already been scored", scoredRound, divisionID),
+			}
+		}
+		leagueStr := "On Stage"
+		if division.GetLeague() == rcjpb.Division_RESCUE {
+			leagueStr = "Rescue"
+		} else if division.GetLeague() == rcjpb.Division_SOCCER {
+			leagueStr = "Soccer"
+		}
+		var interviewTemplate, performanceTemplate interface{}
+		if division.GetInterviewTemplateId() != "" {
+			interviewTemplate = division.GetInterviewTemplateId()
+		}
+		if division.GetPerformanceTemplateId() != "" {
+			performanceTemplate = division.GetPerformanceTemplateId()
+		}
+		sql, args, _ := s.PSQL.Update("divisions").SetMap(map[string]interface{}{
+			"name":                 division.GetName(),
+			"league":               leagueStr,
+			"competition_rounds":   division.GetCompetitionRounds(),
+			"final_rounds":         division.GetFinalRounds(),
+			"interview_template":   interviewTemplate,
+			"performance_template": performanceTemplate,
+		}).Where(sq.Eq{"id": divisionID}).ToSql()
+		_, err = tx.Exec(sql, args...)
+		if err != nil {
+			return err
+		}
+		updated, err := s.FetchDivision(divisionID, tx)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "Division", divisionID, original, updated)
 	})
 	if err != nil {
 		return nil, err
 	}
-	return s.FetchDivision(divisionID)
+	return s.FetchDivision(divisionID, nil)
 }
 
 func (s *CockroachStore) CreateScoreSheetTemplate(ctx context.Context, handler func(*rcjpb.ScoreSheetTemplate) error) (*rcjpb.ScoreSheetTemplate, error) {
@@ -1249,48 +1591,37 @@ func (s *CockroachStore) CreateScoreSheetTemplate(ctx context.Context, handler f
 		if handlerError != nil {
 			return handlerError
 		}
+		template.CompetitionId = CompetitionFromContext(ctx)
+		if template.CompetitionId == "" {
+			return errors.New("Error creating template: No competition selected")
+		}
 		typeStr := "Interview"
 		if template.Type == rcjpb.ScoreSheetTemplate_PERFORMANCE {
 			typeStr = "Performance"
 		}
-		arrayItems := make([]string, len(template.GetTimings()))
-		for idx, str := range template.GetTimings() {
-			arrayItems[idx] = fmt.Sprintf("\"%s\"", str)
-		}
-		arrayStr := strings.Join(arrayItems, ",")
 		tempSql, tempArgs, _ := s.PSQL.Insert("score_sheet_templates").Columns(
 			"name",
 			"type",
 			"timings",
+			"competition",
 		).Values(
 			template.GetName(),
 			typeStr,
-			fmt.Sprintf("{%s}", arrayStr),
+			pq.StringArray(template.GetTimings()),
+			template.GetCompetitionId(),
 		).Suffix("RETURNING \"id\"").ToSql()
 		tempRows, tempErr := tx.Query(tempSql, tempArgs...)
 		if tempErr != nil {
 			return tempErr
 		}
-		defer tempRows.Close()
 		for tempRows.Next() {
 			tempRows.Scan(&templateID)
 		}
-		sectionQuery := s.PSQL.Insert("score_sheet_template_sections").Columns(
-			"title",
-			"score_sheet_template",
-			"description",
-			"max_value",
-			"multiplier",
-			"display_order",
-		)
-		for _, section := range template.GetSections() {
+		tempRows.Close()
+		sectionQuery := s.PSQL.Insert("score_sheet_template_sections").Columns(templateSectionColumns...)
+		for idx, section := range template.GetSections() {
 			sectionQuery = sectionQuery.Values(
-				section.GetTitle(),
-				templateID,
-				section.GetDescription(),
-				section.GetMaxValue(),
-				section.GetMaxValue(),
-				section.GetDisplayOrder(),
+				templateSectionValues(section, templateID, displayOrder(template.GetSections(), idx), 1, nil)...,
 			)
 		}
 		sectionSql, sectionArgs, _ := sectionQuery.ToSql()
@@ -1298,14 +1629,15 @@ func (s *CockroachStore) CreateScoreSheetTemplate(ctx context.Context, handler f
 		if sectionErr != nil {
 			return sectionErr
 		}
-		return nil
+		template.Id = templateID
+		return s.recordAudit(ctx, tx, "ScoreSheetTemplate", templateID, nil, template)
 	})
 	if err != nil {
 		return nil, err
 	}
 	templates, err := s.FetchScoreSheetTemplates(ctx, &FetchScoreSheetTemplateOptions{
 		IDs: []string{templateID},
-	})
+	}, nil)
 	if err != nil {
 		return nil, err
 	}
@@ -1315,32 +1647,158 @@ func (s *CockroachStore) CreateScoreSheetTemplate(ctx context.Context, handler f
 	return templates[0], nil
 }
 
+// displayOrder returns the display order of the section at idx. Sections are ordered as listed
+// unless the caller gave them explicit display orders.
+func displayOrder(sections []*rcjpb.ScoreSheetTemplateSection, idx int) int32 {
+	for _, section := range sections {
+		if section.GetDisplayOrder() != 0 {
+			return sections[idx].GetDisplayOrder()
+		}
+	}
+	return int32(idx)
+}
+
+// UpdateScoreSheetTemplate applies the handler's changes to the template. Sections are never
+// edited in place: any change to them creates a new template version holding the handler's
+// sections in the order listed, so sheets scored against earlier versions keep their totals.
+// Sections without an ID are added and sections missing from the list are dropped.
+func (s *CockroachStore) UpdateScoreSheetTemplate(ctx context.Context, templateID string, handler func(*rcjpb.ScoreSheetTemplate) error) (*rcjpb.ScoreSheetTemplate, error) {
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		templates, err := s.FetchScoreSheetTemplates(ctx, &FetchScoreSheetTemplateOptions{
+			IDs: []string{templateID},
+		}, tx)
+		if err != nil {
+			return err
+		}
+		if len(templates) != 1 {
+			return ErrNotFound
+		}
+		template := templates[0]
+		original := proto.Clone(template)
+		originalSections := template.GetSections()
+		handlerError := handler(template)
+		if handlerError != nil {
+			return handlerError
+		}
+		typeStr := "Interview"
+		if template.Type == rcjpb.ScoreSheetTemplate_PERFORMANCE {
+			typeStr = "Performance"
+		}
+		tempSql, tempArgs, _ := s.PSQL.Update("score_sheet_templates").SetMap(map[string]interface{}{
+			"name":    template.GetName(),
+			"type":    typeStr,
+			"timings": pq.StringArray(template.GetTimings()),
+		}).Where(sq.Eq{"id": templateID}).ToSql()
+		_, err = tx.Exec(tempSql, tempArgs...)
+		if err != nil {
+			return err
+		}
+		if sectionsChanged(originalSections, template.GetSections()) {
+			err = s.createTemplateVersion(tx, template, originalSections)
+			if err != nil {
+				return err
+			}
+		}
+		updated, err := s.FetchScoreSheetTemplates(ctx, &FetchScoreSheetTemplateOptions{
+			IDs: []string{templateID},
+		}, tx)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "ScoreSheetTemplate", templateID, original, updated[0])
+	})
+	if err != nil {
+		return nil, err
+	}
+	templates, err := s.FetchScoreSheetTemplates(ctx, &FetchScoreSheetTemplateOptions{
+		IDs: []string{templateID},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(templates) != 1 {
+		return nil, ErrNotFound
+	}
+	return templates[0], nil
+}
+
 func (s *CockroachStore) FetchUsers(ctx context.Context) ([]*rcjpb.User, error) {
-	sql, args, _ := s.PSQL.Select("id", "name", "username", "is_admin").From("users").ToSql()
+	sql, args, _ := s.PSQL.Select("id", "name", "username", "is_admin", "must_change_password").
+		From("users").Where(sq.Eq{"deleted_at": nil}).ToSql()
 	type dbUser struct {
-		ID       string `db:"id"`
-		Name     string `db:"name"`
-		Username string `db:"username"`
-		IsAdmin  bool   `db:"is_admin"`
+		ID                 string `db:"id"`
+		Name               string `db:"name"`
+		Username           string `db:"username"`
+		IsAdmin            bool   `db:"is_admin"`
+		MustChangePassword bool   `db:"must_change_password"`
 	}
 	dbUsers := []dbUser{}
 	err := s.DB.Select(&dbUsers, sql, args...)
 	if err != nil {
 		return nil, err
 	}
+	userIDs := make([]string, len(dbUsers))
+	for idx, entry := range dbUsers {
+		userIDs[idx] = entry.ID
+	}
+	roles, err := s.fetchUserRoles(userIDs, nil)
+	if err != nil {
+		return nil, err
+	}
 	protoUsers := []*rcjpb.User{}
 	for _, entry := range dbUsers {
 		protoUser := &rcjpb.User{
-			Id:       entry.ID,
-			Name:     entry.Name,
-			Username: entry.Username,
-			IsAdmin:  entry.IsAdmin,
-		}
+			Id:                 entry.ID,
+			Name:               entry.Name,
+			Username:           entry.Username,
+			IsAdmin:            entry.IsAdmin,
+			Roles:              roles[entry.ID],
+			MustChangePassword: entry.MustChangePassword,
+		}
+		protoUser.Roles = userRoles(protoUser)
+		protoUser.IsAdmin = hasRole(protoUser.Roles, rcjpb.User_ADMIN)
 		protoUsers = append(protoUsers, protoUser)
 	}
 	return protoUsers, nil
 }
 
+// insertUser creates the user row and its roles, returning the new ID. Password hashing is left
+// to the caller so it can happen outside of the transaction.
+func (s *CockroachStore) insertUser(ctx context.Context, tx *sqlx.Tx, user *rcjpb.User, hash string) (string, error) {
+	roles := userRoles(user)
+	sql, args, _ := s.PSQL.Insert("users").Columns(
+		"name",
+		"username",
+		"hashed_password",
+		"is_admin",
+		"must_change_password",
+	).Values(
+		user.GetName(),
+		user.GetUsername(),
+		hash,
+		hasRole(roles, rcjpb.User_ADMIN),
+		user.GetMustChangePassword(),
+	).Suffix("RETURNING \"id\"").ToSql()
+	userRows, err := tx.Query(sql, args...)
+	if err != nil {
+		return "", err
+	}
+	var userID string
+	for userRows.Next() {
+		userRows.Scan(&userID)
+	}
+	userRows.Close()
+	err = s.setUserRoles(tx, userID, roles)
+	if err != nil {
+		return "", err
+	}
+	created, err := s.FetchUser(userID, tx)
+	if err != nil {
+		return "", err
+	}
+	return userID, s.recordAudit(ctx, tx, "User", userID, nil, created)
+}
+
 func (s *CockroachStore) CreateUser(ctx context.Context, handler func(*rcjpb.User) error) (*rcjpb.User, error) {
 	var userID string
 	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
@@ -1353,26 +1811,8 @@ func (s *CockroachStore) CreateUser(ctx context.Context, handler func(*rcjpb.Use
 		if err != nil {
 			return err
 		}
-		sql, args, _ := s.PSQL.Insert("users").Columns(
-			"name",
-			"username",
-			"hashed_password",
-			"is_admin",
-		).Values(
-			user.GetName(),
-			user.GetUsername(),
-			string(hash),
-			user.GetIsAdmin(),
-		).Suffix("RETURNING \"id\"").ToSql()
-		userRows, err := tx.Query(sql, args...)
-		if err != nil {
-			return err
-		}
-		defer userRows.Close()
-		for userRows.Next() {
-			userRows.Scan(&userID)
-		}
-		return nil
+		userID, err = s.insertUser(ctx, tx, user, string(hash))
+		return err
 	})
 	if err != nil {
 		return nil, err
@@ -1386,25 +1826,31 @@ func (s *CockroachStore) UpdateUser(ctx context.Context, userID string, handler
 		if err != nil {
 			return err
 		}
+		original := proto.Clone(user)
 		handlerError := handler(user)
 		if handlerError != nil {
 			return handlerError
 		}
+		roles := userRoles(user)
 		updateMap := map[string]interface{}{
 			"name":     user.GetName(),
 			"username": user.GetUsername(),
-			"is_admin": user.GetIsAdmin(),
-		}
-		if len(user.GetPassword()) > 0 {
-			hash, err := scrypt.GenerateFromPassword([]byte(user.Password), scrypt.DefaultParams)
-			if err != nil {
-				return err
-			}
-			updateMap["hashed_password"] = string(hash)
+			"is_admin": hasRole(roles, rcjpb.User_ADMIN),
 		}
 		sql, args, _ := s.PSQL.Update("users").SetMap(updateMap).Where(sq.Eq{"id": userID}).ToSql()
 		_, err = tx.Exec(sql, args...)
-		return err
+		if err != nil {
+			return err
+		}
+		err = s.setUserRoles(tx, userID, roles)
+		if err != nil {
+			return err
+		}
+		updated, err := s.FetchUser(userID, tx)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "User", userID, original, updated)
 	})
 	if err != nil {
 		return nil, err
@@ -1413,13 +1859,14 @@ func (s *CockroachStore) UpdateUser(ctx context.Context, userID string, handler
 }
 
 func (s *CockroachStore) FetchCheckins(ctx context.Context) ([]*rcjpb.Checkin, error) {
-	sql, args, _ := s.PSQL.Select(
+	query := s.PSQL.Select(
 		"id",
 		"team",
 		"agent",
 		"comments",
 		"in_time",
-	).From("team_checkins").ToSql()
+	).From("team_checkins").Where(sq.Eq{"deleted_at": nil})
+	sql, args, _ := competitionScope(ctx, query, "competition").ToSql()
 	type checkinEntry struct {
 		ID       string     `db:"id"`
 		Team     string     `db:"team"`
@@ -1428,7 +1875,7 @@ func (s *CockroachStore) FetchCheckins(ctx context.Context) ([]*rcjpb.Checkin, e
 		InTime   *time.Time `db:"in_time"`
 	}
 	entries := []checkinEntry{}
-	err := s.DB.Select(&entries, sql, args...)
+	err := s.DB.SelectContext(ctx, &entries, sql, args...)
 	if err != nil {
 		return nil, err
 	}
@@ -1455,14 +1902,14 @@ func (s *CockroachStore) FetchCheckins(ctx context.Context) ([]*rcjpb.Checkin, e
 	return results, nil
 }
 
-func (s *CockroachStore) FetchCheckin(ctx context.Context, id string) (*rcjpb.Checkin, error) {
+func (s *CockroachStore) FetchCheckin(ctx context.Context, id string, txx *sqlx.Tx) (*rcjpb.Checkin, error) {
 	sql, args, _ := s.PSQL.Select(
 		"id",
 		"team",
 		"agent",
 		"comments",
 		"in_time",
-	).From("team_checkins").Where(sq.Eq{"id": id}).ToSql()
+	).From("team_checkins").Where(sq.Eq{"id": id, "deleted_at": nil}).ToSql()
 	type checkinEntry struct {
 		ID       string     `db:"id"`
 		Team     string     `db:"team"`
@@ -1471,7 +1918,12 @@ func (s *CockroachStore) FetchCheckin(ctx context.Context, id string) (*rcjpb.Ch
 		InTime   *time.Time `db:"in_time"`
 	}
 	entry := checkinEntry{}
-	err := s.DB.Get(&entry, sql, args...)
+	var err error
+	if txx != nil {
+		err = txx.Get(&entry, sql, args...)
+	} else {
+		err = s.DB.Get(&entry, sql, args...)
+	}
 	if err != nil {
 		return nil, err
 	}
@@ -1500,19 +1952,42 @@ func (s *CockroachStore) CreateCheckin(ctx context.Context, handler func(*rcjpb.
 	if handlerErr != nil {
 		return nil, handlerErr
 	}
-	sql, args, _ := s.PSQL.Insert("team_checkins").Columns(
-		"team",
-		"agent",
-		"comments",
-	).Values(checkin.GetTeam().GetId(), checkin.GetAgent().GetId(), checkin.GetComments()).Suffix("RETURNING \"id\"").ToSql()
 	var checkinID string
-	checkinRows, err := s.DB.Query(sql, args...)
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		replayedID, err := s.replayedID(ctx, tx, "team_checkins", "agent", "in_time")
+		if err != nil {
+			return err
+		}
+		if replayedID != "" {
+			checkinID = replayedID
+			return nil
+		}
+		sql, args, _ := s.PSQL.Insert("team_checkins").Columns(
+			"team",
+			"agent",
+			"comments",
+			"competition",
+			"idempotency_key",
+		).Values(
+			checkin.GetTeam().GetId(),
+			checkin.GetAgent().GetId(),
+			checkin.GetComments(),
+			sq.Expr("(SELECT competition FROM teams WHERE id = ?)", checkin.GetTeam().GetId()),
+			idempotencyKey(ctx),
+		).Suffix("RETURNING \"id\"").ToSql()
+		checkinRows, err := tx.Query(sql, args...)
+		if err != nil {
+			return err
+		}
+		for checkinRows.Next() {
+			checkinRows.Scan(&checkinID)
+		}
+		checkinRows.Close()
+		checkin.Id = checkinID
+		return s.recordAudit(ctx, tx, "Checkin", checkinID, nil, checkin)
+	})
 	if err != nil {
 		return nil, err
 	}
-	for checkinRows.Next() {
-		checkinRows.Scan(&checkinID)
-	}
-	checkinRows.Close()
-	return s.FetchCheckin(ctx, checkinID)
+	return s.FetchCheckin(ctx, checkinID, nil)
 }
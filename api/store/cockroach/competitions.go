@@ -0,0 +1,382 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/cockroach-go/crdb"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/jmoiron/sqlx"
+	"github.com/lib/pq"
+	"time"
+)
+
+type competitionContextKey struct{}
+
+// WithCompetition scopes fetches and creations made with the returned context to the competition.
+// Without a competition, list fetches return results from every competition.
+func WithCompetition(ctx context.Context, competitionID string) context.Context {
+	return context.WithValue(ctx, competitionContextKey{}, competitionID)
+}
+
+// CompetitionFromContext returns the competition set by WithCompetition, or an empty string.
+func CompetitionFromContext(ctx context.Context) string {
+	competitionID, _ := ctx.Value(competitionContextKey{}).(string)
+	return competitionID
+}
+
+// competitionScope restricts a query to the active competition using the given column.
+func competitionScope(ctx context.Context, query sq.SelectBuilder, column string) sq.SelectBuilder {
+	if competitionID := CompetitionFromContext(ctx); competitionID != "" {
+		return query.Where(sq.Eq{column: competitionID})
+	}
+	return query
+}
+
+type FetchCompetitionsOptions struct {
+	IDs []string
+}
+
+// FetchCompetitions returns competitions, most recently created first.
+func (s *CockroachStore) FetchCompetitions(ctx context.Context, opts *FetchCompetitionsOptions, txx *sqlx.Tx) ([]*rcjpb.Competition, error) {
+	query := s.PSQL.Select("id", "name", "created_at").From("competitions")
+	if opts != nil {
+		if len(opts.IDs) > 0 {
+			query = query.Where(sq.Eq{"id": opts.IDs})
+		}
+	}
+	sql, args, _ := query.OrderBy("created_at DESC").ToSql()
+	type dbCompetition struct {
+		ID        string    `db:"id"`
+		Name      string    `db:"name"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	dbCompetitions := []dbCompetition{}
+	var err error
+	if txx != nil {
+		err = txx.Select(&dbCompetitions, sql, args...)
+	} else {
+		err = s.DB.SelectContext(ctx, &dbCompetitions, sql, args...)
+	}
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching competitions: %+v", err))
+	}
+	results := make([]*rcjpb.Competition, len(dbCompetitions))
+	for idx, entry := range dbCompetitions {
+		results[idx] = &rcjpb.Competition{
+			Id:   entry.ID,
+			Name: entry.Name,
+			CreatedAt: &tspb.Timestamp{
+				Seconds: entry.CreatedAt.Unix(),
+				Nanos:   int32(entry.CreatedAt.Nanosecond()),
+			},
+		}
+	}
+	return results, nil
+}
+
+// FetchCompetition returns the competition with the given ID, or nil if there is none.
+func (s *CockroachStore) FetchCompetition(ctx context.Context, id string, txx *sqlx.Tx) (*rcjpb.Competition, error) {
+	competitions, err := s.FetchCompetitions(ctx, &FetchCompetitionsOptions{
+		IDs: []string{id},
+	}, txx)
+	if err != nil {
+		return nil, err
+	}
+	if len(competitions) == 0 {
+		return nil, nil
+	}
+	return competitions[0], nil
+}
+
+// LatestCompetitionID returns the most recently created competition, or an empty string if there are none.
+func (s *CockroachStore) LatestCompetitionID(ctx context.Context) (string, error) {
+	sql, args, _ := s.PSQL.Select("id").From("competitions").OrderBy("created_at DESC").Limit(1).ToSql()
+	ids := []string{}
+	err := s.DB.SelectContext(ctx, &ids, sql, args...)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Error fetching competitions: %+v", err))
+	}
+	if len(ids) == 0 {
+		return "", nil
+	}
+	return ids[0], nil
+}
+
+func (s *CockroachStore) CreateCompetition(ctx context.Context, handler func(*rcjpb.Competition) error) (*rcjpb.Competition, error) {
+	var competitionID string
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		competition := &rcjpb.Competition{}
+		handlerError := handler(competition)
+		if handlerError != nil {
+			return handlerError
+		}
+		sql, args, _ := s.PSQL.Insert("competitions").Columns("name").
+			Values(competition.GetName()).Suffix("RETURNING \"id\"").ToSql()
+		rows, err := tx.Query(sql, args...)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			rows.Scan(&competitionID)
+		}
+		rows.Close()
+		created, err := s.FetchCompetition(ctx, competitionID, tx)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "Competition", competitionID, nil, created)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.FetchCompetition(ctx, competitionID, nil)
+}
+
+// FetchSessionCompetition returns the competition selected for the session, or an empty string if none was selected.
+func (s *CockroachStore) FetchSessionCompetition(ctx context.Context, token string) (string, error) {
+	sql, args, _ := s.PSQL.Select("competition").From("sessions").
+		Where(sq.Eq{"token_hash": hashSessionToken(token)}).
+		Where(sq.NotEq{"competition": nil}).ToSql()
+	ids := []string{}
+	err := s.DB.SelectContext(ctx, &ids, sql, args...)
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Error fetching session competition: %+v", err))
+	}
+	if len(ids) == 0 {
+		return "", nil
+	}
+	return ids[0], nil
+}
+
+// SetSessionCompetition selects the competition that subsequent requests in the session are scoped to.
+func (s *CockroachStore) SetSessionCompetition(ctx context.Context, token, competitionID string) error {
+	sql, args, _ := s.PSQL.Update("sessions").
+		Set("competition", competitionID).
+		Where(sq.Eq{"token_hash": hashSessionToken(token), "revoked_at": nil}).ToSql()
+	_, err := s.DB.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error selecting competition: %+v", err))
+	}
+	return nil
+}
+
+type CloneCompetitionOptions struct {
+	// Name is the name of the competition created to hold the copy
+	Name string
+	// IncludeJudgeAssignments also copies which judges are assigned to each division
+	IncludeJudgeAssignments bool
+}
+
+// CloneCompetitionSetup creates a new competition holding copies of the source competition's
+// divisions and score sheet templates, including template sections. Teams, check-ins and score
+// sheets are never copied. The new competition is returned.
+func (s *CockroachStore) CloneCompetitionSetup(ctx context.Context, sourceID string, opts *CloneCompetitionOptions) (*rcjpb.Competition, error) {
+	var competitionID string
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		competitionID = ""
+		compSql, compArgs, _ := s.PSQL.Insert("competitions").Columns("name").
+			Values(opts.Name).Suffix("RETURNING \"id\"").ToSql()
+		err := tx.Get(&competitionID, compSql, compArgs...)
+		if err != nil {
+			return err
+		}
+		created, err := s.FetchCompetition(ctx, competitionID, tx)
+		if err != nil {
+			return err
+		}
+		err = s.recordAudit(ctx, tx, "Competition", competitionID, nil, created)
+		if err != nil {
+			return err
+		}
+		// Templates referenced by the source divisions are copied even if they were shared. Deleted
+		// templates, divisions and assignments are left behind
+		templateSql, templateArgs, _ := s.PSQL.Select("id", "name", "type", "timings", "version").
+			From("score_sheet_templates").
+			Where(sq.Eq{"deleted_at": nil}).
+			Where(sq.Or{
+				sq.Eq{"competition": sourceID},
+				sq.Expr("id IN (SELECT interview_template FROM divisions WHERE competition = ? AND deleted_at IS NULL)", sourceID),
+				sq.Expr("id IN (SELECT performance_template FROM divisions WHERE competition = ? AND deleted_at IS NULL)", sourceID),
+			}).ToSql()
+		type dbTemplate struct {
+			ID      string         `db:"id"`
+			Name    string         `db:"name"`
+			Type    string         `db:"type"`
+			Timings pq.StringArray `db:"timings"`
+			Version int            `db:"version"`
+		}
+		templates := []dbTemplate{}
+		err = tx.Select(&templates, templateSql, templateArgs...)
+		if err != nil {
+			return err
+		}
+		templateIDs := map[string]string{}
+		for _, template := range templates {
+			var newTemplateID string
+			insertSql, insertArgs, _ := s.PSQL.Insert("score_sheet_templates").
+				Columns("name", "type", "timings", "competition").
+				Values(template.Name, template.Type, template.Timings, competitionID).
+				Suffix("RETURNING \"id\"").ToSql()
+			err = tx.Get(&newTemplateID, insertSql, insertArgs...)
+			if err != nil {
+				return err
+			}
+			templateIDs[template.ID] = newTemplateID
+			// Only the current version is copied and becomes version 1 of the new template. The new
+			// template ID is bound to the first placeholder
+			sectionSql, sectionArgs, _ := s.PSQL.Select(
+				"title",
+				"?::UUID",
+				"description",
+				"max_value",
+				"multiplier",
+				"display_order",
+				"kind",
+				"step",
+				"points",
+				"levels",
+			).From("score_sheet_template_sections").
+				Where(sq.Eq{"score_sheet_template": template.ID, "version": template.Version}).ToSql()
+			_, err = tx.Exec(fmt.Sprintf(
+				"INSERT INTO score_sheet_template_sections (title, score_sheet_template, description, max_value, multiplier, display_order, kind, step, points, levels) %s",
+				sectionSql,
+			), append([]interface{}{newTemplateID}, sectionArgs...)...)
+			if err != nil {
+				return err
+			}
+			err = s.recordAudit(ctx, tx, "ScoreSheetTemplate", newTemplateID, nil, &rcjpb.ScoreSheetTemplate{
+				Id:            newTemplateID,
+				Name:          template.Name,
+				CompetitionId: competitionID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		divisionSql, divisionArgs, _ := s.PSQL.Select(
+			"id",
+			"name",
+			"league",
+			"competition_rounds",
+			"final_rounds",
+			"interview_template",
+			"performance_template",
+		).From("divisions").Where(sq.Eq{"competition": sourceID, "deleted_at": nil}).ToSql()
+		type dbDivision struct {
+			ID                  string  `db:"id"`
+			Name                string  `db:"name"`
+			League              string  `db:"league"`
+			CompetitionRounds   int     `db:"competition_rounds"`
+			FinalRounds         int     `db:"final_rounds"`
+			InterviewTemplate   *string `db:"interview_template"`
+			PerformanceTemplate *string `db:"performance_template"`
+		}
+		divisions := []dbDivision{}
+		err = tx.Select(&divisions, divisionSql, divisionArgs...)
+		if err != nil {
+			return err
+		}
+		mapTemplate := func(id *string) interface{} {
+			if id == nil {
+				return nil
+			}
+			if newID, ok := templateIDs[*id]; ok {
+				return newID
+			}
+			return nil
+		}
+		divisionIDs := map[string]string{}
+		for _, division := range divisions {
+			var newDivisionID string
+			insertSql, insertArgs, _ := s.PSQL.Insert("divisions").Columns(
+				"name",
+				"league",
+				"competition_rounds",
+				"final_rounds",
+				"interview_template",
+				"performance_template",
+				"competition",
+			).Values(
+				division.Name,
+				division.League,
+				division.CompetitionRounds,
+				division.FinalRounds,
+				mapTemplate(division.InterviewTemplate),
+				mapTemplate(division.PerformanceTemplate),
+				competitionID,
+			).Suffix("RETURNING \"id\"").ToSql()
+			err = tx.Get(&newDivisionID, insertSql, insertArgs...)
+			if err != nil {
+				return err
+			}
+			divisionIDs[division.ID] = newDivisionID
+			err = s.recordAudit(ctx, tx, "Division", newDivisionID, nil, &rcjpb.Division{
+				Id:                newDivisionID,
+				Name:              division.Name,
+				CompetitionRounds: int32(division.CompetitionRounds),
+				FinalRounds:       int32(division.FinalRounds),
+				CompetitionId:     competitionID,
+			})
+			if err != nil {
+				return err
+			}
+		}
+		if !opts.IncludeJudgeAssignments {
+			return nil
+		}
+		assignmentSql, assignmentArgs, _ := s.PSQL.Select("judge", "division", "round").
+			From("judge_assignments").
+			Where(sq.Eq{"competition": sourceID}).
+			Where("judge IN (SELECT id FROM users WHERE deleted_at IS NULL)").
+			Where("division IN (SELECT id FROM divisions WHERE deleted_at IS NULL)").ToSql()
+		type dbAssignment struct {
+			Judge    string `db:"judge"`
+			Division string `db:"division"`
+			Round    *int   `db:"round"`
+		}
+		assignments := []dbAssignment{}
+		err = tx.Select(&assignments, assignmentSql, assignmentArgs...)
+		if err != nil {
+			return err
+		}
+		for _, assignment := range assignments {
+			if _, ok := divisionIDs[assignment.Division]; !ok {
+				continue
+			}
+			var round interface{}
+			if assignment.Round != nil {
+				round = *assignment.Round
+			}
+			var newAssignmentID string
+			insertSql, insertArgs, _ := s.PSQL.Insert("judge_assignments").
+				Columns("judge", "division", "round", "competition").
+				Values(assignment.Judge, divisionIDs[assignment.Division], round, competitionID).
+				Suffix("RETURNING \"id\"").ToSql()
+			err = tx.Get(&newAssignmentID, insertSql, insertArgs...)
+			if err != nil {
+				return err
+			}
+			created := &rcjpb.JudgeAssignment{
+				Id:         newAssignmentID,
+				JudgeId:    assignment.Judge,
+				DivisionId: divisionIDs[assignment.Division],
+				AllRounds:  assignment.Round == nil,
+			}
+			if assignment.Round != nil {
+				created.Round = int32(*assignment.Round)
+			}
+			err = s.recordAudit(ctx, tx, "JudgeAssignment", newAssignmentID, nil, created)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error cloning competition: %+v", err))
+	}
+	return s.FetchCompetition(ctx, competitionID, nil)
+}
@@ -0,0 +1,29 @@
+package cockroach
+
+import (
+	"fmt"
+	"github.com/golang/protobuf/proto"
+)
+
+// StaleError is returned when an update was made against a version of a row that has since
+// changed. Current holds the row as it is now so the caller can merge their changes into it.
+type StaleError struct {
+	Message string
+	Current proto.Message
+}
+
+func (e *StaleError) Error() string {
+	return e.Message
+}
+
+// checkVersion returns a StaleError carrying current if the version the caller read differs
+// from the version in the database.
+func checkVersion(entity string, readVersion, currentVersion int32, current proto.Message) error {
+	if readVersion == currentVersion {
+		return nil
+	}
+	return &StaleError{
+		Message: fmt.Sprintf("%s was changed by someone else (version %d, now %d)", entity, readVersion, currentVersion),
+		Current: current,
+	}
+}
@@ -0,0 +1,493 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/cockroach-go/crdb"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/golang/protobuf/proto"
+	"github.com/jmoiron/sqlx"
+	"sort"
+	"strings"
+	"time"
+)
+
+// ErrNotFound is returned when the row to change does not exist, or is already in the requested state.
+var ErrNotFound = errors.New("Not found")
+
+// ConflictError is returned when a change would conflict with rows that depend on it, such as
+// a delete that would leave live rows referring to deleted ones.
+type ConflictError struct {
+	Message string
+}
+
+func (e *ConflictError) Error() string {
+	return e.Message
+}
+
+// softDeleteDependent describes rows in another table that refer to an entity.
+type softDeleteDependent struct {
+	description string
+	table       string
+	column      string
+	// entity is nil for rows without their own deleted_at, which are hidden while the entity is deleted
+	entity *softDeleteEntity
+}
+
+// softDeleteParent describes a row an entity refers to, which must be live for the entity to be restored.
+type softDeleteParent struct {
+	description string
+	table       string
+	column      string
+}
+
+type softDeleteEntity struct {
+	name       string
+	table      string
+	parents    []softDeleteParent
+	dependents []softDeleteDependent
+	snapshot   func(s *CockroachStore, ctx context.Context, tx *sqlx.Tx, id string) (proto.Message, error)
+	// tracksUpdates is set for tables whose updated_at must move when rows are deleted or restored
+	tracksUpdates bool
+	// guard, if set, is checked for every row before it is deleted or restored, including rows
+	// reached through a cascade
+	guard func(s *CockroachStore, tx *sqlx.Tx, id string) error
+}
+
+var scoreSheetEntity = &softDeleteEntity{
+	name:          "ScoreSheet",
+	table:         "score_sheets",
+	tracksUpdates: true,
+	parents: []softDeleteParent{
+		{"team", "teams", "team"},
+		{"division", "divisions", "division"},
+		{"template", "score_sheet_templates", "template"},
+		{"author", "users", "author"},
+	},
+	snapshot: func(s *CockroachStore, ctx context.Context, tx *sqlx.Tx, id string) (proto.Message, error) {
+		return s.FetchScoreSheet(ctx, id, tx)
+	},
+	guard: func(s *CockroachStore, tx *sqlx.Tx, id string) error {
+		return s.scoreSheetRoundOpen(tx, id)
+	},
+}
+
+var checkinEntity = &softDeleteEntity{
+	name:  "Checkin",
+	table: "team_checkins",
+	parents: []softDeleteParent{
+		{"team", "teams", "team"},
+		{"agent", "users", "agent"},
+	},
+	snapshot: func(s *CockroachStore, ctx context.Context, tx *sqlx.Tx, id string) (proto.Message, error) {
+		return s.FetchCheckin(ctx, id, tx)
+	},
+}
+
+var teamEntity = &softDeleteEntity{
+	name:  "Team",
+	table: "teams",
+	parents: []softDeleteParent{
+		{"division", "divisions", "division"},
+		{"institution", "institutions", "institution"},
+	},
+	dependents: []softDeleteDependent{
+		{"score sheets", "score_sheets", "team", scoreSheetEntity},
+		{"check-ins", "team_checkins", "team", checkinEntity},
+	},
+	snapshot: func(s *CockroachStore, ctx context.Context, tx *sqlx.Tx, id string) (proto.Message, error) {
+		return s.FetchTeam(ctx, id, tx)
+	},
+}
+
+var divisionEntity = &softDeleteEntity{
+	name:  "Division",
+	table: "divisions",
+	parents: []softDeleteParent{
+		{"interview template", "score_sheet_templates", "interview_template"},
+		{"performance template", "score_sheet_templates", "performance_template"},
+	},
+	dependents: []softDeleteDependent{
+		{"teams", "teams", "division", teamEntity},
+		{"score sheets", "score_sheets", "division", scoreSheetEntity},
+		{"judge assignments", "judge_assignments", "division", nil},
+	},
+	snapshot: func(s *CockroachStore, ctx context.Context, tx *sqlx.Tx, id string) (proto.Message, error) {
+		return s.FetchDivision(id, tx)
+	},
+}
+
+var institutionEntity = &softDeleteEntity{
+	name:  "Institution",
+	table: "institutions",
+	dependents: []softDeleteDependent{
+		{"teams", "teams", "institution", teamEntity},
+	},
+	snapshot: func(s *CockroachStore, ctx context.Context, tx *sqlx.Tx, id string) (proto.Message, error) {
+		sql, args, _ := s.PSQL.Select("id", "name").From("institutions").Where(sq.Eq{"id": id}).ToSql()
+		institution := struct {
+			ID   string `db:"id"`
+			Name string `db:"name"`
+		}{}
+		err := tx.Get(&institution, sql, args...)
+		if err != nil {
+			return nil, err
+		}
+		return &rcjpb.Institution{
+			Id:   institution.ID,
+			Name: institution.Name,
+		}, nil
+	},
+}
+
+var userEntity = &softDeleteEntity{
+	name:  "User",
+	table: "users",
+	dependents: []softDeleteDependent{
+		{"score sheets", "score_sheets", "author", scoreSheetEntity},
+		{"check-ins", "team_checkins", "agent", checkinEntity},
+		{"judge assignments", "judge_assignments", "judge", nil},
+	},
+	snapshot: func(s *CockroachStore, ctx context.Context, tx *sqlx.Tx, id string) (proto.Message, error) {
+		return s.FetchUser(id, tx)
+	},
+}
+
+var scoreSheetTemplateEntity = &softDeleteEntity{
+	name:  "ScoreSheetTemplate",
+	table: "score_sheet_templates",
+	dependents: []softDeleteDependent{
+		{"divisions", "divisions", "interview_template", divisionEntity},
+		{"divisions", "divisions", "performance_template", divisionEntity},
+		{"score sheets", "score_sheets", "template", scoreSheetEntity},
+	},
+	snapshot: func(s *CockroachStore, ctx context.Context, tx *sqlx.Tx, id string) (proto.Message, error) {
+		sql, args, _ := s.PSQL.Select("id", "name", "type", "competition").
+			From("score_sheet_templates").Where(sq.Eq{"id": id}).ToSql()
+		template := struct {
+			ID          string `db:"id"`
+			Name        string `db:"name"`
+			Type        string `db:"type"`
+			Competition string `db:"competition"`
+		}{}
+		err := tx.Get(&template, sql, args...)
+		if err != nil {
+			return nil, err
+		}
+		templateType := rcjpb.ScoreSheetTemplate_INTERVIEW
+		if template.Type == "Performance" {
+			templateType = rcjpb.ScoreSheetTemplate_PERFORMANCE
+		}
+		return &rcjpb.ScoreSheetTemplate{
+			Id:            template.ID,
+			Name:          template.Name,
+			Type:          templateType,
+			CompetitionId: template.Competition,
+		}, nil
+	},
+}
+
+// checkDependents returns a ConflictError describing any live rows that refer to the entity.
+func (s *CockroachStore) checkDependents(tx *sqlx.Tx, entity *softDeleteEntity, id string) error {
+	counts := map[string]int{}
+	for _, dependent := range entity.dependents {
+		query := s.PSQL.Select("count(*)").From(dependent.table).Where(sq.Eq{dependent.column: id})
+		if dependent.entity != nil {
+			query = query.Where(sq.Eq{"deleted_at": nil})
+		}
+		sql, args, _ := query.ToSql()
+		var count int
+		err := tx.Get(&count, sql, args...)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			counts[dependent.description] += count
+		}
+	}
+	if len(counts) == 0 {
+		return nil
+	}
+	descriptions := []string{}
+	for description, count := range counts {
+		descriptions = append(descriptions, fmt.Sprintf("%d %s", count, description))
+	}
+	sort.Strings(descriptions)
+	return &ConflictError{
+		Message: fmt.Sprintf("%s %s is still referred to by %s", entity.name, id, strings.Join(descriptions, ", ")),
+	}
+}
+
+// deleteRows soft-deletes the rows and everything depending on them, marking all of them with the same time.
+func (s *CockroachStore) deleteRows(ctx context.Context, tx *sqlx.Tx, entity *softDeleteEntity, ids []string, deletedAt time.Time) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	for _, dependent := range entity.dependents {
+		if dependent.entity == nil {
+			continue
+		}
+		sql, args, _ := s.PSQL.Select("id").From(dependent.table).
+			Where(sq.Eq{dependent.column: ids, "deleted_at": nil}).ToSql()
+		dependentIDs := []string{}
+		err := tx.Select(&dependentIDs, sql, args...)
+		if err != nil {
+			return err
+		}
+		err = s.deleteRows(ctx, tx, dependent.entity, dependentIDs, deletedAt)
+		if err != nil {
+			return err
+		}
+	}
+	err := s.guardRows(tx, entity, ids)
+	if err != nil {
+		return err
+	}
+	snapshots := make([]proto.Message, len(ids))
+	for idx, id := range ids {
+		snapshot, err := entity.snapshot(s, ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		snapshots[idx] = snapshot
+	}
+	update := s.PSQL.Update(entity.table).Set("deleted_at", deletedAt)
+	if entity.tracksUpdates {
+		update = update.Set("updated_at", sq.Expr("current_timestamp()"))
+	}
+	sql, args, _ := update.Where(sq.Eq{"id": ids, "deleted_at": nil}).ToSql()
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+	for idx, id := range ids {
+		err = s.recordAudit(ctx, tx, entity.name, id, snapshots[idx], nil)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// guardRows runs the entity's guard, if any, for each of the rows.
+func (s *CockroachStore) guardRows(tx *sqlx.Tx, entity *softDeleteEntity, ids []string) error {
+	if entity.guard == nil {
+		return nil
+	}
+	for _, id := range ids {
+		err := entity.guard(s, tx, id)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// restoreRows restores rows deleted at the given time and, if cascade is set, the dependents deleted with them.
+func (s *CockroachStore) restoreRows(ctx context.Context, tx *sqlx.Tx, entity *softDeleteEntity, ids []string, deletedAt time.Time, cascade bool) error {
+	if len(ids) == 0 {
+		return nil
+	}
+	for _, parent := range entity.parents {
+		sql, args, _ := s.PSQL.Select("count(*)").From(fmt.Sprintf("%s AS child", entity.table)).
+			Join(fmt.Sprintf("%s AS parent ON child.%s = parent.id", parent.table, parent.column)).
+			Where(sq.Eq{"child.id": ids}).
+			Where(sq.NotEq{"parent.deleted_at": nil}).ToSql()
+		var count int
+		err := tx.Get(&count, sql, args...)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return &ConflictError{
+				Message: fmt.Sprintf("%s refers to a deleted %s which must be restored first", entity.name, parent.description),
+			}
+		}
+	}
+	err := s.guardRows(tx, entity, ids)
+	if err != nil {
+		return err
+	}
+	update := s.PSQL.Update(entity.table).Set("deleted_at", nil)
+	if entity.tracksUpdates {
+		update = update.Set("updated_at", sq.Expr("current_timestamp()"))
+	}
+	sql, args, _ := update.Where(sq.Eq{"id": ids, "deleted_at": deletedAt}).ToSql()
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+	for _, id := range ids {
+		snapshot, err := entity.snapshot(s, ctx, tx, id)
+		if err != nil {
+			return err
+		}
+		err = s.recordAudit(ctx, tx, entity.name, id, nil, snapshot)
+		if err != nil {
+			return err
+		}
+	}
+	if !cascade {
+		return nil
+	}
+	for _, dependent := range entity.dependents {
+		if dependent.entity == nil {
+			continue
+		}
+		depSql, depArgs, _ := s.PSQL.Select("id").From(dependent.table).
+			Where(sq.Eq{dependent.column: ids, "deleted_at": deletedAt}).ToSql()
+		dependentIDs := []string{}
+		err := tx.Select(&dependentIDs, depSql, depArgs...)
+		if err != nil {
+			return err
+		}
+		err = s.restoreRows(ctx, tx, dependent.entity, dependentIDs, deletedAt, cascade)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// softDelete marks the row deleted. Unless cascade is set, it fails with a ConflictError if
+// live rows still refer to it; with cascade they are deleted along with it.
+func (s *CockroachStore) softDelete(ctx context.Context, entity *softDeleteEntity, id string, cascade bool, afterDelete func(*sqlx.Tx) error) error {
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		sql, args, _ := s.PSQL.Select("count(*)").From(entity.table).
+			Where(sq.Eq{"id": id, "deleted_at": nil}).ToSql()
+		var count int
+		err := tx.Get(&count, sql, args...)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrNotFound
+		}
+		if !cascade {
+			err = s.checkDependents(tx, entity, id)
+			if err != nil {
+				return err
+			}
+		}
+		// CockroachDB stores timestamps to the microsecond, so truncate to compare them on restore
+		err = s.deleteRows(ctx, tx, entity, []string{id}, time.Now().UTC().Truncate(time.Microsecond))
+		if err != nil {
+			return err
+		}
+		if afterDelete != nil {
+			return afterDelete(tx)
+		}
+		return nil
+	})
+	return deleteError(err, "deleting")
+}
+
+// restore undoes a soft delete. With cascade, rows deleted along with it are restored too.
+func (s *CockroachStore) restore(ctx context.Context, entity *softDeleteEntity, id string, cascade bool, afterRestore func(*sqlx.Tx) error) error {
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		sql, args, _ := s.PSQL.Select("deleted_at").From(entity.table).
+			Where(sq.Eq{"id": id}).Where(sq.NotEq{"deleted_at": nil}).ToSql()
+		deletedAt := []time.Time{}
+		err := tx.Select(&deletedAt, sql, args...)
+		if err != nil {
+			return err
+		}
+		if len(deletedAt) == 0 {
+			return ErrNotFound
+		}
+		err = s.restoreRows(ctx, tx, entity, []string{id}, deletedAt[0], cascade)
+		if err != nil {
+			return err
+		}
+		if afterRestore != nil {
+			return afterRestore(tx)
+		}
+		return nil
+	})
+	return deleteError(err, "restoring")
+}
+
+func deleteError(err error, action string) error {
+	if err == nil || err == ErrNotFound {
+		return err
+	}
+	if _, ok := err.(*ConflictError); ok {
+		return err
+	}
+	return errors.New(fmt.Sprintf("Error %s: %+v", action, err))
+}
+
+func (s *CockroachStore) DeleteTeam(ctx context.Context, id string, cascade bool) error {
+	return s.softDelete(ctx, teamEntity, id, cascade, nil)
+}
+
+func (s *CockroachStore) RestoreTeam(ctx context.Context, id string, cascade bool) error {
+	return s.restore(ctx, teamEntity, id, cascade, nil)
+}
+
+func (s *CockroachStore) DeleteDivision(ctx context.Context, id string, cascade bool) error {
+	return s.softDelete(ctx, divisionEntity, id, cascade, nil)
+}
+
+func (s *CockroachStore) RestoreDivision(ctx context.Context, id string, cascade bool) error {
+	return s.restore(ctx, divisionEntity, id, cascade, nil)
+}
+
+func (s *CockroachStore) DeleteInstitution(ctx context.Context, id string, cascade bool) error {
+	return s.softDelete(ctx, institutionEntity, id, cascade, nil)
+}
+
+func (s *CockroachStore) RestoreInstitution(ctx context.Context, id string, cascade bool) error {
+	return s.restore(ctx, institutionEntity, id, cascade, nil)
+}
+
+// DeleteUser also ends the user's sessions. Their API keys stop working while they are deleted.
+func (s *CockroachStore) DeleteUser(ctx context.Context, id string, cascade bool) error {
+	return s.softDelete(ctx, userEntity, id, cascade, func(tx *sqlx.Tx) error {
+		return s.revokeUserSessions(tx, id)
+	})
+}
+
+func (s *CockroachStore) RestoreUser(ctx context.Context, id string, cascade bool) error {
+	return s.restore(ctx, userEntity, id, cascade, nil)
+}
+
+// scoreSheetRoundOpen returns a ConflictError if the score sheet's round is locked.
+func (s *CockroachStore) scoreSheetRoundOpen(tx *sqlx.Tx, id string) error {
+	sql, args, _ := s.PSQL.Select("division", "round").From("score_sheets").Where(sq.Eq{"id": id}).ToSql()
+	sheet := struct {
+		Division string `db:"division"`
+		Round    int32  `db:"round"`
+	}{}
+	err := tx.Get(&sheet, sql, args...)
+	if err != nil {
+		return err
+	}
+	return s.checkRoundOpen(tx, sheet.Division, sheet.Round)
+}
+
+func (s *CockroachStore) DeleteScoreSheet(ctx context.Context, id string) error {
+	return s.softDelete(ctx, scoreSheetEntity, id, false, nil)
+}
+
+func (s *CockroachStore) RestoreScoreSheet(ctx context.Context, id string) error {
+	return s.restore(ctx, scoreSheetEntity, id, false, nil)
+}
+
+func (s *CockroachStore) DeleteScoreSheetTemplate(ctx context.Context, id string, cascade bool) error {
+	return s.softDelete(ctx, scoreSheetTemplateEntity, id, cascade, nil)
+}
+
+func (s *CockroachStore) RestoreScoreSheetTemplate(ctx context.Context, id string, cascade bool) error {
+	return s.restore(ctx, scoreSheetTemplateEntity, id, cascade, nil)
+}
+
+func (s *CockroachStore) DeleteCheckin(ctx context.Context, id string) error {
+	return s.softDelete(ctx, checkinEntity, id, false, nil)
+}
+
+func (s *CockroachStore) RestoreCheckin(ctx context.Context, id string) error {
+	return s.restore(ctx, checkinEntity, id, false, nil)
+}
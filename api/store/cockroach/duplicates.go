@@ -0,0 +1,125 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/jmoiron/sqlx"
+)
+
+// checkDuplicateScoreSheet returns a ConflictError if the sheet's author already has another live
+// sheet for the same team, round and template.
+func (s *CockroachStore) checkDuplicateScoreSheet(tx *sqlx.Tx, scoreSheet *rcjpb.ScoreSheet) error {
+	query := s.PSQL.Select("id").From("score_sheets").Where(sq.Eq{
+		"author":     scoreSheet.GetAuthor().GetId(),
+		"team":       scoreSheet.GetTeam().GetId(),
+		"round":      scoreSheet.GetRound(),
+		"template":   scoreSheet.GetScoreSheetTemplateId(),
+		"deleted_at": nil,
+	})
+	if scoreSheet.GetId() != "" {
+		query = query.Where(sq.NotEq{"id": scoreSheet.GetId()})
+	}
+	sql, args, _ := query.ToSql()
+	ids := []string{}
+	err := tx.Select(&ids, sql, args...)
+	if err != nil {
+		return err
+	}
+	if len(ids) > 0 {
+		return &ConflictError{
+			Message: fmt.Sprintf("The team has already been scored in round %d on score sheet %s", scoreSheet.GetRound(), ids[0]),
+		}
+	}
+	return nil
+}
+
+// FetchDuplicateScoreSheets returns the live sheets that share an author, team, round and template
+// with another live sheet, grouped by what they share. Sheets in each group are oldest first.
+func (s *CockroachStore) FetchDuplicateScoreSheets(ctx context.Context, divisionID *string) ([]*rcjpb.DuplicateScoreSheets, error) {
+	query := s.PSQL.Select(
+		"score_sheets.id as id",
+		"score_sheets.division as division",
+		"score_sheets.round as round",
+		"score_sheets.template as template",
+		"score_sheets.status as status",
+		"score_sheets.version as version",
+		"users.id as author_id",
+		"users.name as author",
+		"teams.id as team_id",
+		"teams.name as team_name",
+	).From("score_sheets").
+		Join("(SELECT author, team, round, template FROM score_sheets WHERE deleted_at IS NULL " +
+			"GROUP BY author, team, round, template HAVING COUNT(*) > 1) as duplicates ON " +
+			"duplicates.author = score_sheets.author AND duplicates.team = score_sheets.team AND " +
+			"duplicates.round = score_sheets.round AND duplicates.template = score_sheets.template").
+		Join("users ON users.id = score_sheets.author").
+		Join("teams ON teams.id = score_sheets.team").
+		Where(sq.Eq{"score_sheets.deleted_at": nil})
+	if divisionID != nil {
+		query = query.Where(sq.Eq{"score_sheets.division": divisionID})
+	}
+	if competitionID := CompetitionFromContext(ctx); competitionID != "" {
+		query = query.Where(sq.Expr("score_sheets.division IN (SELECT id FROM divisions WHERE competition = ?)", competitionID))
+	}
+	sql, args, _ := query.OrderBy(
+		"score_sheets.team",
+		"score_sheets.round",
+		"score_sheets.template",
+		"score_sheets.author",
+		"score_sheets.created_at",
+	).ToSql()
+	type dbScoreSheet struct {
+		ID         string `db:"id"`
+		DivisionID string `db:"division"`
+		Round      int32  `db:"round"`
+		TemplateID string `db:"template"`
+		Status     string `db:"status"`
+		Version    int32  `db:"version"`
+		AuthorID   string `db:"author_id"`
+		Author     string `db:"author"`
+		TeamID     string `db:"team_id"`
+		TeamName   string `db:"team_name"`
+	}
+	entries := []*dbScoreSheet{}
+	err := s.DB.SelectContext(ctx, &entries, sql, args...)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching duplicate score sheets: %+v", err))
+	}
+	duplicates := []*rcjpb.DuplicateScoreSheets{}
+	var current *rcjpb.DuplicateScoreSheets
+	for _, entry := range entries {
+		author := &rcjpb.User{
+			Id:   entry.AuthorID,
+			Name: entry.Author,
+		}
+		team := &rcjpb.Team{
+			Id:       entry.TeamID,
+			Name:     entry.TeamName,
+			Division: entry.DivisionID,
+		}
+		if current == nil || current.GetAuthor().GetId() != entry.AuthorID || current.GetTeam().GetId() != entry.TeamID ||
+			current.GetRound() != entry.Round || current.GetScoreSheetTemplateId() != entry.TemplateID {
+			current = &rcjpb.DuplicateScoreSheets{
+				Author:               author,
+				Team:                 team,
+				Round:                entry.Round,
+				ScoreSheetTemplateId: entry.TemplateID,
+			}
+			duplicates = append(duplicates, current)
+		}
+		current.ScoreSheets = append(current.ScoreSheets, &rcjpb.ScoreSheet{
+			Id:                   entry.ID,
+			DivisionId:           entry.DivisionID,
+			Round:                entry.Round,
+			ScoreSheetTemplateId: entry.TemplateID,
+			Status:               scoreSheetStatus(entry.Status),
+			Version:              entry.Version,
+			Author:               author,
+			Team:                 team,
+		})
+	}
+	return duplicates, nil
+}
@@ -0,0 +1,53 @@
+package cockroach
+
+import (
+	"context"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// IdempotencyWindow is how long a create can be replayed with the same idempotency key and still
+// return the entity it originally created.
+const IdempotencyWindow = 24 * time.Hour
+
+type idempotencyContextKey struct{}
+
+// WithIdempotencyKey attaches the key identifying a create request to the context. Creates made
+// with the returned context store the key with the row they insert.
+func WithIdempotencyKey(ctx context.Context, key string) context.Context {
+	return context.WithValue(ctx, idempotencyContextKey{}, key)
+}
+
+// idempotencyKey returns the context's idempotency key, or nil if there is none so that it is
+// stored as NULL.
+func idempotencyKey(ctx context.Context) interface{} {
+	if key, _ := ctx.Value(idempotencyContextKey{}).(string); key != "" {
+		return key
+	}
+	return nil
+}
+
+// replayedID returns the ID of the live row in table that the context's audit actor created with
+// the context's idempotency key within the window, or an empty string if this is not a replay.
+func (s *CockroachStore) replayedID(ctx context.Context, tx *sqlx.Tx, table, userColumn, createdColumn string) (string, error) {
+	key := idempotencyKey(ctx)
+	actor, _ := ctx.Value(auditContextKey{}).(auditActor)
+	if key == nil || actor.userID == "" {
+		return "", nil
+	}
+	sql, args, _ := s.PSQL.Select("id").From(table).
+		Where(sq.Eq{
+			userColumn:        actor.userID,
+			"idempotency_key": key,
+			"deleted_at":      nil,
+		}).
+		Where(sq.Gt{createdColumn: time.Now().UTC().Add(-IdempotencyWindow)}).
+		OrderBy(createdColumn + " DESC").Limit(1).ToSql()
+	ids := []string{}
+	err := tx.Select(&ids, sql, args...)
+	if err != nil || len(ids) == 0 {
+		return "", err
+	}
+	return ids[0], nil
+}
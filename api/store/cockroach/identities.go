@@ -0,0 +1,227 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/cockroach-go/crdb"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// ResolveIdentity returns the local user linked to an identity from an external provider.
+// If no user is linked and provision is set, a user without a local password is created
+// and linked. It returns nil if the identity is unknown and provisioning is disabled, and a
+// ConflictError rather than provisioning a second user with the username of an active user.
+func (s *CockroachStore) ResolveIdentity(ctx context.Context, provider, subject, username, name string, provision bool) (*rcjpb.User, error) {
+	var userID string
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		userID = ""
+		sql, args, _ := s.PSQL.Select("user_identities.user_id as user_id", "users.deleted_at IS NOT NULL as deleted").
+			From("user_identities").Join("users ON users.id = user_identities.user_id").
+			Where(sq.Eq{"provider": provider, "subject": subject}).ToSql()
+		links := []struct {
+			UserID  string `db:"user_id"`
+			Deleted bool   `db:"deleted"`
+		}{}
+		err := tx.Select(&links, sql, args...)
+		if err != nil {
+			return err
+		}
+		if len(links) > 0 {
+			// Identities linked to a deleted user cannot log in until the user is restored
+			if !links[0].Deleted {
+				userID = links[0].UserID
+			}
+			return nil
+		}
+		if !provision {
+			return nil
+		}
+		// Existing users must have the identity linked by an admin instead
+		countSql, countArgs, _ := s.PSQL.Select("count(*)").From("users").
+			Where(sq.Eq{"username": username, "deleted_at": nil}).ToSql()
+		var count int
+		err = tx.Get(&count, countSql, countArgs...)
+		if err != nil {
+			return err
+		}
+		if count > 0 {
+			return &ConflictError{
+				Message: fmt.Sprintf("A user with the username %s already exists and must be linked to the %s login by an administrator", username, provider),
+			}
+		}
+		userSql, userArgs, _ := s.PSQL.Insert("users").
+			Columns("name", "username", "hashed_password", "is_admin").
+			Values(name, username, "", false).Suffix("RETURNING \"id\"").ToSql()
+		userRows, err := tx.Query(userSql, userArgs...)
+		if err != nil {
+			return err
+		}
+		for userRows.Next() {
+			userRows.Scan(&userID)
+		}
+		userRows.Close()
+		linkSql, linkArgs, _ := s.PSQL.Insert("user_identities").
+			Columns("provider", "subject", "user_id").
+			Values(provider, subject, userID).ToSql()
+		_, err = tx.Exec(linkSql, linkArgs...)
+		if err != nil {
+			return err
+		}
+		created, err := s.FetchUser(userID, tx)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "User", userID, nil, created)
+	})
+	if _, ok := err.(*ConflictError); ok {
+		return nil, err
+	} else if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error resolving identity: %+v", err))
+	}
+	if userID == "" {
+		return nil, nil
+	}
+	return s.FetchUser(userID, nil)
+}
+
+// identityEntityID identifies a linked identity in the audit log.
+func identityEntityID(provider, subject string) string {
+	return fmt.Sprintf("%s/%s", provider, subject)
+}
+
+// FetchUserIdentities returns the external identities linked to a user, oldest first.
+func (s *CockroachStore) FetchUserIdentities(ctx context.Context, userID string, txx *sqlx.Tx) ([]*rcjpb.UserIdentity, error) {
+	sql, args, _ := s.PSQL.Select("provider", "subject", "user_id", "created_at").
+		From("user_identities").Where(sq.Eq{"user_id": userID}).
+		OrderBy("created_at").ToSql()
+	type dbIdentity struct {
+		Provider  string    `db:"provider"`
+		Subject   string    `db:"subject"`
+		UserID    string    `db:"user_id"`
+		CreatedAt time.Time `db:"created_at"`
+	}
+	dbIdentities := []dbIdentity{}
+	var err error
+	if txx != nil {
+		err = txx.Select(&dbIdentities, sql, args...)
+	} else {
+		err = s.DB.SelectContext(ctx, &dbIdentities, sql, args...)
+	}
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching user identities: %+v", err))
+	}
+	identities := make([]*rcjpb.UserIdentity, len(dbIdentities))
+	for idx, entry := range dbIdentities {
+		identities[idx] = &rcjpb.UserIdentity{
+			Provider: entry.Provider,
+			Subject:  entry.Subject,
+			UserId:   entry.UserID,
+			CreatedAt: &tspb.Timestamp{
+				Seconds: entry.CreatedAt.Unix(),
+				Nanos:   int32(entry.CreatedAt.Nanosecond()),
+			},
+		}
+	}
+	return identities, nil
+}
+
+// LinkIdentity links an identity from an external provider to an existing user, so that logging
+// in through the provider signs in as that user. It returns ErrNotFound if the user does not exist
+// and a ConflictError if the identity is already linked to a different user.
+func (s *CockroachStore) LinkIdentity(ctx context.Context, provider, subject, userID string) (*rcjpb.UserIdentity, error) {
+	var linked *rcjpb.UserIdentity
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		countSql, countArgs, _ := s.PSQL.Select("count(*)").From("users").
+			Where(sq.Eq{"id": userID, "deleted_at": nil}).ToSql()
+		var count int
+		err := tx.Get(&count, countSql, countArgs...)
+		if err != nil {
+			return err
+		}
+		if count == 0 {
+			return ErrNotFound
+		}
+		existingSql, existingArgs, _ := s.PSQL.Select("user_id").From("user_identities").
+			Where(sq.Eq{"provider": provider, "subject": subject}).ToSql()
+		existing := []string{}
+		err = tx.Select(&existing, existingSql, existingArgs...)
+		if err != nil {
+			return err
+		}
+		if len(existing) == 0 {
+			insertSql, insertArgs, _ := s.PSQL.Insert("user_identities").
+				Columns("provider", "subject", "user_id").
+				Values(provider, subject, userID).ToSql()
+			_, err = tx.Exec(insertSql, insertArgs...)
+			if err != nil {
+				return err
+			}
+		} else if existing[0] != userID {
+			return &ConflictError{
+				Message: fmt.Sprintf("The %s login %s is already linked to another user", provider, subject),
+			}
+		}
+		identities, err := s.FetchUserIdentities(ctx, userID, tx)
+		if err != nil {
+			return err
+		}
+		for _, identity := range identities {
+			if identity.GetProvider() == provider && identity.GetSubject() == subject {
+				linked = identity
+			}
+		}
+		if len(existing) > 0 {
+			return nil
+		}
+		return s.recordAudit(ctx, tx, "UserIdentity", identityEntityID(provider, subject), nil, linked)
+	})
+	if _, ok := err.(*ConflictError); ok || err == ErrNotFound {
+		return nil, err
+	} else if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error linking identity: %+v", err))
+	}
+	return linked, nil
+}
+
+// UnlinkIdentity removes the link between an external identity and its user. It returns
+// ErrNotFound if the identity is not linked.
+func (s *CockroachStore) UnlinkIdentity(ctx context.Context, provider, subject string) error {
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		existingSql, existingArgs, _ := s.PSQL.Select("user_id").From("user_identities").
+			Where(sq.Eq{"provider": provider, "subject": subject}).ToSql()
+		existing := []string{}
+		err := tx.Select(&existing, existingSql, existingArgs...)
+		if err != nil {
+			return err
+		}
+		if len(existing) == 0 {
+			return ErrNotFound
+		}
+		identities, err := s.FetchUserIdentities(ctx, existing[0], tx)
+		if err != nil {
+			return err
+		}
+		var original *rcjpb.UserIdentity
+		for _, identity := range identities {
+			if identity.GetProvider() == provider && identity.GetSubject() == subject {
+				original = identity
+			}
+		}
+		deleteSql, deleteArgs, _ := s.PSQL.Delete("user_identities").
+			Where(sq.Eq{"provider": provider, "subject": subject}).ToSql()
+		_, err = tx.Exec(deleteSql, deleteArgs...)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "UserIdentity", identityEntityID(provider, subject), original, nil)
+	})
+	if err == nil || err == ErrNotFound {
+		return err
+	}
+	return errors.New(fmt.Sprintf("Error unlinking identity: %+v", err))
+}
@@ -0,0 +1,134 @@
+package cockroach
+
+import (
+	"context"
+	"testing"
+)
+
+func TestResolveIdentityLinkedUser(t *testing.T) {
+	store, cleanup := testStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	existing := createTestUser(t, store, "jo")
+	if _, err := store.LinkIdentity(ctx, "directory", "uid=jsmith,dc=example,dc=org", existing.GetId()); err != nil {
+		t.Fatalf("Error linking identity: %+v", err)
+	}
+	for _, provision := range []bool{false, true} {
+		user, err := store.ResolveIdentity(ctx, "directory", "uid=jsmith,dc=example,dc=org", "jsmith", "Jo Smith", provision)
+		if err != nil {
+			t.Fatalf("Error resolving identity: %+v", err)
+		}
+		// The local user keeps its own details rather than taking the provider's
+		if user.GetId() != existing.GetId() || user.GetUsername() != "jo" {
+			t.Errorf("Expected the linked user %+v, got %+v", existing, user)
+		}
+	}
+}
+
+func TestResolveIdentityProvisionsUser(t *testing.T) {
+	store, cleanup := testStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	user, err := store.ResolveIdentity(ctx, "example", "user-1234", "jsmith", "Jo Smith", true)
+	if err != nil {
+		t.Fatalf("Error resolving identity: %+v", err)
+	}
+	if user.GetId() == "" || user.GetUsername() != "jsmith" || user.GetName() != "Jo Smith" {
+		t.Fatalf("Expected a new user named after the identity, got %+v", user)
+	}
+	identities, err := store.FetchUserIdentities(ctx, user.GetId(), nil)
+	if err != nil {
+		t.Fatalf("Error fetching identities: %+v", err)
+	}
+	if len(identities) != 1 || identities[0].GetProvider() != "example" || identities[0].GetSubject() != "user-1234" {
+		t.Errorf("Expected the identity to be linked to the new user, got %+v", identities)
+	}
+	// Logging in again finds the same user rather than provisioning another
+	again, err := store.ResolveIdentity(ctx, "example", "user-1234", "jsmith", "Jo Smith", true)
+	if err != nil {
+		t.Fatalf("Error resolving identity: %+v", err)
+	}
+	if again.GetId() != user.GetId() {
+		t.Errorf("Expected the provisioned user %s to be reused, got %+v", user.GetId(), again)
+	}
+}
+
+func TestResolveIdentityRefusesExistingUsername(t *testing.T) {
+	store, cleanup := testStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	existing := createTestUser(t, store, "jsmith")
+	user, err := store.ResolveIdentity(ctx, "example", "user-1234", "jsmith", "Jo Smith", true)
+	if _, ok := err.(*ConflictError); !ok {
+		t.Fatalf("Expected a ConflictError, got %+v and %+v", err, user)
+	}
+	// Once the existing user is deleted the username is free to provision again
+	if err = store.DeleteUser(ctx, existing.GetId(), false); err != nil {
+		t.Fatalf("Error deleting user: %+v", err)
+	}
+	user, err = store.ResolveIdentity(ctx, "example", "user-1234", "jsmith", "Jo Smith", true)
+	if err != nil {
+		t.Fatalf("Error resolving identity: %+v", err)
+	}
+	if user.GetId() == "" || user.GetId() == existing.GetId() {
+		t.Errorf("Expected a new user, got %+v", user)
+	}
+}
+
+func TestResolveIdentityWithoutUser(t *testing.T) {
+	store, cleanup := testStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	user, err := store.ResolveIdentity(ctx, "example", "user-1234", "jsmith", "Jo Smith", false)
+	if err != nil || user != nil {
+		t.Errorf("Expected no user when provisioning is disabled, got %+v and %+v", user, err)
+	}
+	deleted := createTestUser(t, store, "jo")
+	if _, err = store.LinkIdentity(ctx, "example", "user-5678", deleted.GetId()); err != nil {
+		t.Fatalf("Error linking identity: %+v", err)
+	}
+	if err = store.DeleteUser(ctx, deleted.GetId(), false); err != nil {
+		t.Fatalf("Error deleting user: %+v", err)
+	}
+	user, err = store.ResolveIdentity(ctx, "example", "user-5678", "jo", "Jo", true)
+	if err != nil || user != nil {
+		t.Errorf("Expected no user for an identity linked to a deleted user, got %+v and %+v", user, err)
+	}
+}
+
+func TestLinkAndUnlinkIdentity(t *testing.T) {
+	store, cleanup := testStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	first := createTestUser(t, store, "first")
+	second := createTestUser(t, store, "second")
+	linked, err := store.LinkIdentity(ctx, "example", "user-1234", first.GetId())
+	if err != nil {
+		t.Fatalf("Error linking identity: %+v", err)
+	}
+	if linked.GetUserId() != first.GetId() || linked.GetCreatedAt() == nil {
+		t.Errorf("Expected the identity to be linked to %s, got %+v", first.GetId(), linked)
+	}
+	// Linking again to the same user is a no-op, but another user cannot take the identity
+	if _, err = store.LinkIdentity(ctx, "example", "user-1234", first.GetId()); err != nil {
+		t.Errorf("Expected relinking to the same user to succeed, got %+v", err)
+	}
+	if _, err = store.LinkIdentity(ctx, "example", "user-1234", second.GetId()); err == nil {
+		t.Errorf("Expected a conflict linking the identity to a second user")
+	} else if _, ok := err.(*ConflictError); !ok {
+		t.Errorf("Expected a ConflictError, got %+v", err)
+	}
+	if _, err = store.LinkIdentity(ctx, "example", "user-5678", "00000000-0000-0000-0000-000000000000"); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound linking to a missing user, got %+v", err)
+	}
+	if err = store.UnlinkIdentity(ctx, "example", "user-1234"); err != nil {
+		t.Fatalf("Error unlinking identity: %+v", err)
+	}
+	user, err := store.ResolveIdentity(ctx, "example", "user-1234", "jsmith", "Jo Smith", false)
+	if err != nil || user != nil {
+		t.Errorf("Expected the unlinked identity to resolve to no user, got %+v and %+v", user, err)
+	}
+	if err = store.UnlinkIdentity(ctx, "example", "user-1234"); err != ErrNotFound {
+		t.Errorf("Expected ErrNotFound unlinking twice, got %+v", err)
+	}
+}
@@ -0,0 +1,154 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/cockroach-go/crdb"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/jmoiron/sqlx"
+)
+
+type FetchJudgeAssignmentsOptions struct {
+	IDs        []string
+	JudgeID    *string
+	DivisionID *string
+}
+
+func (s *CockroachStore) FetchJudgeAssignments(ctx context.Context, opts *FetchJudgeAssignmentsOptions, txx *sqlx.Tx) ([]*rcjpb.JudgeAssignment, error) {
+	// Assignments of deleted judges or divisions are hidden rather than removed so a restore brings them back
+	query := s.PSQL.Select("id", "judge", "division", "round").From("judge_assignments").
+		Where("judge IN (SELECT id FROM users WHERE deleted_at IS NULL)").
+		Where("division IN (SELECT id FROM divisions WHERE deleted_at IS NULL)")
+	if opts != nil {
+		if len(opts.IDs) > 0 {
+			query = query.Where(sq.Eq{"id": opts.IDs})
+		}
+		if opts.JudgeID != nil {
+			query = query.Where(sq.Eq{"judge": opts.JudgeID})
+		}
+		if opts.DivisionID != nil {
+			query = query.Where(sq.Eq{"division": opts.DivisionID})
+		}
+	}
+	sql, args, _ := competitionScope(ctx, query, "competition").ToSql()
+	type dbAssignment struct {
+		ID       string `db:"id"`
+		Judge    string `db:"judge"`
+		Division string `db:"division"`
+		Round    *int   `db:"round"`
+	}
+	dbAssignments := []dbAssignment{}
+	var err error
+	if txx != nil {
+		err = txx.Select(&dbAssignments, sql, args...)
+	} else {
+		err = s.DB.SelectContext(ctx, &dbAssignments, sql, args...)
+	}
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching judge assignments: %+v", err))
+	}
+	results := make([]*rcjpb.JudgeAssignment, len(dbAssignments))
+	for idx, entry := range dbAssignments {
+		assignment := &rcjpb.JudgeAssignment{
+			Id:         entry.ID,
+			JudgeId:    entry.Judge,
+			DivisionId: entry.Division,
+			AllRounds:  entry.Round == nil,
+		}
+		if entry.Round != nil {
+			assignment.Round = int32(*entry.Round)
+		}
+		results[idx] = assignment
+	}
+	return results, nil
+}
+
+// IsJudgeAssigned reports whether the judge has been assigned to score the division round.
+func (s *CockroachStore) IsJudgeAssigned(ctx context.Context, judgeID, divisionID string, round int32, txx *sqlx.Tx) (bool, error) {
+	assignments, err := s.FetchJudgeAssignments(ctx, &FetchJudgeAssignmentsOptions{
+		JudgeID:    &judgeID,
+		DivisionID: &divisionID,
+	}, txx)
+	if err != nil {
+		return false, err
+	}
+	for _, assignment := range assignments {
+		if assignment.GetAllRounds() || assignment.GetRound() == round {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func (s *CockroachStore) CreateJudgeAssignment(ctx context.Context, handler func(*rcjpb.JudgeAssignment) error) (*rcjpb.JudgeAssignment, error) {
+	var assignmentID string
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		assignment := &rcjpb.JudgeAssignment{}
+		handlerError := handler(assignment)
+		if handlerError != nil {
+			return handlerError
+		}
+		var round interface{}
+		if !assignment.GetAllRounds() {
+			round = assignment.GetRound()
+		}
+		sql, args, _ := s.PSQL.Insert("judge_assignments").
+			Columns("judge", "division", "round", "competition").
+			Values(
+				assignment.GetJudgeId(),
+				assignment.GetDivisionId(),
+				round,
+				sq.Expr("(SELECT competition FROM divisions WHERE id = ?)", assignment.GetDivisionId()),
+			).
+			Suffix("RETURNING \"id\"").ToSql()
+		rows, err := tx.Query(sql, args...)
+		if err != nil {
+			return err
+		}
+		for rows.Next() {
+			rows.Scan(&assignmentID)
+		}
+		rows.Close()
+		assignment.Id = assignmentID
+		return s.recordAudit(ctx, tx, "JudgeAssignment", assignmentID, nil, assignment)
+	})
+	if err != nil {
+		return nil, err
+	}
+	assignments, err := s.FetchJudgeAssignments(ctx, &FetchJudgeAssignmentsOptions{
+		IDs: []string{assignmentID},
+	}, nil)
+	if err != nil {
+		return nil, err
+	}
+	if len(assignments) != 1 {
+		return nil, errors.New("Error fetching judge assignment: Not found")
+	}
+	return assignments[0], nil
+}
+
+func (s *CockroachStore) DeleteJudgeAssignment(ctx context.Context, id string) error {
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		assignments, err := s.FetchJudgeAssignments(ctx, &FetchJudgeAssignmentsOptions{
+			IDs: []string{id},
+		}, tx)
+		if err != nil {
+			return err
+		}
+		if len(assignments) == 0 {
+			return nil
+		}
+		sql, args, _ := s.PSQL.Delete("judge_assignments").Where(sq.Eq{"id": id}).ToSql()
+		_, err = tx.Exec(sql, args...)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "JudgeAssignment", id, assignments[0], nil)
+	})
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error deleting judge assignment: %+v", err))
+	}
+	return nil
+}
@@ -0,0 +1,227 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/cockroach-go/crdb"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// LoginThrottlePolicy controls how failed logins against a single key are slowed down.
+// After FreeAttempts failures within Window, each further failure locks the key for
+// BaseDelay doubled per extra failure, up to MaxDelay.
+type LoginThrottlePolicy struct {
+	FreeAttempts int
+	BaseDelay    time.Duration
+	MaxDelay     time.Duration
+	Window       time.Duration
+}
+
+func (p LoginThrottlePolicy) lockoutFor(failures int) time.Duration {
+	extra := failures - p.FreeAttempts
+	if extra <= 0 {
+		return 0
+	}
+	delay := p.BaseDelay
+	for i := 1; i < extra; i++ {
+		delay *= 2
+		if delay >= p.MaxDelay {
+			return p.MaxDelay
+		}
+	}
+	return delay
+}
+
+// loginAttemptTimeout is how long an attempt may be in progress before it is assumed to have
+// been abandoned and no longer holds one of the free attempts.
+const loginAttemptTimeout = time.Minute
+
+type dbLoginAttempt struct {
+	Key           string     `db:"key"`
+	Failures      int        `db:"failures"`
+	LastFailureAt *time.Time `db:"last_failure_at"`
+	LockedUntil   *time.Time `db:"locked_until"`
+	Pending       int        `db:"pending"`
+	PendingAt     *time.Time `db:"pending_at"`
+}
+
+// recentFailures returns the failures that still count towards the policy's window.
+func (a *dbLoginAttempt) recentFailures(now time.Time, policy LoginThrottlePolicy) int {
+	if a.LastFailureAt == nil || now.Sub(*a.LastFailureAt) >= policy.Window {
+		return 0
+	}
+	return a.Failures
+}
+
+// inProgress returns the attempts that have started but not finished.
+func (a *dbLoginAttempt) inProgress(now time.Time) int {
+	if a.PendingAt == nil || now.Sub(*a.PendingAt) >= loginAttemptTimeout {
+		return 0
+	}
+	return a.Pending
+}
+
+func (s *CockroachStore) fetchLoginAttempts(tx *sqlx.Tx, keys []string) (map[string]*dbLoginAttempt, error) {
+	sql, args, _ := s.PSQL.Select("key", "failures", "last_failure_at", "locked_until", "pending", "pending_at").
+		From("login_attempts").Where(sq.Eq{"key": keys}).ToSql()
+	attempts := []*dbLoginAttempt{}
+	err := tx.Select(&attempts, sql, args...)
+	if err != nil {
+		return nil, err
+	}
+	results := map[string]*dbLoginAttempt{}
+	for _, key := range keys {
+		results[key] = &dbLoginAttempt{Key: key}
+	}
+	for _, attempt := range attempts {
+		results[attempt.Key] = attempt
+	}
+	return results, nil
+}
+
+func (s *CockroachStore) saveLoginAttempt(tx *sqlx.Tx, attempt *dbLoginAttempt) error {
+	sql, args, _ := s.PSQL.Insert("login_attempts").
+		Columns("key", "failures", "last_failure_at", "locked_until", "pending", "pending_at").
+		Values(attempt.Key, attempt.Failures, attempt.LastFailureAt, attempt.LockedUntil, attempt.Pending, attempt.PendingAt).
+		Suffix("ON CONFLICT (key) DO UPDATE SET failures = excluded.failures, last_failure_at = excluded.last_failure_at, locked_until = excluded.locked_until, pending = excluded.pending, pending_at = excluded.pending_at").ToSql()
+	_, err := tx.Exec(sql, args...)
+	return err
+}
+
+// StartLoginAttempt counts an attempt against each of the keys before the credentials are
+// checked. Attempts in progress use up free attempts, so concurrent guesses cannot all get past
+// the throttle. If any key is locked, or its free attempts are used up by attempts in progress,
+// nothing is counted and the time to wait until is returned. Otherwise the zero time is
+// returned and FinishLoginAttempt must be called with the same keys.
+func (s *CockroachStore) StartLoginAttempt(ctx context.Context, policies map[string]LoginThrottlePolicy) (time.Time, error) {
+	keys := []string{}
+	for key := range policies {
+		keys = append(keys, key)
+	}
+	lockedUntil := time.Time{}
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		lockedUntil = time.Time{}
+		attempts, err := s.fetchLoginAttempts(tx, keys)
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		for key, policy := range policies {
+			attempt := attempts[key]
+			until := time.Time{}
+			if attempt.LockedUntil != nil && attempt.LockedUntil.After(now) {
+				until = *attempt.LockedUntil
+			} else if pending := attempt.inProgress(now); pending > 0 && attempt.recentFailures(now, policy)+pending >= policy.FreeAttempts {
+				until = now.Add(policy.BaseDelay)
+			}
+			if until.After(lockedUntil) {
+				lockedUntil = until
+			}
+		}
+		if !lockedUntil.IsZero() {
+			return nil
+		}
+		for _, key := range keys {
+			attempt := attempts[key]
+			attempt.Pending = attempt.inProgress(now) + 1
+			attempt.PendingAt = &now
+			if err := s.saveLoginAttempt(tx, attempt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return time.Time{}, errors.New(fmt.Sprintf("Error starting login attempt: %+v", err))
+	}
+	return lockedUntil, nil
+}
+
+// FinishLoginAttempt ends an attempt started with StartLoginAttempt. Failed attempts are counted
+// against each key and extend its lockout according to its policy.
+func (s *CockroachStore) FinishLoginAttempt(ctx context.Context, policies map[string]LoginThrottlePolicy, failed bool) error {
+	keys := []string{}
+	for key := range policies {
+		keys = append(keys, key)
+	}
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		attempts, err := s.fetchLoginAttempts(tx, keys)
+		if err != nil {
+			return err
+		}
+		now := time.Now().UTC()
+		for key, policy := range policies {
+			attempt := attempts[key]
+			if pending := attempt.inProgress(now); pending > 0 {
+				attempt.Pending = pending - 1
+			} else {
+				attempt.Pending = 0
+			}
+			if failed {
+				attempt.Failures = attempt.recentFailures(now, policy) + 1
+				attempt.LastFailureAt = &now
+				attempt.LockedUntil = nil
+				if delay := policy.lockoutFor(attempt.Failures); delay > 0 {
+					lockedUntil := now.Add(delay)
+					attempt.LockedUntil = &lockedUntil
+				}
+			}
+			if err := s.saveLoginAttempt(tx, attempt); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error finishing login attempt: %+v", err))
+	}
+	return nil
+}
+
+// ClearLoginAttempts forgets the failure history of the keys, lifting any lockout.
+func (s *CockroachStore) ClearLoginAttempts(ctx context.Context, keys []string) error {
+	sql, args, _ := s.PSQL.Delete("login_attempts").Where(sq.Eq{"key": keys}).ToSql()
+	_, err := s.DB.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error clearing login attempts: %+v", err))
+	}
+	return nil
+}
+
+// FetchLoginLockouts returns the keys that are currently locked out.
+func (s *CockroachStore) FetchLoginLockouts(ctx context.Context) ([]*rcjpb.LoginLockout, error) {
+	sql, args, _ := s.PSQL.Select("key", "failures", "last_failure_at", "locked_until", "pending", "pending_at").
+		From("login_attempts").Where(sq.Gt{"locked_until": time.Now().UTC()}).
+		OrderBy("locked_until DESC").ToSql()
+	attempts := []dbLoginAttempt{}
+	err := s.DB.SelectContext(ctx, &attempts, sql, args...)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching login lockouts: %+v", err))
+	}
+	results := make([]*rcjpb.LoginLockout, len(attempts))
+	for idx, attempt := range attempts {
+		lockout := &rcjpb.LoginLockout{
+			Key:      attempt.Key,
+			Failures: int32(attempt.Failures),
+		}
+		if attempt.LastFailureAt != nil {
+			lockout.LastFailureAt = &tspb.Timestamp{
+				Seconds: attempt.LastFailureAt.Unix(),
+				Nanos:   int32(attempt.LastFailureAt.Nanosecond()),
+			}
+		}
+		if attempt.LockedUntil != nil {
+			lockout.LockedUntil = &tspb.Timestamp{
+				Seconds: attempt.LockedUntil.Unix(),
+				Nanos:   int32(attempt.LockedUntil.Nanosecond()),
+			}
+		}
+		results[idx] = lockout
+	}
+	return results, nil
+}
@@ -0,0 +1,99 @@
+package cockroach
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+)
+
+var testLoginPolicy = LoginThrottlePolicy{
+	FreeAttempts: 3,
+	BaseDelay:    time.Minute,
+	MaxDelay:     time.Hour,
+	Window:       time.Hour,
+}
+
+func TestStartLoginAttemptLimitsConcurrentAttempts(t *testing.T) {
+	store, cleanup := testStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	policies := map[string]LoginThrottlePolicy{"user:jsmith": testLoginPolicy}
+	var wg sync.WaitGroup
+	var mutex sync.Mutex
+	started := 0
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			lockedUntil, err := store.StartLoginAttempt(ctx, policies)
+			if err != nil {
+				t.Errorf("Error starting login attempt: %+v", err)
+				return
+			}
+			if lockedUntil.IsZero() {
+				mutex.Lock()
+				started++
+				mutex.Unlock()
+			}
+		}()
+	}
+	wg.Wait()
+	if started != testLoginPolicy.FreeAttempts {
+		t.Fatalf("Expected %d attempts to start, got %d", testLoginPolicy.FreeAttempts, started)
+	}
+	// Finishing an attempt successfully frees it for the next caller
+	if err := store.FinishLoginAttempt(ctx, policies, false); err != nil {
+		t.Fatalf("Error finishing login attempt: %+v", err)
+	}
+	lockedUntil, err := store.StartLoginAttempt(ctx, policies)
+	if err != nil {
+		t.Fatalf("Error starting login attempt: %+v", err)
+	}
+	if !lockedUntil.IsZero() {
+		t.Errorf("Expected an attempt to start once one finished, got a wait until %s", lockedUntil)
+	}
+}
+
+func TestFinishLoginAttemptLocksOut(t *testing.T) {
+	store, cleanup := testStore(t)
+	defer cleanup()
+	ctx := context.Background()
+	policies := map[string]LoginThrottlePolicy{"user:jsmith": testLoginPolicy, "ip:192.0.2.1": testLoginPolicy}
+	for i := 0; i <= testLoginPolicy.FreeAttempts; i++ {
+		lockedUntil, err := store.StartLoginAttempt(ctx, policies)
+		if err != nil {
+			t.Fatalf("Error starting login attempt: %+v", err)
+		}
+		if !lockedUntil.IsZero() {
+			t.Fatalf("Expected attempt %d to start, got a wait until %s", i, lockedUntil)
+		}
+		if err := store.FinishLoginAttempt(ctx, policies, true); err != nil {
+			t.Fatalf("Error finishing login attempt: %+v", err)
+		}
+	}
+	lockedUntil, err := store.StartLoginAttempt(ctx, policies)
+	if err != nil {
+		t.Fatalf("Error starting login attempt: %+v", err)
+	}
+	if lockedUntil.Before(time.Now().Add(testLoginPolicy.BaseDelay - time.Second)) {
+		t.Errorf("Expected a lockout of %s, got a wait until %s", testLoginPolicy.BaseDelay, lockedUntil)
+	}
+	lockouts, err := store.FetchLoginLockouts(ctx)
+	if err != nil {
+		t.Fatalf("Error fetching lockouts: %+v", err)
+	}
+	if len(lockouts) != 2 {
+		t.Errorf("Expected both keys to be locked out, got %+v", lockouts)
+	}
+	if err := store.ClearLoginAttempts(ctx, []string{"user:jsmith", "ip:192.0.2.1"}); err != nil {
+		t.Fatalf("Error clearing login attempts: %+v", err)
+	}
+	lockedUntil, err = store.StartLoginAttempt(ctx, policies)
+	if err != nil {
+		t.Fatalf("Error starting login attempt: %+v", err)
+	}
+	if !lockedUntil.IsZero() {
+		t.Errorf("Expected the lockout to be lifted, got a wait until %s", lockedUntil)
+	}
+}
@@ -0,0 +1,105 @@
+package cockroach
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/cockroach-go/crdb"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/elithrar/simple-scrypt"
+	"github.com/golang/protobuf/proto"
+	"github.com/jmoiron/sqlx"
+	"math/big"
+)
+
+// temporaryPasswordAlphabet omits characters that are easily confused when read aloud or written down.
+const temporaryPasswordAlphabet = "abcdefghjkmnpqrstuvwxyzABCDEFGHJKMNPQRSTUVWXYZ23456789"
+
+const temporaryPasswordLength = 12
+
+// GenerateTemporaryPassword returns a random password suitable for handing out to a new or reset user.
+func GenerateTemporaryPassword() (string, error) {
+	b := make([]byte, temporaryPasswordLength)
+	max := big.NewInt(int64(len(temporaryPasswordAlphabet)))
+	for idx := range b {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", err
+		}
+		b[idx] = temporaryPasswordAlphabet[n.Int64()]
+	}
+	return string(b), nil
+}
+
+// setPassword stores a new password hash for the user and records the change.
+func (s *CockroachStore) setPassword(ctx context.Context, tx *sqlx.Tx, userID, password string, mustChange bool) error {
+	before, err := s.FetchUser(userID, tx)
+	if err != nil {
+		return err
+	}
+	hash, err := scrypt.GenerateFromPassword([]byte(password), scrypt.DefaultParams)
+	if err != nil {
+		return err
+	}
+	sql, args, _ := s.PSQL.Update("users").SetMap(map[string]interface{}{
+		"hashed_password":      string(hash),
+		"must_change_password": mustChange,
+	}).Where(sq.Eq{"id": userID}).ToSql()
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		return err
+	}
+	after := proto.Clone(before).(*rcjpb.User)
+	after.MustChangePassword = mustChange
+	return s.recordAudit(ctx, tx, "User", userID, before, after)
+}
+
+// ChangePassword replaces the user's password if currentPassword matches, clearing any forced change.
+// It reports false if the current password is wrong.
+func (s *CockroachStore) ChangePassword(ctx context.Context, userID, currentPassword, newPassword string) (bool, error) {
+	changed := false
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		changed = false
+		sql, args, _ := s.PSQL.Select("hashed_password").From("users").Where(sq.Eq{"id": userID}).ToSql()
+		var hash string
+		err := tx.Get(&hash, sql, args...)
+		if err != nil {
+			return err
+		}
+		if hash == "" || scrypt.CompareHashAndPassword([]byte(hash), []byte(currentPassword)) != nil {
+			return nil
+		}
+		err = s.setPassword(ctx, tx, userID, newPassword, false)
+		if err != nil {
+			return err
+		}
+		changed = true
+		return nil
+	})
+	if err != nil {
+		return false, errors.New(fmt.Sprintf("Error changing password: %+v", err))
+	}
+	return changed, nil
+}
+
+// ResetUserPassword replaces the user's password with a random temporary one that must be changed
+// at next login, and ends the user's existing sessions. The temporary password is returned.
+func (s *CockroachStore) ResetUserPassword(ctx context.Context, userID string) (string, error) {
+	password, err := GenerateTemporaryPassword()
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Error generating password: %+v", err))
+	}
+	err = crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		err := s.setPassword(ctx, tx, userID, password, true)
+		if err != nil {
+			return err
+		}
+		return s.revokeUserSessions(tx, userID)
+	})
+	if err != nil {
+		return "", errors.New(fmt.Sprintf("Error resetting password: %+v", err))
+	}
+	return password, nil
+}
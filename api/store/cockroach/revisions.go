@@ -0,0 +1,153 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/golang/protobuf/jsonpb"
+	"github.com/golang/protobuf/proto"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/jmoiron/sqlx"
+	"strings"
+	"time"
+)
+
+var revisionUnmarshaler = &jsonpb.Unmarshaler{AllowUnknownFields: true}
+
+// recordRevision keeps a copy of the score sheet as saved, attributed to the context's audit
+// actor, as part of the transaction that saved it.
+func (s *CockroachStore) recordRevision(ctx context.Context, tx *sqlx.Tx, scoreSheet *rcjpb.ScoreSheet) error {
+	actor, _ := ctx.Value(auditContextKey{}).(auditActor)
+	snapshot, err := auditSnapshot(scoreSheet)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error recording revision: %+v", err))
+	}
+	var savedBy interface{}
+	if actor.userID != "" {
+		savedBy = actor.userID
+	}
+	sql, args, _ := s.PSQL.Insert("score_sheet_revisions").
+		Columns("score_sheet", "version", "saved_by", "snapshot").
+		Values(scoreSheet.GetId(), scoreSheet.GetVersion(), savedBy, snapshot).ToSql()
+	_, err = tx.Exec(sql, args...)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error recording revision: %+v", err))
+	}
+	return nil
+}
+
+// FetchScoreSheetRevisions returns every saved revision of the score sheet, oldest first. Each
+// revision lists what changed since the one before it.
+func (s *CockroachStore) FetchScoreSheetRevisions(ctx context.Context, scoreSheetID string, txx *sqlx.Tx) ([]*rcjpb.ScoreSheetRevision, error) {
+	sql, args, _ := s.PSQL.Select(
+		"score_sheet_revisions.id as id",
+		"score_sheet_revisions.version as version",
+		"score_sheet_revisions.saved_by as saved_by",
+		"users.name as saved_by_name",
+		"score_sheet_revisions.saved_at as saved_at",
+		"score_sheet_revisions.snapshot::STRING as snapshot",
+	).From("score_sheet_revisions").
+		LeftJoin("users ON users.id = score_sheet_revisions.saved_by").
+		Where(sq.Eq{"score_sheet_revisions.score_sheet": scoreSheetID}).
+		OrderBy("score_sheet_revisions.version").ToSql()
+	type dbRevision struct {
+		ID          string    `db:"id"`
+		Version     int32     `db:"version"`
+		SavedBy     *string   `db:"saved_by"`
+		SavedByName *string   `db:"saved_by_name"`
+		SavedAt     time.Time `db:"saved_at"`
+		Snapshot    string    `db:"snapshot"`
+	}
+	entries := []*dbRevision{}
+	var err error
+	if txx != nil {
+		err = txx.Select(&entries, sql, args...)
+	} else {
+		err = s.DB.SelectContext(ctx, &entries, sql, args...)
+	}
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching revisions: %+v", err))
+	}
+	revisions := make([]*rcjpb.ScoreSheetRevision, len(entries))
+	var previous *rcjpb.ScoreSheet
+	for idx, entry := range entries {
+		saved := &rcjpb.ScoreSheet{}
+		err = revisionUnmarshaler.Unmarshal(strings.NewReader(entry.Snapshot), saved)
+		if err != nil {
+			return nil, errors.New(fmt.Sprintf("Error reading revision %s: %+v", entry.ID, err))
+		}
+		revision := &rcjpb.ScoreSheetRevision{
+			Id:           entry.ID,
+			ScoreSheetId: scoreSheetID,
+			Version:      entry.Version,
+			SavedAt: &tspb.Timestamp{
+				Seconds: entry.SavedAt.Unix(),
+				Nanos:   int32(entry.SavedAt.Nanosecond()),
+			},
+			ScoreSheet: saved,
+		}
+		if entry.SavedBy != nil {
+			revision.SavedBy = &rcjpb.User{
+				Id: *entry.SavedBy,
+			}
+			if entry.SavedByName != nil {
+				revision.SavedBy.Name = *entry.SavedByName
+			}
+		}
+		if previous != nil {
+			diffRevision(revision, previous, saved)
+		}
+		revisions[idx] = revision
+		previous = saved
+	}
+	return revisions, nil
+}
+
+// diffRevision fills in what changed between the previous and current copies of a sheet. Sections
+// are matched by their score sheet section ID; a section missing from either side counts as 0.
+func diffRevision(revision *rcjpb.ScoreSheetRevision, previous, current *rcjpb.ScoreSheet) {
+	previousSections := map[string]*rcjpb.ScoreSheetSection{}
+	for _, section := range previous.GetSections() {
+		previousSections[section.GetId()] = section
+	}
+	for _, section := range current.GetSections() {
+		previousSection, ok := previousSections[section.GetId()]
+		delete(previousSections, section.GetId())
+		if ok && previousSection.GetValue() == section.GetValue() {
+			continue
+		}
+		revision.SectionChanges = append(revision.SectionChanges, &rcjpb.ScoreSheetRevision_SectionChange{
+			SectionId:     section.GetId(),
+			Title:         section.GetTitle(),
+			PreviousValue: previousSection.GetValue(),
+			Value:         section.GetValue(),
+		})
+	}
+	for _, section := range previous.GetSections() {
+		if _, removed := previousSections[section.GetId()]; removed {
+			revision.SectionChanges = append(revision.SectionChanges, &rcjpb.ScoreSheetRevision_SectionChange{
+				SectionId:     section.GetId(),
+				Title:         section.GetTitle(),
+				PreviousValue: section.GetValue(),
+			})
+		}
+	}
+	revision.CommentsChanged = previous.GetComments() != current.GetComments()
+	revision.TimingsChanged = !timingsEqual(previous.GetTimings(), current.GetTimings())
+	revision.TeamChanged = previous.GetTeam().GetId() != current.GetTeam().GetId()
+	revision.StatusChanged = previous.GetStatus() != current.GetStatus()
+}
+
+func timingsEqual(a, b []*rcjpb.ScoreSheet_Timing) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for idx := range a {
+		if !proto.Equal(a[idx], b[idx]) {
+			return false
+		}
+	}
+	return true
+}
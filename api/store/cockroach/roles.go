@@ -0,0 +1,94 @@
+package cockroach
+
+import (
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/jmoiron/sqlx"
+)
+
+var roleNames = map[rcjpb.User_Role]string{
+	rcjpb.User_ADMIN:         "Admin",
+	rcjpb.User_HEAD_JUDGE:    "Head Judge",
+	rcjpb.User_JUDGE:         "Judge",
+	rcjpb.User_CHECKIN_AGENT: "Check-in Agent",
+	rcjpb.User_VIEWER:        "Viewer",
+}
+
+func roleFromString(name string) rcjpb.User_Role {
+	for role, roleName := range roleNames {
+		if roleName == name {
+			return role
+		}
+	}
+	return rcjpb.User_VIEWER
+}
+
+// userRoles returns the roles to persist for a user. The legacy is_admin flag implies the admin role.
+func userRoles(user *rcjpb.User) []rcjpb.User_Role {
+	roles := []rcjpb.User_Role{}
+	seen := map[rcjpb.User_Role]bool{}
+	for _, role := range user.GetRoles() {
+		if !seen[role] {
+			seen[role] = true
+			roles = append(roles, role)
+		}
+	}
+	if user.GetIsAdmin() && !seen[rcjpb.User_ADMIN] {
+		roles = append(roles, rcjpb.User_ADMIN)
+	}
+	return roles
+}
+
+func hasRole(roles []rcjpb.User_Role, role rcjpb.User_Role) bool {
+	for _, r := range roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchUserRoles returns the roles of each of the given users keyed by user ID.
+func (s *CockroachStore) fetchUserRoles(userIDs []string, txx *sqlx.Tx) (map[string][]rcjpb.User_Role, error) {
+	sql, args, _ := s.PSQL.Select("user_id", "role").From("user_roles").
+		Where(sq.Eq{"user_id": userIDs}).ToSql()
+	dbRoles := []struct {
+		UserID string `db:"user_id"`
+		Role   string `db:"role"`
+	}{}
+	var err error
+	if txx != nil {
+		err = txx.Select(&dbRoles, sql, args...)
+	} else {
+		err = s.DB.Select(&dbRoles, sql, args...)
+	}
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching roles: %+v", err))
+	}
+	results := map[string][]rcjpb.User_Role{}
+	for _, dbRole := range dbRoles {
+		results[dbRole.UserID] = append(results[dbRole.UserID], roleFromString(dbRole.Role))
+	}
+	return results, nil
+}
+
+// setUserRoles replaces the roles held by a user.
+func (s *CockroachStore) setUserRoles(txx *sqlx.Tx, userID string, roles []rcjpb.User_Role) error {
+	delSql, delArgs, _ := s.PSQL.Delete("user_roles").Where(sq.Eq{"user_id": userID}).ToSql()
+	_, err := txx.Exec(delSql, delArgs...)
+	if err != nil {
+		return err
+	}
+	if len(roles) == 0 {
+		return nil
+	}
+	insertQuery := s.PSQL.Insert("user_roles").Columns("user_id", "role")
+	for _, role := range roles {
+		insertQuery = insertQuery.Values(userID, roleNames[role])
+	}
+	insertSql, insertArgs, _ := insertQuery.ToSql()
+	_, err = txx.Exec(insertSql, insertArgs...)
+	return err
+}
@@ -0,0 +1,243 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/cockroach-go/crdb"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	tspb "github.com/golang/protobuf/ptypes/timestamp"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+var scoreSheetStatusNames = map[rcjpb.ScoreSheet_Status]string{
+	rcjpb.ScoreSheet_DRAFT:     "Draft",
+	rcjpb.ScoreSheet_SUBMITTED: "Submitted",
+	rcjpb.ScoreSheet_LOCKED:    "Locked",
+}
+
+// CountedScoreSheets restricts a query on score_sheets to the submitted and locked sheets that
+// count toward ladders and exports. It has no placeholders so it can be used in nested queries.
+const CountedScoreSheets = "score_sheets.status IN ('Submitted', 'Locked')"
+
+func scoreSheetStatus(name string) rcjpb.ScoreSheet_Status {
+	for status, statusName := range scoreSheetStatusNames {
+		if statusName == name {
+			return status
+		}
+	}
+	return rcjpb.ScoreSheet_DRAFT
+}
+
+// FetchRoundLocks returns every lock taken on the division's rounds, including those since
+// unlocked, most recent first.
+func (s *CockroachStore) FetchRoundLocks(ctx context.Context, divisionID string, txx *sqlx.Tx) ([]*rcjpb.RoundLock, error) {
+	sql, args, _ := s.PSQL.Select(
+		"round_locks.id as id",
+		"round_locks.division as division",
+		"round_locks.round as round",
+		"round_locks.locked_by as locked_by",
+		"locker.name as locked_by_name",
+		"round_locks.locked_at as locked_at",
+		"round_locks.unlocked_by as unlocked_by",
+		"unlocker.name as unlocked_by_name",
+		"round_locks.unlocked_at as unlocked_at",
+		"COALESCE(round_locks.unlock_reason, '') as unlock_reason",
+	).From("round_locks").
+		Join("users locker ON locker.id = round_locks.locked_by").
+		LeftJoin("users unlocker ON unlocker.id = round_locks.unlocked_by").
+		Where(sq.Eq{"round_locks.division": divisionID}).
+		OrderBy("round_locks.locked_at DESC").ToSql()
+	type dbRoundLock struct {
+		ID             string     `db:"id"`
+		Division       string     `db:"division"`
+		Round          int32      `db:"round"`
+		LockedBy       string     `db:"locked_by"`
+		LockedByName   string     `db:"locked_by_name"`
+		LockedAt       time.Time  `db:"locked_at"`
+		UnlockedBy     *string    `db:"unlocked_by"`
+		UnlockedByName *string    `db:"unlocked_by_name"`
+		UnlockedAt     *time.Time `db:"unlocked_at"`
+		UnlockReason   string     `db:"unlock_reason"`
+	}
+	entries := []*dbRoundLock{}
+	var err error
+	if txx != nil {
+		err = txx.Select(&entries, sql, args...)
+	} else {
+		err = s.DB.SelectContext(ctx, &entries, sql, args...)
+	}
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching round locks: %+v", err))
+	}
+	locks := make([]*rcjpb.RoundLock, len(entries))
+	for idx, entry := range entries {
+		lock := &rcjpb.RoundLock{
+			Id:         entry.ID,
+			DivisionId: entry.Division,
+			Round:      entry.Round,
+			LockedBy: &rcjpb.User{
+				Id:   entry.LockedBy,
+				Name: entry.LockedByName,
+			},
+			LockedAt: &tspb.Timestamp{
+				Seconds: entry.LockedAt.Unix(),
+				Nanos:   int32(entry.LockedAt.Nanosecond()),
+			},
+			UnlockReason: entry.UnlockReason,
+		}
+		if entry.UnlockedBy != nil {
+			lock.UnlockedBy = &rcjpb.User{
+				Id: *entry.UnlockedBy,
+			}
+			if entry.UnlockedByName != nil {
+				lock.UnlockedBy.Name = *entry.UnlockedByName
+			}
+		}
+		if entry.UnlockedAt != nil {
+			lock.UnlockedAt = &tspb.Timestamp{
+				Seconds: entry.UnlockedAt.Unix(),
+				Nanos:   int32(entry.UnlockedAt.Nanosecond()),
+			}
+		}
+		locks[idx] = lock
+	}
+	return locks, nil
+}
+
+// activeRoundLock returns the ID of the lock currently held on the division round, or an empty
+// string if the round is open.
+func (s *CockroachStore) activeRoundLock(tx *sqlx.Tx, divisionID string, round int32) (string, error) {
+	sql, args, _ := s.PSQL.Select("id").From("round_locks").
+		Where(sq.Eq{"division": divisionID, "round": round, "unlocked_at": nil}).ToSql()
+	ids := []string{}
+	err := tx.Select(&ids, sql, args...)
+	if err != nil || len(ids) == 0 {
+		return "", err
+	}
+	return ids[0], nil
+}
+
+// checkRoundOpen returns a ConflictError if the division round is locked.
+func (s *CockroachStore) checkRoundOpen(tx *sqlx.Tx, divisionID string, round int32) error {
+	lockID, err := s.activeRoundLock(tx, divisionID, round)
+	if err != nil {
+		return err
+	}
+	if lockID != "" {
+		return &ConflictError{
+			Message: fmt.Sprintf("Round %d of the division is locked", round),
+		}
+	}
+	return nil
+}
+
+func (s *CockroachStore) fetchRoundLock(ctx context.Context, tx *sqlx.Tx, divisionID, lockID string) (*rcjpb.RoundLock, error) {
+	locks, err := s.FetchRoundLocks(ctx, divisionID, tx)
+	if err != nil {
+		return nil, err
+	}
+	for _, lock := range locks {
+		if lock.GetId() == lockID {
+			return lock, nil
+		}
+	}
+	return nil, ErrNotFound
+}
+
+// LockRound finalises a division round. Submitted sheets in the round become locked and no
+// sheets in the round can be created, updated or deleted until it is unlocked. Drafts are left
+// as they are and no longer count.
+func (s *CockroachStore) LockRound(ctx context.Context, divisionID string, round int32, userID string) (*rcjpb.RoundLock, error) {
+	var lock *rcjpb.RoundLock
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		err := s.checkRoundOpen(tx, divisionID, round)
+		if err != nil {
+			return err
+		}
+		var lockID string
+		lockSql, lockArgs, _ := s.PSQL.Insert("round_locks").
+			Columns("division", "round", "locked_by").
+			Values(divisionID, round, userID).
+			Suffix("RETURNING \"id\"").ToSql()
+		err = tx.Get(&lockID, lockSql, lockArgs...)
+		if err != nil {
+			return err
+		}
+		sheetSql, sheetArgs, _ := s.PSQL.Update("score_sheets").
+			Set("status", scoreSheetStatusNames[rcjpb.ScoreSheet_LOCKED]).
+			Set("version", sq.Expr("version + 1")).
+			Set("updated_at", sq.Expr("current_timestamp()")).
+			Where(sq.Eq{
+				"division":   divisionID,
+				"round":      round,
+				"status":     scoreSheetStatusNames[rcjpb.ScoreSheet_SUBMITTED],
+				"deleted_at": nil,
+			}).ToSql()
+		_, err = tx.Exec(sheetSql, sheetArgs...)
+		if err != nil {
+			return err
+		}
+		lock, err = s.fetchRoundLock(ctx, tx, divisionID, lockID)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "RoundLock", lockID, nil, lock)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
+
+// UnlockRound reopens a locked division round, recording who unlocked it and why. Locked sheets
+// in the round return to submitted.
+func (s *CockroachStore) UnlockRound(ctx context.Context, divisionID string, round int32, userID, reason string) (*rcjpb.RoundLock, error) {
+	var lock *rcjpb.RoundLock
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		lockID, err := s.activeRoundLock(tx, divisionID, round)
+		if err != nil {
+			return err
+		}
+		if lockID == "" {
+			return ErrNotFound
+		}
+		original, err := s.fetchRoundLock(ctx, tx, divisionID, lockID)
+		if err != nil {
+			return err
+		}
+		lockSql, lockArgs, _ := s.PSQL.Update("round_locks").SetMap(map[string]interface{}{
+			"unlocked_by":   userID,
+			"unlocked_at":   sq.Expr("current_timestamp()"),
+			"unlock_reason": reason,
+		}).Where(sq.Eq{"id": lockID}).ToSql()
+		_, err = tx.Exec(lockSql, lockArgs...)
+		if err != nil {
+			return err
+		}
+		sheetSql, sheetArgs, _ := s.PSQL.Update("score_sheets").
+			Set("status", scoreSheetStatusNames[rcjpb.ScoreSheet_SUBMITTED]).
+			Set("version", sq.Expr("version + 1")).
+			Set("updated_at", sq.Expr("current_timestamp()")).
+			Where(sq.Eq{
+				"division": divisionID,
+				"round":    round,
+				"status":   scoreSheetStatusNames[rcjpb.ScoreSheet_LOCKED],
+			}).ToSql()
+		_, err = tx.Exec(sheetSql, sheetArgs...)
+		if err != nil {
+			return err
+		}
+		lock, err = s.fetchRoundLock(ctx, tx, divisionID, lockID)
+		if err != nil {
+			return err
+		}
+		return s.recordAudit(ctx, tx, "RoundLock", lockID, original, lock)
+	})
+	if err != nil {
+		return nil, err
+	}
+	return lock, nil
+}
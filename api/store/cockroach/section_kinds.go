@@ -0,0 +1,159 @@
+package cockroach
+
+import (
+	"encoding/json"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/jmoiron/sqlx/types"
+	"math"
+)
+
+// WeightedScore is the SQL expression for the points a score sheet section contributes to the
+// sheet's total. It requires score_sheet_sections to be joined to score_sheet_template_sections.
+const WeightedScore = "score_sheet_sections.value * score_sheet_template_sections.multiplier * " +
+	"(CASE WHEN score_sheet_template_sections.kind = 'Penalty' THEN -score_sheet_template_sections.points " +
+	"ELSE score_sheet_template_sections.points END)"
+
+var sectionKindNames = map[rcjpb.ScoreSheetTemplateSection_Kind]string{
+	rcjpb.ScoreSheetTemplateSection_NUMERIC:  "Numeric",
+	rcjpb.ScoreSheetTemplateSection_CHECKBOX: "Checkbox",
+	rcjpb.ScoreSheetTemplateSection_SCALE:    "Scale",
+	rcjpb.ScoreSheetTemplateSection_COUNT:    "Count",
+	rcjpb.ScoreSheetTemplateSection_PENALTY:  "Penalty",
+}
+
+func sectionKind(name string) rcjpb.ScoreSheetTemplateSection_Kind {
+	for kind, kindName := range sectionKindNames {
+		if kindName == name {
+			return kind
+		}
+	}
+	return rcjpb.ScoreSheetTemplateSection_NUMERIC
+}
+
+// SectionWeight is the number of points each unit of a section's value is worth, matching WeightedScore.
+func SectionWeight(kind rcjpb.ScoreSheetTemplateSection_Kind, multiplier int32, points float64) float64 {
+	if kind == rcjpb.ScoreSheetTemplateSection_PENALTY {
+		return -points * float64(multiplier)
+	}
+	return points * float64(multiplier)
+}
+
+type dbLevel struct {
+	Label string  `json:"label"`
+	Value float64 `json:"value"`
+}
+
+func marshalLevels(levels []*rcjpb.ScoreSheetTemplateSection_Level) string {
+	dbLevels := make([]dbLevel, len(levels))
+	for idx, level := range levels {
+		dbLevels[idx] = dbLevel{
+			Label: level.GetLabel(),
+			Value: level.GetValue(),
+		}
+	}
+	b, _ := json.Marshal(dbLevels)
+	return string(b)
+}
+
+func unmarshalLevels(text types.JSONText) []*rcjpb.ScoreSheetTemplateSection_Level {
+	dbLevels := []dbLevel{}
+	text.Unmarshal(&dbLevels)
+	var levels []*rcjpb.ScoreSheetTemplateSection_Level
+	for _, level := range dbLevels {
+		levels = append(levels, &rcjpb.ScoreSheetTemplateSection_Level{
+			Label: level.Label,
+			Value: level.Value,
+		})
+	}
+	return levels
+}
+
+// templateSectionColumns are the columns written by templateSectionValues.
+var templateSectionColumns = []string{
+	"title",
+	"score_sheet_template",
+	"description",
+	"max_value",
+	"multiplier",
+	"display_order",
+	"version",
+	"previous_section",
+	"kind",
+	"step",
+	"points",
+	"levels",
+}
+
+// templateSectionValues returns the values of a template section row. Parameters that do not
+// apply to the section's kind are stored as their defaults, checkboxes always have a maximum of 1
+// and scales take their maximum from the highest level.
+func templateSectionValues(section *rcjpb.ScoreSheetTemplateSection, templateID string, displayOrder, version int32, previousSection interface{}) []interface{} {
+	maxValue := section.GetMaxValue()
+	step := 0.0
+	points := 1.0
+	levels := []*rcjpb.ScoreSheetTemplateSection_Level{}
+	switch section.GetKind() {
+	case rcjpb.ScoreSheetTemplateSection_NUMERIC:
+		step = section.GetStep()
+	case rcjpb.ScoreSheetTemplateSection_CHECKBOX:
+		maxValue = 1
+		points = section.GetPoints()
+	case rcjpb.ScoreSheetTemplateSection_SCALE:
+		levels = section.GetLevels()
+		highest := 0.0
+		for _, level := range levels {
+			highest = math.Max(highest, level.GetValue())
+		}
+		maxValue = int32(math.Ceil(highest))
+	case rcjpb.ScoreSheetTemplateSection_COUNT, rcjpb.ScoreSheetTemplateSection_PENALTY:
+		points = section.GetPoints()
+	}
+	return []interface{}{
+		section.GetTitle(),
+		templateID,
+		section.GetDescription(),
+		maxValue,
+		section.GetMultiplier(),
+		displayOrder,
+		version,
+		previousSection,
+		sectionKindNames[section.GetKind()],
+		step,
+		points,
+		marshalLevels(levels),
+	}
+}
+
+// migratedValueTolerance absorbs floating point error when comparing section values.
+const migratedValueTolerance = 1e-6
+
+// migratedValue maps a score onto a section following the rules of the section's kind. Numeric,
+// count and penalty scores above the maximum are capped and numeric scores are rounded down to
+// the section's step. Checkboxes and scales only accept values they could have been scored with.
+// ok is false if the score cannot be represented on the section.
+func migratedValue(section *rcjpb.ScoreSheetTemplateSection, value float64) (migrated float64, ok bool) {
+	if value < 0 {
+		return 0, false
+	}
+	switch section.GetKind() {
+	case rcjpb.ScoreSheetTemplateSection_CHECKBOX:
+		return value, value == 0 || value == 1
+	case rcjpb.ScoreSheetTemplateSection_SCALE:
+		for _, level := range section.GetLevels() {
+			if math.Abs(level.GetValue()-value) < migratedValueTolerance {
+				return value, true
+			}
+		}
+		return 0, false
+	case rcjpb.ScoreSheetTemplateSection_COUNT, rcjpb.ScoreSheetTemplateSection_PENALTY:
+		if value != math.Trunc(value) {
+			return 0, false
+		}
+		return math.Min(value, float64(section.GetMaxValue())), true
+	}
+	migrated = math.Min(value, float64(section.GetMaxValue()))
+	if step := section.GetStep(); step > 0 {
+		migrated = math.Floor(migrated/step+migratedValueTolerance) * step
+	}
+	return migrated, true
+}
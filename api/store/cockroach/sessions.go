@@ -0,0 +1,88 @@
+package cockroach
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// newSessionToken returns a random opaque token. Only its hash is persisted.
+func newSessionToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func hashSessionToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+// CreateSession issues a new session for the user that expires after the given duration.
+// The returned token is the only copy of the secret and must be handed to the client.
+func (s *CockroachStore) CreateSession(ctx context.Context, userID string, duration time.Duration) (string, time.Time, error) {
+	token, err := newSessionToken()
+	if err != nil {
+		return "", time.Time{}, errors.New(fmt.Sprintf("Error generating session token: %+v", err))
+	}
+	expiresAt := time.Now().UTC().Add(duration)
+	sql, args, _ := s.PSQL.Insert("sessions").
+		Columns("user_id", "token_hash", "expires_at").
+		Values(userID, hashSessionToken(token), expiresAt).ToSql()
+	_, err = s.DB.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return "", time.Time{}, errors.New(fmt.Sprintf("Error creating session: %+v", err))
+	}
+	return token, expiresAt, nil
+}
+
+// FetchSessionUser returns the user owning an unexpired, unrevoked session, or nil if the token is not valid.
+func (s *CockroachStore) FetchSessionUser(ctx context.Context, token string) (*rcjpb.User, error) {
+	if token == "" {
+		return nil, nil
+	}
+	sql, args, _ := s.PSQL.Select("user_id").From("sessions").
+		Where(sq.Eq{"token_hash": hashSessionToken(token), "revoked_at": nil}).
+		Where("expires_at > current_timestamp()").
+		Where("user_id IN (SELECT id FROM users WHERE deleted_at IS NULL)").ToSql()
+	userIDs := []string{}
+	err := s.DB.SelectContext(ctx, &userIDs, sql, args...)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching session: %+v", err))
+	}
+	if len(userIDs) == 0 {
+		return nil, nil
+	}
+	return s.FetchUser(userIDs[0], nil)
+}
+
+// RevokeSession invalidates the session identified by the token. Unknown tokens are ignored.
+func (s *CockroachStore) RevokeSession(ctx context.Context, token string) error {
+	sql, args, _ := s.PSQL.Update("sessions").
+		Set("revoked_at", sq.Expr("current_timestamp()")).
+		Where(sq.Eq{"token_hash": hashSessionToken(token), "revoked_at": nil}).ToSql()
+	_, err := s.DB.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return errors.New(fmt.Sprintf("Error revoking session: %+v", err))
+	}
+	return nil
+}
+
+// revokeUserSessions invalidates every active session belonging to the user.
+func (s *CockroachStore) revokeUserSessions(txx *sqlx.Tx, userID string) error {
+	sql, args, _ := s.PSQL.Update("sessions").
+		Set("revoked_at", sq.Expr("current_timestamp()")).
+		Where(sq.Eq{"user_id": userID, "revoked_at": nil}).ToSql()
+	_, err := txx.Exec(sql, args...)
+	return err
+}
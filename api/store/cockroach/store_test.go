@@ -0,0 +1,77 @@
+package cockroach
+
+import (
+	"context"
+	"fmt"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/jmoiron/sqlx"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"testing"
+	"time"
+)
+
+// testDatabaseVariable names the environment variable holding the connection string of a
+// CockroachDB cluster to run the store tests against, such as
+// postgresql://root@localhost:26257/?sslmode=disable. The tests are skipped when it is not set.
+const testDatabaseVariable = "RCJ_TEST_DATABASE"
+
+// testStore returns a store backed by a new database loaded with tables.sql, and a function that
+// drops the database again.
+func testStore(t *testing.T) (*CockroachStore, func()) {
+	connection := os.Getenv(testDatabaseVariable)
+	if connection == "" {
+		t.Skipf("%s is not set", testDatabaseVariable)
+	}
+	admin, err := sqlx.Connect("postgres", connection)
+	if err != nil {
+		t.Fatalf("Error connecting to test cluster: %+v", err)
+	}
+	name := fmt.Sprintf("rcj_test_%d", time.Now().UnixNano())
+	if _, err = admin.Exec(fmt.Sprintf("CREATE DATABASE %s", name)); err != nil {
+		admin.Close()
+		t.Fatalf("Error creating test database: %+v", err)
+	}
+	cleanup := func() {
+		admin.Exec(fmt.Sprintf("DROP DATABASE %s CASCADE", name))
+		admin.Close()
+	}
+	u, err := url.Parse(connection)
+	if err != nil {
+		cleanup()
+		t.Fatalf("Error parsing %s: %+v", testDatabaseVariable, err)
+	}
+	u.Path = "/" + name
+	store, err := NewCockroachStore(u.String())
+	if err != nil {
+		cleanup()
+		t.Fatalf("Error connecting to test database: %+v", err)
+	}
+	schema, err := ioutil.ReadFile("../../../tables.sql")
+	if err == nil {
+		_, err = store.DB.Exec(string(schema))
+	}
+	if err != nil {
+		store.DB.Close()
+		cleanup()
+		t.Fatalf("Error loading schema: %+v", err)
+	}
+	return store, func() {
+		store.DB.Close()
+		cleanup()
+	}
+}
+
+func createTestUser(t *testing.T, store *CockroachStore, username string) *rcjpb.User {
+	user, err := store.CreateUser(context.Background(), func(newUser *rcjpb.User) error {
+		newUser.Name = username
+		newUser.Username = username
+		newUser.Password = "password"
+		return nil
+	})
+	if err != nil {
+		t.Fatalf("Error creating user %s: %+v", username, err)
+	}
+	return user
+}
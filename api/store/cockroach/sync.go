@@ -0,0 +1,117 @@
+package cockroach
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/cockroach-go/crdb"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/jmoiron/sqlx"
+	"time"
+)
+
+// SyncCursorOverlap is how far before a sync cursor changes are looked for, so that a write
+// committed while the previous sync was reading is not missed.
+const SyncCursorOverlap = time.Minute
+
+// editedAt returns when the sheet was last edited on the judge's device, or the current time if
+// the client did not say.
+func editedAt(scoreSheet *rcjpb.ScoreSheet) interface{} {
+	if ts := scoreSheet.GetEditedAt(); ts != nil {
+		return time.Unix(ts.GetSeconds(), int64(ts.GetNanos())).UTC()
+	}
+	return sq.Expr("current_timestamp()")
+}
+
+// ScoreSheetSyncState describes what the server holds for a score sheet ID sent by a client.
+type ScoreSheetSyncState struct {
+	Exists   bool
+	Deleted  bool
+	AuthorID string
+	Version  int32
+}
+
+// FetchScoreSheetSyncState looks up a score sheet by ID, including deleted sheets.
+func (s *CockroachStore) FetchScoreSheetSyncState(ctx context.Context, id string) (*ScoreSheetSyncState, error) {
+	sql, args, _ := s.PSQL.Select(
+		"author",
+		"version",
+		"deleted_at IS NOT NULL as deleted",
+	).From("score_sheets").Where(sq.Eq{"id": id}).ToSql()
+	rows := []struct {
+		AuthorID string `db:"author"`
+		Version  int32  `db:"version"`
+		Deleted  bool   `db:"deleted"`
+	}{}
+	err := s.DB.SelectContext(ctx, &rows, sql, args...)
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching score sheet: %+v", err))
+	}
+	if len(rows) == 0 {
+		return &ScoreSheetSyncState{}, nil
+	}
+	return &ScoreSheetSyncState{
+		Exists:   true,
+		Deleted:  rows[0].Deleted,
+		AuthorID: rows[0].AuthorID,
+		Version:  rows[0].Version,
+	}, nil
+}
+
+// ScoreSheetChanges are the sheets changed on the server since a sync cursor.
+type ScoreSheetChanges struct {
+	Changed    []*rcjpb.ScoreSheet
+	DeletedIDs []string
+	// Cursor is the time the changes were read at, to be passed back on the next sync.
+	Cursor time.Time
+}
+
+// FetchScoreSheetChanges returns the author's sheets in the context's competition that were
+// updated, deleted or restored since the cursor, or all of their live sheets if since is nil.
+func (s *CockroachStore) FetchScoreSheetChanges(ctx context.Context, authorID string, since *time.Time) (*ScoreSheetChanges, error) {
+	changes := &ScoreSheetChanges{}
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		changes.Changed = nil
+		changes.DeletedIDs = nil
+		err := tx.Get(&changes.Cursor, "SELECT current_timestamp()::TIMESTAMP")
+		if err != nil {
+			return err
+		}
+		query := s.PSQL.Select("id", "deleted_at IS NOT NULL as deleted").From("score_sheets").
+			Where(sq.Eq{"author": authorID})
+		if since != nil {
+			query = query.Where(sq.Gt{"updated_at": since.Add(-SyncCursorOverlap)})
+		} else {
+			query = query.Where(sq.Eq{"deleted_at": nil})
+		}
+		if competitionID := CompetitionFromContext(ctx); competitionID != "" {
+			query = query.Where(sq.Expr("division IN (SELECT id FROM divisions WHERE competition = ?)", competitionID))
+		}
+		sql, args, _ := query.OrderBy("updated_at").ToSql()
+		rows := []struct {
+			ID      string `db:"id"`
+			Deleted bool   `db:"deleted"`
+		}{}
+		err = tx.Select(&rows, sql, args...)
+		if err != nil {
+			return err
+		}
+		for _, row := range rows {
+			if row.Deleted {
+				changes.DeletedIDs = append(changes.DeletedIDs, row.ID)
+				continue
+			}
+			scoreSheet, err := s.FetchScoreSheet(ctx, row.ID, tx)
+			if err != nil {
+				return err
+			}
+			changes.Changed = append(changes.Changed, scoreSheet)
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, errors.New(fmt.Sprintf("Error fetching score sheet changes: %+v", err))
+	}
+	return changes, nil
+}
@@ -0,0 +1,322 @@
+package cockroach
+
+import (
+	"context"
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	"github.com/davefinster/cockroach-go/crdb"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/jmoiron/sqlx"
+	"github.com/jmoiron/sqlx/types"
+	"reflect"
+)
+
+// sectionsChanged reports whether the sections differ in content or order. Sections are compared
+// as they would be stored so parameters that do not apply to a section's kind are ignored.
+func sectionsChanged(original, updated []*rcjpb.ScoreSheetTemplateSection) bool {
+	if len(original) != len(updated) {
+		return true
+	}
+	for idx, section := range updated {
+		existing := original[idx]
+		if section.GetId() != existing.GetId() ||
+			!reflect.DeepEqual(templateSectionValues(section, "", 0, 0, nil), templateSectionValues(existing, "", 0, 0, nil)) {
+			return true
+		}
+	}
+	return false
+}
+
+// createTemplateVersion stores the template's sections as the next version of the template. Each
+// section carried over from the previous version records the section it replaces so scores can be
+// migrated between versions.
+func (s *CockroachStore) createTemplateVersion(tx *sqlx.Tx, template *rcjpb.ScoreSheetTemplate, originalSections []*rcjpb.ScoreSheetTemplateSection) error {
+	sections := template.GetSections()
+	previous := map[string]bool{}
+	for _, section := range originalSections {
+		previous[section.GetId()] = true
+	}
+	version := template.GetVersion() + 1
+	for idx, section := range sections {
+		var previousSection interface{}
+		if section.GetId() != "" {
+			if !previous[section.GetId()] {
+				return &ConflictError{
+					Message: fmt.Sprintf("Section %s is not part of version %d of the template", section.GetId(), template.GetVersion()),
+				}
+			}
+			previousSection = section.GetId()
+		}
+		insertSql, insertArgs, _ := s.PSQL.Insert("score_sheet_template_sections").
+			Columns(templateSectionColumns...).
+			Values(templateSectionValues(section, template.GetId(), int32(idx), version, previousSection)...).
+			ToSql()
+		_, err := tx.Exec(insertSql, insertArgs...)
+		if err != nil {
+			return err
+		}
+	}
+	versionSql, versionArgs, _ := s.PSQL.Update("score_sheet_templates").
+		Set("version", version).Where(sq.Eq{"id": template.GetId()}).ToSql()
+	_, err := tx.Exec(versionSql, versionArgs...)
+	return err
+}
+
+type MigrateScoreSheetsOptions struct {
+	TemplateID string
+	// ToVersion is the template version to migrate to, defaulting to the current version
+	ToVersion int32
+	// ScoreSheetIDs limits the migration to the given sheets
+	ScoreSheetIDs []string
+	// Preview calculates the migration without changing any sheets
+	Preview bool
+}
+
+// MigrateScoreSheets moves sheets scored against earlier versions of a template to a later version.
+// Scores follow each section to its replacement and are mapped onto it by the rules of its kind,
+// being capped at the new maximum where the kind allows. Scores for sections that were removed, or
+// that the replacement cannot represent, are dropped. Sections added since the sheet was scored start at zero. The score
+// impact on every affected sheet is returned whether or not the migration is a preview. Sheets that
+// are locked, or in a locked round, are reported as skipped and left unchanged.
+func (s *CockroachStore) MigrateScoreSheets(ctx context.Context, opts *MigrateScoreSheetsOptions) ([]*rcjpb.ScoreSheetMigration, error) {
+	var migrations []*rcjpb.ScoreSheetMigration
+	err := crdb.ExecuteTxx(ctx, s.DB, nil, func(tx *sqlx.Tx) error {
+		migrations = []*rcjpb.ScoreSheetMigration{}
+		templateQuery := s.PSQL.Select("version").From("score_sheet_templates").
+			Where(sq.Eq{"id": opts.TemplateID, "deleted_at": nil})
+		templateSql, templateArgs, _ := competitionScope(ctx, templateQuery, "competition").ToSql()
+		versions := []int32{}
+		err := tx.Select(&versions, templateSql, templateArgs...)
+		if err != nil {
+			return err
+		}
+		if len(versions) == 0 {
+			return ErrNotFound
+		}
+		toVersion := opts.ToVersion
+		if toVersion == 0 {
+			toVersion = versions[0]
+		}
+		if toVersion < 1 || toVersion > versions[0] {
+			return &ConflictError{
+				Message: fmt.Sprintf("Version %d of the template does not exist", toVersion),
+			}
+		}
+		type dbSection struct {
+			ID              string         `db:"id"`
+			Title           string         `db:"title"`
+			MaxValue        int            `db:"max_value"`
+			Multiplier      int32          `db:"multiplier"`
+			Kind            string         `db:"kind"`
+			Step            float64        `db:"step"`
+			Points          float64        `db:"points"`
+			Levels          types.JSONText `db:"levels"`
+			Version         int32          `db:"version"`
+			PreviousSection *string        `db:"previous_section"`
+		}
+		sectionSql, sectionArgs, _ := s.PSQL.Select(
+			"id",
+			"title",
+			"max_value",
+			"multiplier",
+			"kind",
+			"step",
+			"points",
+			"levels",
+			"version",
+			"previous_section",
+		).From("score_sheet_template_sections").
+			Where(sq.Eq{"score_sheet_template": opts.TemplateID}).
+			OrderBy("version", "display_order").ToSql()
+		sections := []*dbSection{}
+		err = tx.Select(&sections, sectionSql, sectionArgs...)
+		if err != nil {
+			return err
+		}
+		sectionsByID := map[string]*dbSection{}
+		for _, section := range sections {
+			sectionsByID[section.ID] = section
+		}
+		// Every earlier section maps to the target section that replaced it
+		targetSections := []*dbSection{}
+		replacements := map[string]*dbSection{}
+		for _, section := range sections {
+			if section.Version != toVersion {
+				continue
+			}
+			targetSections = append(targetSections, section)
+			for current := section; current != nil; {
+				replacements[current.ID] = section
+				if current.PreviousSection == nil {
+					break
+				}
+				current = sectionsByID[*current.PreviousSection]
+			}
+		}
+		sheetQuery := s.PSQL.Select("id", "division", "team", "round", "status", "template_version").From("score_sheets").
+			Where(sq.Eq{"template": opts.TemplateID, "deleted_at": nil}).
+			Where(sq.Lt{"template_version": toVersion}).
+			OrderBy("created_at")
+		if len(opts.ScoreSheetIDs) > 0 {
+			sheetQuery = sheetQuery.Where(sq.Eq{"id": opts.ScoreSheetIDs})
+		}
+		sheetSql, sheetArgs, _ := sheetQuery.ToSql()
+		type dbSheet struct {
+			ID              string `db:"id"`
+			Division        string `db:"division"`
+			Team            string `db:"team"`
+			Round           int32  `db:"round"`
+			Status          string `db:"status"`
+			TemplateVersion int32  `db:"template_version"`
+		}
+		sheets := []*dbSheet{}
+		err = tx.Select(&sheets, sheetSql, sheetArgs...)
+		if err != nil {
+			return err
+		}
+		if len(sheets) == 0 {
+			return nil
+		}
+		sheetIDs := make([]string, len(sheets))
+		for idx, sheet := range sheets {
+			sheetIDs[idx] = sheet.ID
+		}
+		scoreSql, scoreArgs, _ := s.PSQL.Select("id", "section", "value", "score_sheet").
+			From("score_sheet_sections").Where(sq.Eq{"score_sheet": sheetIDs}).ToSql()
+		type dbScore struct {
+			ID         string  `db:"id"`
+			Section    string  `db:"section"`
+			Value      float64 `db:"value"`
+			ScoreSheet string  `db:"score_sheet"`
+		}
+		scores := []*dbScore{}
+		err = tx.Select(&scores, scoreSql, scoreArgs...)
+		if err != nil {
+			return err
+		}
+		scoreMap := map[string][]*dbScore{}
+		for _, score := range scores {
+			scoreMap[score.ScoreSheet] = append(scoreMap[score.ScoreSheet], score)
+		}
+		for _, sheet := range sheets {
+			migration := &rcjpb.ScoreSheetMigration{
+				ScoreSheetId: sheet.ID,
+				TeamId:       sheet.Team,
+				Round:        sheet.Round,
+				FromVersion:  sheet.TemplateVersion,
+				ToVersion:    toVersion,
+			}
+			migrations = append(migrations, migration)
+			// Locked sheets keep their version and totals until their round is unlocked
+			if sheet.Status == scoreSheetStatusNames[rcjpb.ScoreSheet_LOCKED] {
+				migration.SkippedReason = "Score sheet is locked"
+				continue
+			}
+			err = s.checkRoundOpen(tx, sheet.Division, sheet.Round)
+			if conflict, ok := err.(*ConflictError); ok {
+				migration.SkippedReason = conflict.Message
+				continue
+			} else if err != nil {
+				return err
+			}
+			type change struct {
+				score   *dbScore
+				section *dbSection
+				value   float64
+			}
+			changes := []change{}
+			covered := map[string]bool{}
+			for _, score := range scoreMap[sheet.ID] {
+				title := score.Section
+				if original, ok := sectionsByID[score.Section]; ok {
+					migration.TotalBefore += score.Value * SectionWeight(sectionKind(original.Kind), original.Multiplier, original.Points)
+					title = original.Title
+				}
+				target, ok := replacements[score.Section]
+				if !ok || covered[target.ID] {
+					migration.DroppedSections = append(migration.DroppedSections, title)
+					changes = append(changes, change{score: score})
+					continue
+				}
+				covered[target.ID] = true
+				value, ok := migratedValue(&rcjpb.ScoreSheetTemplateSection{
+					MaxValue: int32(target.MaxValue),
+					Kind:     sectionKind(target.Kind),
+					Step:     target.Step,
+					Levels:   unmarshalLevels(target.Levels),
+				}, score.Value)
+				if !ok {
+					// The score moves to the replacement section but starts again from zero
+					migration.DroppedSections = append(migration.DroppedSections, title)
+					changes = append(changes, change{score: score, section: target})
+					continue
+				}
+				if value != score.Value {
+					migration.ClampedSections = append(migration.ClampedSections, target.Title)
+				}
+				migration.TotalAfter += value * SectionWeight(sectionKind(target.Kind), target.Multiplier, target.Points)
+				changes = append(changes, change{score: score, section: target, value: value})
+			}
+			for _, target := range targetSections {
+				if !covered[target.ID] {
+					migration.AddedSections = append(migration.AddedSections, target.Title)
+					changes = append(changes, change{section: target})
+				}
+			}
+			if opts.Preview {
+				continue
+			}
+			original, err := s.FetchScoreSheet(ctx, sheet.ID, tx)
+			if err != nil {
+				return err
+			}
+			for _, change := range changes {
+				var changeSql string
+				var changeArgs []interface{}
+				if change.section == nil {
+					changeSql, changeArgs, _ = s.PSQL.Delete("score_sheet_sections").
+						Where(sq.Eq{"id": change.score.ID}).ToSql()
+				} else if change.score == nil {
+					changeSql, changeArgs, _ = s.PSQL.Insert("score_sheet_sections").
+						Columns("section", "value", "score_sheet").
+						Values(change.section.ID, 0, sheet.ID).ToSql()
+				} else {
+					changeSql, changeArgs, _ = s.PSQL.Update("score_sheet_sections").SetMap(map[string]interface{}{
+						"section": change.section.ID,
+						"value":   change.value,
+					}).Where(sq.Eq{"id": change.score.ID}).ToSql()
+				}
+				_, err = tx.Exec(changeSql, changeArgs...)
+				if err != nil {
+					return err
+				}
+			}
+			versionSql, versionArgs, _ := s.PSQL.Update("score_sheets").
+				Set("template_version", toVersion).
+				Set("version", sq.Expr("version + 1")).
+				Set("updated_at", sq.Expr("current_timestamp()")).
+				Where(sq.Eq{"id": sheet.ID}).ToSql()
+			_, err = tx.Exec(versionSql, versionArgs...)
+			if err != nil {
+				return err
+			}
+			updated, err := s.FetchScoreSheet(ctx, sheet.ID, tx)
+			if err != nil {
+				return err
+			}
+			err = s.recordRevision(ctx, tx, updated)
+			if err != nil {
+				return err
+			}
+			err = s.recordAudit(ctx, tx, "ScoreSheet", sheet.ID, original, updated)
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return migrations, nil
+}
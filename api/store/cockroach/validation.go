@@ -0,0 +1,131 @@
+package cockroach
+
+import (
+	"fmt"
+	sq "github.com/Masterminds/squirrel"
+	rcjpb "github.com/davefinster/rcj-go/api/proto"
+	"github.com/jmoiron/sqlx"
+	"strings"
+)
+
+// FieldViolation describes a problem with one field of a request.
+type FieldViolation struct {
+	Field       string
+	Description string
+}
+
+// ValidationError is returned when a request is inconsistent with the rows it refers to.
+type ValidationError struct {
+	Message    string
+	Violations []FieldViolation
+}
+
+func (e *ValidationError) Error() string {
+	descriptions := make([]string, len(e.Violations))
+	for idx, violation := range e.Violations {
+		descriptions[idx] = fmt.Sprintf("%s: %s", violation.Field, violation.Description)
+	}
+	return fmt.Sprintf("%s: %s", e.Message, strings.Join(descriptions, "; "))
+}
+
+func (e *ValidationError) add(field, format string, a ...interface{}) {
+	e.Violations = append(e.Violations, FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, a...),
+	})
+}
+
+// validateScoreSheet checks a score sheet against its division, team and template. The template
+// must be one of the division's, the round must exist in the division and suit the template type,
+// the team must belong to the division and every section must come from the template version
+// the sheet is scored against. Sections are identified by their template section ID when
+// created is true, and by their score sheet section ID otherwise.
+func (s *CockroachStore) validateScoreSheet(tx *sqlx.Tx, scoreSheet *rcjpb.ScoreSheet, created bool) error {
+	verr := &ValidationError{
+		Message: "Invalid score sheet",
+	}
+	divisionSql, divisionArgs, _ := s.PSQL.Select(
+		"competition_rounds",
+		"final_rounds",
+		"COALESCE(interview_template::STRING, '') as interview_template",
+		"COALESCE(performance_template::STRING, '') as performance_template",
+	).From("divisions").Where(sq.Eq{"id": scoreSheet.GetDivisionId(), "deleted_at": nil}).ToSql()
+	divisions := []struct {
+		CompetitionRounds   int32  `db:"competition_rounds"`
+		FinalRounds         int32  `db:"final_rounds"`
+		InterviewTemplate   string `db:"interview_template"`
+		PerformanceTemplate string `db:"performance_template"`
+	}{}
+	err := tx.Select(&divisions, divisionSql, divisionArgs...)
+	if err != nil {
+		return err
+	}
+	if len(divisions) == 0 {
+		verr.add("score_sheet.division_id", "Division %s not found", scoreSheet.GetDivisionId())
+		return verr
+	}
+	division := divisions[0]
+	templateID := scoreSheet.GetScoreSheetTemplateId()
+	if templateID == division.InterviewTemplate {
+		if scoreSheet.GetRound() != 0 {
+			verr.add("score_sheet.round", "Interviews are scored in round 0")
+		}
+	} else if templateID == division.PerformanceTemplate {
+		lastRound := division.CompetitionRounds + division.FinalRounds
+		if scoreSheet.GetRound() < 1 || scoreSheet.GetRound() > lastRound {
+			verr.add("score_sheet.round", "Performances are scored in rounds 1 to %d", lastRound)
+		}
+	} else {
+		verr.add("score_sheet.score_sheet_template_id", "Template is not used by the division")
+	}
+	teamSql, teamArgs, _ := s.PSQL.Select("division").From("teams").
+		Where(sq.Eq{"id": scoreSheet.GetTeam().GetId(), "deleted_at": nil}).ToSql()
+	teamDivisions := []string{}
+	err = tx.Select(&teamDivisions, teamSql, teamArgs...)
+	if err != nil {
+		return err
+	}
+	if len(teamDivisions) == 0 {
+		verr.add("score_sheet.team.id", "Team %s not found", scoreSheet.GetTeam().GetId())
+	} else if teamDivisions[0] != scoreSheet.GetDivisionId() {
+		verr.add("score_sheet.team.id", "Team is not in the division")
+	}
+	var sectionQuery sq.SelectBuilder
+	if created {
+		sectionQuery = s.PSQL.Select("id").From("score_sheet_template_sections").
+			Where(sq.Eq{"score_sheet_template": templateID}).
+			Where("version = (SELECT version FROM score_sheet_templates WHERE score_sheet_templates.id = score_sheet_template_sections.score_sheet_template)")
+	} else {
+		sectionQuery = s.PSQL.Select("id").From("score_sheet_sections").
+			Where(sq.Eq{"score_sheet": scoreSheet.GetId()})
+	}
+	sectionSql, sectionArgs, _ := sectionQuery.ToSql()
+	sectionIDs := []string{}
+	err = tx.Select(&sectionIDs, sectionSql, sectionArgs...)
+	if err != nil {
+		return err
+	}
+	known := map[string]bool{}
+	for _, id := range sectionIDs {
+		known[id] = true
+	}
+	seen := map[string]bool{}
+	for idx, section := range scoreSheet.GetSections() {
+		field := fmt.Sprintf("score_sheet.sections[%d].id", idx)
+		id := section.GetId()
+		if created {
+			field = fmt.Sprintf("score_sheet.sections[%d].section_id", idx)
+			id = section.GetSectionId()
+		}
+		if !known[id] {
+			verr.add(field, "Section is not part of the score sheet template")
+		} else if seen[id] {
+			verr.add(field, "Section is scored more than once")
+		}
+		seen[id] = true
+	}
+	if len(verr.Violations) > 0 {
+		return verr
+	}
+	return nil
+}
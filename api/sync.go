@@ -0,0 +1,100 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"regexp"
+	"time"
+)
+
+var uuidPattern = regexp.MustCompile("^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$")
+
+// SyncScoreSheets saves sheets edited on a judge's device while offline and returns the user's
+// sheets that changed on the server since the device last synced. Each sheet is saved in its own
+// transaction so that one rejected or conflicted sheet does not hold back the rest of the batch.
+func (s *robocupGrpcServer) SyncScoreSheets(ctx context.Context, req *serv.SyncScoreSheetsRequest) (*serv.SyncScoreSheetsResponse, error) {
+	if err := requireCompetition(ctx); err != nil {
+		return nil, err
+	}
+	var since *time.Time
+	if req.GetCursor() != "" {
+		cursor, err := time.Parse(time.RFC3339Nano, req.GetCursor())
+		if err != nil {
+			return nil, grpc.Errorf(codes.InvalidArgument, "Invalid sync cursor")
+		}
+		since = &cursor
+	}
+	user := currentUser(ctx)
+	results := make([]*serv.ScoreSheetSyncResult, len(req.GetScoreSheets()))
+	for idx, scoreSheet := range req.GetScoreSheets() {
+		results[idx] = s.syncScoreSheet(ctx, user, scoreSheet)
+	}
+	changes, err := s.Store.FetchScoreSheetChanges(ctx, user.GetId(), since)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching score sheet changes")
+	}
+	return &serv.SyncScoreSheetsResponse{
+		Results:              results,
+		Changes:              changes.Changed,
+		DeletedScoreSheetIds: changes.DeletedIDs,
+		Cursor:               changes.Cursor.Format(time.RFC3339Nano),
+	}, nil
+}
+
+// syncScoreSheet creates a sheet the server has not seen, or updates one provided it has not
+// changed on the server since the version the device last synced.
+func (s *robocupGrpcServer) syncScoreSheet(ctx context.Context, user *serv.User, scoreSheet *serv.ScoreSheet) *serv.ScoreSheetSyncResult {
+	result := &serv.ScoreSheetSyncResult{
+		ScoreSheetId: scoreSheet.GetId(),
+	}
+	reject := func(reason string) *serv.ScoreSheetSyncResult {
+		result.Outcome = serv.ScoreSheetSyncResult_REJECTED
+		result.Reason = reason
+		return result
+	}
+	if !uuidPattern.MatchString(scoreSheet.GetId()) {
+		return reject("Score sheets must have a client generated UUID")
+	}
+	state, err := s.Store.FetchScoreSheetSyncState(ctx, scoreSheet.GetId())
+	if err != nil {
+		return reject("Internal error encountered while syncing score sheet")
+	}
+	var saved *serv.ScoreSheet
+	switch {
+	case !state.Exists:
+		if scoreSheet.GetVersion() != 0 {
+			return reject("Score sheet does not exist on the server")
+		}
+		saved, err = s.createScoreSheet(ctx, scoreSheet, &crdbStore.CreateScoreSheetOptions{
+			ID: scoreSheet.GetId(),
+		})
+	case state.Deleted:
+		return reject("Score sheet has been deleted")
+	case state.AuthorID != user.GetId() && !userHasAnyRole(user, officials):
+		return reject("Score sheet belongs to another judge")
+	default:
+		requested := proto.Clone(scoreSheet).(*serv.ScoreSheet)
+		// A sheet whose creation was saved but whose response never reached the device is sent
+		// as new again. Nobody else can have changed it if it is still at its first version.
+		if requested.GetVersion() == 0 && state.AuthorID == user.GetId() && state.Version == 1 {
+			requested.Version = 1
+		}
+		saved, err = s.updateScoreSheet(ctx, requested)
+	}
+	if err != nil {
+		if stale, ok := err.(*crdbStore.StaleError); ok {
+			result.Outcome = serv.ScoreSheetSyncResult_CONFLICTED
+			result.Reason = stale.Message
+			result.ScoreSheet, _ = stale.Current.(*serv.ScoreSheet)
+			return result
+		}
+		return reject(grpc.ErrorDesc(statusError(err, "Internal error encountered while syncing score sheet")))
+	}
+	result.Outcome = serv.ScoreSheetSyncResult_ACCEPTED
+	result.ScoreSheet = saved
+	return result
+}
@@ -0,0 +1,360 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"github.com/gin-gonic/gin"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"gopkg.in/yaml.v2"
+	"io/ioutil"
+	"net/http"
+	"regexp"
+	"strings"
+)
+
+// templateDocument is the portable form of a score sheet template used by
+// ExportScoreSheetTemplate and ImportScoreSheetTemplate. IDs, versions and the owning
+// competition are not included, so a document can be imported into any competition.
+// The same fields are used for JSON and YAML, for example:
+//
+//	name: On Stage Performance
+//	type: performance
+//	timings:
+//	  - Performance Start
+//	  - Performance End
+//	sections:
+//	  - title: Choreography
+//	    description: Movement is synchronised with the music
+//	    max_value: 10
+//	    multiplier: 2
+//	    display_order: 0
+//	  - title: Costume
+//	    kind: scale
+//	    levels:
+//	      - label: Basic
+//	        value: 1
+//	      - label: Outstanding
+//	        value: 3
+//	    display_order: 1
+//
+// type is either "interview" or "performance". Sections are ordered by display_order and
+// multiplier defaults to 1 when omitted. kind is one of "numeric" (the default), "checkbox",
+// "scale", "count" or "penalty", matching ScoreSheetTemplateSection.Kind, and step, points and
+// levels give the parameters of the kind.
+type templateDocument struct {
+	Name     string                    `json:"name" yaml:"name"`
+	Type     string                    `json:"type" yaml:"type"`
+	Timings  []string                  `json:"timings,omitempty" yaml:"timings,omitempty"`
+	Sections []templateSectionDocument `json:"sections" yaml:"sections"`
+}
+
+type templateSectionDocument struct {
+	Title        string                  `json:"title" yaml:"title"`
+	Description  string                  `json:"description,omitempty" yaml:"description,omitempty"`
+	MaxValue     int32                   `json:"max_value" yaml:"max_value"`
+	Multiplier   *int32                  `json:"multiplier,omitempty" yaml:"multiplier,omitempty"`
+	DisplayOrder int32                   `json:"display_order" yaml:"display_order"`
+	Kind         string                  `json:"kind,omitempty" yaml:"kind,omitempty"`
+	Step         float64                 `json:"step,omitempty" yaml:"step,omitempty"`
+	Points       float64                 `json:"points,omitempty" yaml:"points,omitempty"`
+	Levels       []templateLevelDocument `json:"levels,omitempty" yaml:"levels,omitempty"`
+}
+
+type templateLevelDocument struct {
+	Label string  `json:"label" yaml:"label"`
+	Value float64 `json:"value" yaml:"value"`
+}
+
+var filenameUnsafe = regexp.MustCompile(`[^A-Za-z0-9_-]+`)
+
+func newTemplateDocument(template *serv.ScoreSheetTemplate) *templateDocument {
+	doc := &templateDocument{
+		Name:     template.GetName(),
+		Type:     strings.ToLower(template.GetType().String()),
+		Timings:  template.GetTimings(),
+		Sections: make([]templateSectionDocument, len(template.GetSections())),
+	}
+	for idx, section := range template.GetSections() {
+		multiplier := section.GetMultiplier()
+		doc.Sections[idx] = templateSectionDocument{
+			Title:        section.GetTitle(),
+			Description:  section.GetDescription(),
+			MaxValue:     section.GetMaxValue(),
+			Multiplier:   &multiplier,
+			DisplayOrder: section.GetDisplayOrder(),
+			Step:         section.GetStep(),
+			Points:       section.GetPoints(),
+		}
+		if section.GetKind() != serv.ScoreSheetTemplateSection_NUMERIC {
+			doc.Sections[idx].Kind = strings.ToLower(section.GetKind().String())
+		}
+		if section.GetKind() != serv.ScoreSheetTemplateSection_CHECKBOX &&
+			section.GetKind() != serv.ScoreSheetTemplateSection_COUNT &&
+			section.GetKind() != serv.ScoreSheetTemplateSection_PENALTY {
+			doc.Sections[idx].Points = 0
+		}
+		for _, level := range section.GetLevels() {
+			doc.Sections[idx].Levels = append(doc.Sections[idx].Levels, templateLevelDocument{
+				Label: level.GetLabel(),
+				Value: level.GetValue(),
+			})
+		}
+	}
+	return doc
+}
+
+// template converts the document to a template that can be created. Unknown types are rejected.
+func (doc *templateDocument) template() (*serv.ScoreSheetTemplate, error) {
+	templateType, ok := serv.ScoreSheetTemplate_Type_value[strings.ToUpper(strings.TrimSpace(doc.Type))]
+	if !ok {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Unknown template type %s", doc.Type)
+	}
+	template := &serv.ScoreSheetTemplate{
+		Name:    doc.Name,
+		Type:    serv.ScoreSheetTemplate_Type(templateType),
+		Timings: doc.Timings,
+	}
+	for idx, section := range doc.Sections {
+		kind := serv.ScoreSheetTemplateSection_NUMERIC
+		if section.Kind != "" {
+			value, ok := serv.ScoreSheetTemplateSection_Kind_value[strings.ToUpper(strings.TrimSpace(section.Kind))]
+			if !ok {
+				return nil, grpc.Errorf(codes.InvalidArgument, "Unknown kind %s for section %d", section.Kind, idx)
+			}
+			kind = serv.ScoreSheetTemplateSection_Kind(value)
+		}
+		templateSection := &serv.ScoreSheetTemplateSection{
+			Title:        section.Title,
+			Description:  section.Description,
+			MaxValue:     section.MaxValue,
+			DisplayOrder: section.DisplayOrder,
+			Kind:         kind,
+			Step:         section.Step,
+			Points:       section.Points,
+		}
+		for _, level := range section.Levels {
+			templateSection.Levels = append(templateSection.Levels, &serv.ScoreSheetTemplateSection_Level{
+				Label: level.Label,
+				Value: level.Value,
+			})
+		}
+		if section.Multiplier != nil {
+			templateSection.Multiplier = *section.Multiplier
+		}
+		template.Sections = append(template.Sections, templateSection)
+	}
+	defaultSectionMultipliers(template)
+	return template, nil
+}
+
+func marshalTemplateDocument(doc *templateDocument, format serv.TemplateFormat) ([]byte, error) {
+	if format == serv.TemplateFormat_YAML {
+		return yaml.Marshal(doc)
+	}
+	return json.MarshalIndent(doc, "", "  ")
+}
+
+// unmarshalTemplateDocument parses a document, rejecting fields that are not part of the format.
+func unmarshalTemplateDocument(data []byte, format serv.TemplateFormat) (*templateDocument, error) {
+	doc := &templateDocument{}
+	var err error
+	if format == serv.TemplateFormat_YAML {
+		err = yaml.UnmarshalStrict(data, doc)
+	} else {
+		decoder := json.NewDecoder(bytes.NewReader(data))
+		decoder.DisallowUnknownFields()
+		err = decoder.Decode(doc)
+	}
+	if err != nil {
+		return nil, grpc.Errorf(codes.InvalidArgument, "Invalid template document: %v", err)
+	}
+	return doc, nil
+}
+
+// parseTemplateFormat accepts "json" or "yaml" in any case, defaulting to JSON.
+func parseTemplateFormat(name string) (serv.TemplateFormat, bool) {
+	if name == "" {
+		return serv.TemplateFormat_JSON, true
+	}
+	if strings.EqualFold(name, "yml") {
+		return serv.TemplateFormat_YAML, true
+	}
+	value, ok := serv.TemplateFormat_value[strings.ToUpper(name)]
+	return serv.TemplateFormat(value), ok
+}
+
+func (s *robocupGrpcServer) fetchTemplate(ctx context.Context, templateID string) (*serv.ScoreSheetTemplate, error) {
+	templates, err := s.Store.FetchScoreSheetTemplates(ctx, &crdbStore.FetchScoreSheetTemplateOptions{
+		IDs: []string{templateID},
+	}, nil)
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while fetching template")
+	}
+	if len(templates) == 0 {
+		return nil, grpc.Errorf(codes.NotFound, "Template %s not found", templateID)
+	}
+	return templates[0], nil
+}
+
+func (s *robocupGrpcServer) ExportScoreSheetTemplate(ctx context.Context, req *serv.ExportScoreSheetTemplateRequest) (*serv.ExportScoreSheetTemplateResponse, error) {
+	template, err := s.fetchTemplate(ctx, req.GetScoreSheetTemplateId())
+	if err != nil {
+		return nil, err
+	}
+	data, err := marshalTemplateDocument(newTemplateDocument(template), req.GetFormat())
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while exporting template")
+	}
+	resp := &serv.ExportScoreSheetTemplateResponse{
+		Document:    string(data),
+		ContentType: "application/json",
+		Filename:    fmt.Sprintf("%s.json", strings.Trim(filenameUnsafe.ReplaceAllString(template.GetName(), "-"), "-")),
+	}
+	if req.GetFormat() == serv.TemplateFormat_YAML {
+		resp.ContentType = "application/x-yaml"
+		resp.Filename = strings.TrimSuffix(resp.Filename, ".json") + ".yaml"
+	}
+	return resp, nil
+}
+
+func (s *robocupGrpcServer) ImportScoreSheetTemplate(ctx context.Context, req *serv.ImportScoreSheetTemplateRequest) (*serv.ImportScoreSheetTemplateResponse, error) {
+	if err := requireCompetition(ctx); err != nil {
+		return nil, err
+	}
+	var template *serv.ScoreSheetTemplate
+	var err error
+	field := "document"
+	if req.GetSourceScoreSheetTemplateId() != "" {
+		var source *serv.ScoreSheetTemplate
+		source, err = s.fetchTemplate(ctx, req.GetSourceScoreSheetTemplateId())
+		if err != nil {
+			return nil, err
+		}
+		// Going through the document drops the IDs and version of the source template
+		template, err = newTemplateDocument(source).template()
+		field = "source_score_sheet_template_id"
+	} else if req.GetDocument() != "" {
+		var doc *templateDocument
+		doc, err = unmarshalTemplateDocument([]byte(req.GetDocument()), req.GetFormat())
+		if err == nil {
+			template, err = doc.template()
+		}
+	} else {
+		return nil, grpc.Errorf(codes.InvalidArgument, "A document or source template is required")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if req.GetName() != "" {
+		template.Name = req.GetName()
+	}
+	if err := validateScoreSheetTemplate(template, field); err != nil {
+		return nil, err
+	}
+	created, err := s.Store.CreateScoreSheetTemplate(ctx, func(newTemplate *serv.ScoreSheetTemplate) error {
+		proto.Merge(newTemplate, template)
+		return nil
+	})
+	if err != nil {
+		return nil, grpc.Errorf(codes.Internal, "Internal error encountered while importing template")
+	}
+	return &serv.ImportScoreSheetTemplateResponse{
+		ScoreSheetTemplate: created,
+	}, nil
+}
+
+// restContext returns a context for a REST call, attributed to the authenticated user and
+// scoped to the competition selected for the session.
+func (s *Server) restContext(c *gin.Context, method string) (context.Context, error) {
+	user := c.MustGet(authenticatedUserKey).(*serv.User)
+	cookie, _ := c.Cookie(sessionCookieName)
+	ctx := c.Request.Context()
+	competitionID, err := s.GRPC.activeCompetition(ctx, nil, cookie)
+	if err != nil {
+		return nil, err
+	}
+	ctx = crdbStore.WithAuditActor(ctx, user.GetId(), method)
+	return crdbStore.WithCompetition(ctx, competitionID), nil
+}
+
+// writeStatusError maps an error from a gRPC handler to the matching HTTP status.
+func writeStatusError(c *gin.Context, err error) {
+	switch grpc.Code(err) {
+	case codes.InvalidArgument:
+		c.String(http.StatusBadRequest, grpc.ErrorDesc(err))
+	case codes.NotFound:
+		c.AbortWithStatus(http.StatusNotFound)
+	case codes.FailedPrecondition:
+		c.String(http.StatusConflict, grpc.ErrorDesc(err))
+	default:
+		c.AbortWithStatus(http.StatusInternalServerError)
+	}
+}
+
+// getTemplateExport downloads a template as JSON, or as YAML with ?format=yaml.
+func (s *Server) getTemplateExport(c *gin.Context) {
+	format, ok := parseTemplateFormat(c.Query("format"))
+	if !ok {
+		c.String(http.StatusBadRequest, "Unknown format %s", c.Query("format"))
+		return
+	}
+	ctx, err := s.restContext(c, "GET /api/templates/:id/export")
+	if err != nil {
+		writeStatusError(c, err)
+		return
+	}
+	resp, err := s.GRPC.ExportScoreSheetTemplate(ctx, &serv.ExportScoreSheetTemplateRequest{
+		ScoreSheetTemplateId: c.Param("id"),
+		Format:               format,
+	})
+	if err != nil {
+		writeStatusError(c, err)
+		return
+	}
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=\"%s\"", resp.GetFilename()))
+	c.Data(http.StatusOK, resp.GetContentType(), []byte(resp.GetDocument()))
+}
+
+// postTemplateImport creates a template from a document uploaded either as a multipart "file"
+// field or as the raw request body. ?format=yaml selects YAML and ?name= renames the template.
+func (s *Server) postTemplateImport(c *gin.Context) {
+	format, ok := parseTemplateFormat(c.Query("format"))
+	if !ok {
+		c.String(http.StatusBadRequest, "Unknown format %s", c.Query("format"))
+		return
+	}
+	c.Request.Body = http.MaxBytesReader(c.Writer, c.Request.Body, maxBulkUploadSize)
+	var data []byte
+	file, _, err := c.Request.FormFile("file")
+	if err == nil {
+		defer file.Close()
+		data, err = ioutil.ReadAll(file)
+	} else {
+		data, err = ioutil.ReadAll(c.Request.Body)
+	}
+	if err != nil {
+		c.AbortWithStatus(http.StatusBadRequest)
+		return
+	}
+	ctx, err := s.restContext(c, "POST /api/templates/import")
+	if err != nil {
+		writeStatusError(c, err)
+		return
+	}
+	resp, err := s.GRPC.ImportScoreSheetTemplate(ctx, &serv.ImportScoreSheetTemplateRequest{
+		Format:   format,
+		Document: string(data),
+		Name:     c.Query("name"),
+	})
+	if err != nil {
+		writeStatusError(c, err)
+		return
+	}
+	c.JSON(http.StatusOK, resp)
+}
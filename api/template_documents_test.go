@@ -0,0 +1,222 @@
+package api
+
+import (
+	serv "github.com/davefinster/rcj-go/api/proto"
+	"github.com/golang/protobuf/proto"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"testing"
+)
+
+// exportedTemplate has a section of every kind, with the parameters of each kind set.
+func exportedTemplate() *serv.ScoreSheetTemplate {
+	return &serv.ScoreSheetTemplate{
+		Id:            "7b0b6c4e-5d1f-4a47-9a39-2f4a1b8f3c10",
+		Name:          "On Stage Performance",
+		CompetitionId: "0f8f2a5e-9c36-4d55-8d0e-3b7b1d2c4e61",
+		Type:          serv.ScoreSheetTemplate_PERFORMANCE,
+		Version:       3,
+		Timings:       []string{"Performance Start", "Performance End"},
+		Sections: []*serv.ScoreSheetTemplateSection{
+			{
+				Id:           "a1",
+				Title:        "Choreography",
+				Description:  "Movement is synchronised with the music",
+				MaxValue:     10,
+				Multiplier:   2,
+				DisplayOrder: 0,
+				Kind:         serv.ScoreSheetTemplateSection_NUMERIC,
+				Step:         0.5,
+			},
+			{
+				Id:           "a2",
+				Title:        "Robot starts on cue",
+				MaxValue:     1,
+				Multiplier:   1,
+				DisplayOrder: 1,
+				Kind:         serv.ScoreSheetTemplateSection_CHECKBOX,
+				Points:       5,
+			},
+			{
+				Id:           "a3",
+				Title:        "Costume",
+				MaxValue:     3,
+				Multiplier:   3,
+				DisplayOrder: 2,
+				Kind:         serv.ScoreSheetTemplateSection_SCALE,
+				Levels: []*serv.ScoreSheetTemplateSection_Level{
+					{Label: "Basic", Value: 1},
+					{Label: "Good", Value: 2.5},
+					{Label: "Outstanding", Value: 3},
+				},
+			},
+			{
+				Id:           "a4",
+				Title:        "Props used",
+				MaxValue:     4,
+				Multiplier:   1,
+				DisplayOrder: 3,
+				Kind:         serv.ScoreSheetTemplateSection_COUNT,
+				Points:       2,
+			},
+			{
+				Id:           "a5",
+				Title:        "Restarts",
+				Description:  "Each restart after the first minute",
+				MaxValue:     3,
+				Multiplier:   4,
+				DisplayOrder: 4,
+				Kind:         serv.ScoreSheetTemplateSection_PENALTY,
+				Points:       1.5,
+			},
+		},
+	}
+}
+
+func TestTemplateDocumentRoundTrip(t *testing.T) {
+	source := exportedTemplate()
+	// IDs, the version and the owning competition are not part of a document
+	expected := proto.Clone(source).(*serv.ScoreSheetTemplate)
+	expected.Id = ""
+	expected.CompetitionId = ""
+	expected.Version = 0
+	for _, section := range expected.Sections {
+		section.Id = ""
+	}
+	for _, format := range []serv.TemplateFormat{serv.TemplateFormat_JSON, serv.TemplateFormat_YAML} {
+		t.Run(format.String(), func(t *testing.T) {
+			data, err := marshalTemplateDocument(newTemplateDocument(source), format)
+			if err != nil {
+				t.Fatalf("Error marshalling document: %+v", err)
+			}
+			doc, err := unmarshalTemplateDocument(data, format)
+			if err != nil {
+				t.Fatalf("Error parsing exported document: %+v\n%s", err, data)
+			}
+			template, err := doc.template()
+			if err != nil {
+				t.Fatalf("Error converting document: %+v", err)
+			}
+			if !proto.Equal(template, expected) {
+				t.Errorf("Expected %+v, got %+v\n%s", expected, template, data)
+			}
+		})
+	}
+}
+
+func TestTemplateDocumentDefaults(t *testing.T) {
+	documents := map[serv.TemplateFormat]string{
+		serv.TemplateFormat_JSON: `{
+			"name": "Interview",
+			"type": "Interview",
+			"sections": [
+				{"title": "Teamwork", "max_value": 5, "display_order": 0},
+				{"title": "Presentation", "max_value": 5, "multiplier": 0, "display_order": 1}
+			]
+		}`,
+		serv.TemplateFormat_YAML: "name: Interview\ntype: Interview\nsections:\n  - title: Teamwork\n    max_value: 5\n    display_order: 0\n  - title: Presentation\n    max_value: 5\n    multiplier: 0\n    display_order: 1\n",
+	}
+	expected := &serv.ScoreSheetTemplate{
+		Name: "Interview",
+		Type: serv.ScoreSheetTemplate_INTERVIEW,
+		Sections: []*serv.ScoreSheetTemplateSection{
+			{
+				Title:      "Teamwork",
+				MaxValue:   5,
+				Multiplier: 1,
+				Kind:       serv.ScoreSheetTemplateSection_NUMERIC,
+			},
+			{
+				Title:        "Presentation",
+				MaxValue:     5,
+				Multiplier:   1,
+				DisplayOrder: 1,
+				Kind:         serv.ScoreSheetTemplateSection_NUMERIC,
+			},
+		},
+	}
+	for format, document := range documents {
+		t.Run(format.String(), func(t *testing.T) {
+			doc, err := unmarshalTemplateDocument([]byte(document), format)
+			if err != nil {
+				t.Fatalf("Error parsing document: %+v", err)
+			}
+			template, err := doc.template()
+			if err != nil {
+				t.Fatalf("Error converting document: %+v", err)
+			}
+			if !proto.Equal(template, expected) {
+				t.Errorf("Expected %+v, got %+v", expected, template)
+			}
+		})
+	}
+}
+
+func TestTemplateDocumentRejectsUnknownFields(t *testing.T) {
+	tests := []struct {
+		name     string
+		format   serv.TemplateFormat
+		document string
+	}{
+		{
+			name:     "JSON template field",
+			format:   serv.TemplateFormat_JSON,
+			document: `{"name": "Interview", "type": "interview", "id": "1234", "sections": []}`,
+		},
+		{
+			name:     "JSON section field",
+			format:   serv.TemplateFormat_JSON,
+			document: `{"name": "Interview", "type": "interview", "sections": [{"title": "Teamwork", "max_value": 5, "weight": 2}]}`,
+		},
+		{
+			name:     "YAML template field",
+			format:   serv.TemplateFormat_YAML,
+			document: "name: Interview\ntype: interview\ncompetition: RoboCup Junior\nsections: []\n",
+		},
+		{
+			name:     "YAML level field",
+			format:   serv.TemplateFormat_YAML,
+			document: "name: Interview\ntype: interview\nsections:\n  - title: Poise\n    kind: scale\n    levels:\n      - label: Good\n        value: 2\n        colour: green\n",
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doc, err := unmarshalTemplateDocument([]byte(test.document), test.format)
+			if grpc.Code(err) != codes.InvalidArgument {
+				t.Errorf("Expected InvalidArgument, got %+v and %+v", err, doc)
+			}
+		})
+	}
+}
+
+func TestTemplateDocumentRejectsUnknownValues(t *testing.T) {
+	tests := []struct {
+		name     string
+		document string
+	}{
+		{
+			name:     "type",
+			document: `{"name": "Interview", "type": "practice", "sections": []}`,
+		},
+		{
+			name:     "missing type",
+			document: `{"name": "Interview", "sections": []}`,
+		},
+		{
+			name:     "kind",
+			document: `{"name": "Interview", "type": "interview", "sections": [{"title": "Teamwork", "max_value": 5, "kind": "slider"}]}`,
+		},
+	}
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doc, err := unmarshalTemplateDocument([]byte(test.document), serv.TemplateFormat_JSON)
+			if err != nil {
+				t.Fatalf("Error parsing document: %+v", err)
+			}
+			template, err := doc.template()
+			if grpc.Code(err) != codes.InvalidArgument {
+				t.Errorf("Expected InvalidArgument, got %+v and %+v", err, template)
+			}
+		})
+	}
+}
@@ -0,0 +1,26 @@
+package api
+
+import (
+	"context"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+)
+
+func (s *robocupGrpcServer) MigrateScoreSheets(ctx context.Context, req *serv.MigrateScoreSheetsRequest) (*serv.MigrateScoreSheetsResponse, error) {
+	if err := requireCompetition(ctx); err != nil {
+		return nil, err
+	}
+	migrations, err := s.Store.MigrateScoreSheets(ctx, &crdbStore.MigrateScoreSheetsOptions{
+		TemplateID:    req.GetScoreSheetTemplateId(),
+		ToVersion:     req.GetToVersion(),
+		ScoreSheetIDs: req.GetScoreSheetIds(),
+		Preview:       req.GetPreview(),
+	})
+	if err != nil {
+		return nil, storeStatusError(err, "Internal error encountered while migrating score sheets")
+	}
+	return &serv.MigrateScoreSheetsResponse{
+		Migrations: migrations,
+		Applied:    !req.GetPreview(),
+	}, nil
+}
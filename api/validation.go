@@ -0,0 +1,234 @@
+package api
+
+import (
+	"fmt"
+	serv "github.com/davefinster/rcj-go/api/proto"
+	crdbStore "github.com/davefinster/rcj-go/api/store/cockroach"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"math"
+	"strings"
+)
+
+// valueTolerance absorbs floating point error when comparing section values.
+const valueTolerance = 1e-6
+
+// violations collects field level problems with a request.
+type violations struct {
+	fields []*errdetails.BadRequest_FieldViolation
+}
+
+func (v *violations) add(field, format string, a ...interface{}) {
+	v.fields = append(v.fields, &errdetails.BadRequest_FieldViolation{
+		Field:       field,
+		Description: fmt.Sprintf(format, a...),
+	})
+}
+
+// err returns an InvalidArgument status carrying the violations as BadRequest details, or nil if
+// there are none.
+func (v *violations) err(message string) error {
+	if len(v.fields) == 0 {
+		return nil
+	}
+	st, detailErr := status.New(codes.InvalidArgument, message).WithDetails(&errdetails.BadRequest{
+		FieldViolations: v.fields,
+	})
+	if detailErr != nil {
+		return status.Error(codes.InvalidArgument, message)
+	}
+	return st.Err()
+}
+
+// defaultSectionMultipliers sets unset multipliers to 1. Clients and documents predating
+// multipliers leave them unset.
+func defaultSectionMultipliers(template *serv.ScoreSheetTemplate) {
+	for _, section := range template.GetSections() {
+		if section.GetMultiplier() == 0 {
+			section.Multiplier = 1
+		}
+	}
+}
+
+// validateScoreSheetTemplate checks the template definition. Display orders may be left unset, in
+// which case sections are ordered as listed, but otherwise they must be unique.
+func validateScoreSheetTemplate(template *serv.ScoreSheetTemplate, field string) error {
+	v := &violations{}
+	if strings.TrimSpace(template.GetName()) == "" {
+		v.add(field+".name", "A name is required")
+	}
+	seenTimings := map[string]bool{}
+	for idx, timing := range template.GetTimings() {
+		timingField := fmt.Sprintf("%s.timings[%d]", field, idx)
+		if strings.TrimSpace(timing) == "" {
+			v.add(timingField, "Timing names cannot be empty")
+		} else if seenTimings[timing] {
+			v.add(timingField, "Timing %s is repeated", timing)
+		}
+		seenTimings[timing] = true
+	}
+	if len(template.GetSections()) == 0 {
+		v.add(field+".sections", "At least one section is required")
+	}
+	explicitOrder := false
+	for _, section := range template.GetSections() {
+		if section.GetDisplayOrder() != 0 {
+			explicitOrder = true
+		}
+	}
+	seenOrders := map[int32]int{}
+	for idx, section := range template.GetSections() {
+		sectionField := fmt.Sprintf("%s.sections[%d]", field, idx)
+		if strings.TrimSpace(section.GetTitle()) == "" {
+			v.add(sectionField+".title", "A title is required")
+		}
+		validateSectionKind(v, section, sectionField)
+		if section.GetMultiplier() <= 0 {
+			v.add(sectionField+".multiplier", "The multiplier must be greater than zero")
+		}
+		if explicitOrder {
+			if first, ok := seenOrders[section.GetDisplayOrder()]; ok {
+				v.add(sectionField+".display_order", "Display order %d is already used by section %d", section.GetDisplayOrder(), first)
+			} else {
+				seenOrders[section.GetDisplayOrder()] = idx
+			}
+		}
+	}
+	return v.err("Invalid score sheet template")
+}
+
+// validateSectionKind checks the parameters required by the section's kind.
+func validateSectionKind(v *violations, section *serv.ScoreSheetTemplateSection, field string) {
+	switch section.GetKind() {
+	case serv.ScoreSheetTemplateSection_NUMERIC:
+		if section.GetMaxValue() <= 0 {
+			v.add(field+".max_value", "The maximum value must be greater than zero")
+		}
+		if section.GetStep() < 0 {
+			v.add(field+".step", "The step cannot be negative")
+		}
+	case serv.ScoreSheetTemplateSection_CHECKBOX:
+		if section.GetPoints() <= 0 {
+			v.add(field+".points", "Checkboxes must be worth more than zero points")
+		}
+	case serv.ScoreSheetTemplateSection_SCALE:
+		if len(section.GetLevels()) < 2 {
+			v.add(field+".levels", "Scales need at least two levels")
+		}
+		seenLabels := map[string]bool{}
+		seenValues := map[float64]bool{}
+		for idx, level := range section.GetLevels() {
+			levelField := fmt.Sprintf("%s.levels[%d]", field, idx)
+			if strings.TrimSpace(level.GetLabel()) == "" {
+				v.add(levelField+".label", "A label is required")
+			} else if seenLabels[level.GetLabel()] {
+				v.add(levelField+".label", "Label %s is repeated", level.GetLabel())
+			}
+			if level.GetValue() < 0 {
+				v.add(levelField+".value", "Level values cannot be negative")
+			} else if seenValues[level.GetValue()] {
+				v.add(levelField+".value", "Value %g is repeated", level.GetValue())
+			}
+			seenLabels[level.GetLabel()] = true
+			seenValues[level.GetValue()] = true
+		}
+	case serv.ScoreSheetTemplateSection_COUNT, serv.ScoreSheetTemplateSection_PENALTY:
+		if section.GetMaxValue() <= 0 {
+			v.add(field+".max_value", "The maximum count must be greater than zero")
+		}
+		if section.GetPoints() <= 0 {
+			v.add(field+".points", "Each item must be worth more than zero points")
+		}
+	default:
+		v.add(field+".kind", "Unknown kind %d", section.GetKind())
+	}
+}
+
+// sectionValueProblem describes why the value cannot be scored for the section, or returns an
+// empty string if it can.
+func sectionValueProblem(section *serv.ScoreSheetTemplateSection, value float64) string {
+	switch section.GetKind() {
+	case serv.ScoreSheetTemplateSection_CHECKBOX:
+		if value != 0 && value != 1 {
+			return "Checkboxes must be 0 or 1"
+		}
+	case serv.ScoreSheetTemplateSection_SCALE:
+		for _, level := range section.GetLevels() {
+			if math.Abs(level.GetValue()-value) < valueTolerance {
+				return ""
+			}
+		}
+		return fmt.Sprintf("%g is not one of the levels", value)
+	case serv.ScoreSheetTemplateSection_COUNT, serv.ScoreSheetTemplateSection_PENALTY:
+		if value < 0 || value > float64(section.GetMaxValue()) || value != math.Trunc(value) {
+			return fmt.Sprintf("Must be a whole number between 0 and %d", section.GetMaxValue())
+		}
+	default:
+		if value < 0 || value > float64(section.GetMaxValue())+valueTolerance {
+			return fmt.Sprintf("Must be between 0 and %d", section.GetMaxValue())
+		}
+		if step := section.GetStep(); step > 0 {
+			steps := value / step
+			if math.Abs(steps-math.Round(steps)) > valueTolerance {
+				return fmt.Sprintf("Must be a multiple of %g", step)
+			}
+		}
+	}
+	return ""
+}
+
+// scoreSheetSectionTemplate returns the template definition a score sheet section was scored against.
+func scoreSheetSectionTemplate(section *serv.ScoreSheetSection) *serv.ScoreSheetTemplateSection {
+	return &serv.ScoreSheetTemplateSection{
+		Id:         section.GetSectionId(),
+		Title:      section.GetTitle(),
+		MaxValue:   section.GetMaxValue(),
+		Multiplier: section.GetMultiplier(),
+		Kind:       section.GetKind(),
+		Step:       section.GetStep(),
+		Levels:     section.GetLevels(),
+		Points:     section.GetPoints(),
+	}
+}
+
+// validateScoreSheet checks the fields of a submitted sheet and each value against the rules of
+// its section's kind. definition returns the template section a submitted section is scored
+// against, or nil if it does not belong to the sheet. Consistency with the division, team and
+// template is checked by the store.
+func validateScoreSheet(scoreSheet *serv.ScoreSheet, definition func(*serv.ScoreSheetSection) *serv.ScoreSheetTemplateSection) error {
+	v := &violations{}
+	if scoreSheet.GetDivisionId() == "" {
+		v.add("score_sheet.division_id", "A division is required")
+	}
+	if scoreSheet.GetScoreSheetTemplateId() == "" {
+		v.add("score_sheet.score_sheet_template_id", "A template is required")
+	}
+	if scoreSheet.GetTeam().GetId() == "" {
+		v.add("score_sheet.team.id", "A team is required")
+	}
+	if scoreSheet.GetRound() < 0 {
+		v.add("score_sheet.round", "The round cannot be negative")
+	}
+	for idx, section := range scoreSheet.GetSections() {
+		field := fmt.Sprintf("score_sheet.sections[%d]", idx)
+		templateSection := definition(section)
+		if templateSection == nil {
+			v.add(field+".section_id", "Section is not part of the score sheet template")
+			continue
+		}
+		if problem := sectionValueProblem(templateSection, section.GetValue()); problem != "" {
+			v.add(field+".value", "%s", problem)
+		}
+	}
+	return v.err("Invalid score sheet")
+}
+
+// validationStatus converts a store validation error to an InvalidArgument status with details.
+func validationStatus(verr *crdbStore.ValidationError) error {
+	v := &violations{}
+	for _, violation := range verr.Violations {
+		v.add(violation.Field, "%s", violation.Description)
+	}
+	return v.err(verr.Message)
+}
@@ -0,0 +1,64 @@
+-- Scopes an existing database to competitions. Everything created before competitions existed is
+-- moved into a single default competition, which can be renamed once the migration has run.
+--
+-- Schema changes cannot share a transaction with the statements that backfill them, so each
+-- statement runs on its own. Every step can be safely repeated if the migration is interrupted.
+
+CREATE TABLE IF NOT EXISTS competitions (
+       id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+       name STRING NOT NULL,
+       created_at TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       INDEX (created_at)
+);
+
+INSERT INTO competitions (name)
+       SELECT 'Default Competition' WHERE NOT EXISTS (SELECT 1 FROM competitions);
+
+-- Add the columns as nullable so existing rows can be backfilled
+ALTER TABLE score_sheet_templates ADD COLUMN IF NOT EXISTS competition UUID;
+ALTER TABLE divisions ADD COLUMN IF NOT EXISTS competition UUID;
+ALTER TABLE teams ADD COLUMN IF NOT EXISTS competition UUID;
+ALTER TABLE team_checkins ADD COLUMN IF NOT EXISTS competition UUID;
+ALTER TABLE judge_assignments ADD COLUMN IF NOT EXISTS competition UUID;
+ALTER TABLE sessions ADD COLUMN IF NOT EXISTS competition UUID;
+
+-- Templates and divisions belong to the default competition. Teams, check-ins and assignments
+-- follow their division so they stay consistent with it.
+UPDATE score_sheet_templates SET competition = (SELECT id FROM competitions ORDER BY created_at LIMIT 1)
+       WHERE competition IS NULL;
+UPDATE divisions SET competition = (SELECT id FROM competitions ORDER BY created_at LIMIT 1)
+       WHERE competition IS NULL;
+UPDATE teams SET competition = (SELECT competition FROM divisions WHERE divisions.id = teams.division)
+       WHERE competition IS NULL;
+UPDATE team_checkins SET competition = (SELECT competition FROM teams WHERE teams.id = team_checkins.team)
+       WHERE competition IS NULL;
+UPDATE judge_assignments SET competition = (SELECT competition FROM divisions WHERE divisions.id = judge_assignments.division)
+       WHERE competition IS NULL;
+
+ALTER TABLE score_sheet_templates ALTER COLUMN competition SET NOT NULL;
+ALTER TABLE divisions ALTER COLUMN competition SET NOT NULL;
+ALTER TABLE teams ALTER COLUMN competition SET NOT NULL;
+ALTER TABLE team_checkins ALTER COLUMN competition SET NOT NULL;
+ALTER TABLE judge_assignments ALTER COLUMN competition SET NOT NULL;
+
+-- Index and constrain the columns as tables.sql does. Sessions start without a competition
+-- selected, so theirs stays nullable.
+CREATE INDEX IF NOT EXISTS score_sheet_templates_competition_idx ON score_sheet_templates (competition);
+CREATE INDEX IF NOT EXISTS divisions_competition_idx ON divisions (competition);
+CREATE INDEX IF NOT EXISTS teams_competition_idx ON teams (competition);
+CREATE INDEX IF NOT EXISTS team_checkins_competition_idx ON team_checkins (competition);
+CREATE INDEX IF NOT EXISTS judge_assignments_competition_idx ON judge_assignments (competition);
+CREATE INDEX IF NOT EXISTS sessions_competition_idx ON sessions (competition);
+
+ALTER TABLE score_sheet_templates DROP CONSTRAINT IF EXISTS fk_competition_ref_competitions;
+ALTER TABLE score_sheet_templates ADD CONSTRAINT fk_competition_ref_competitions FOREIGN KEY (competition) REFERENCES competitions (id);
+ALTER TABLE divisions DROP CONSTRAINT IF EXISTS fk_competition_ref_competitions;
+ALTER TABLE divisions ADD CONSTRAINT fk_competition_ref_competitions FOREIGN KEY (competition) REFERENCES competitions (id);
+ALTER TABLE teams DROP CONSTRAINT IF EXISTS fk_competition_ref_competitions;
+ALTER TABLE teams ADD CONSTRAINT fk_competition_ref_competitions FOREIGN KEY (competition) REFERENCES competitions (id);
+ALTER TABLE team_checkins DROP CONSTRAINT IF EXISTS fk_competition_ref_competitions;
+ALTER TABLE team_checkins ADD CONSTRAINT fk_competition_ref_competitions FOREIGN KEY (competition) REFERENCES competitions (id);
+ALTER TABLE judge_assignments DROP CONSTRAINT IF EXISTS fk_competition_ref_competitions;
+ALTER TABLE judge_assignments ADD CONSTRAINT fk_competition_ref_competitions FOREIGN KEY (competition) REFERENCES competitions (id);
+ALTER TABLE sessions DROP CONSTRAINT IF EXISTS fk_competition_ref_competitions;
+ALTER TABLE sessions ADD CONSTRAINT fk_competition_ref_competitions FOREIGN KEY (competition) REFERENCES competitions (id);
@@ -3,14 +3,33 @@ CREATE TABLE users (
        name STRING NOT NULL,
        username STRING NOT NULL,
        hashed_password STRING NOT NULL,
-       is_admin BOOLEAN NOT NULL DEFAULT false
+       is_admin BOOLEAN NOT NULL DEFAULT false,
+       must_change_password BOOLEAN NOT NULL DEFAULT false,
+       deleted_at TIMESTAMP
+);
+
+CREATE TABLE user_roles (
+       user_id UUID NOT NULL REFERENCES users (id),
+       role STRING NOT NULL CHECK (role IN ('Admin', 'Head Judge', 'Judge', 'Check-in Agent', 'Viewer')),
+       PRIMARY KEY (user_id, role)
+);
+
+CREATE TABLE competitions (
+       id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+       name STRING NOT NULL,
+       created_at TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       INDEX (created_at)
 );
 
 CREATE TABLE score_sheet_templates (
        id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
        name STRING NOT NULL,
        type STRING NOT NULL CHECK (type IN ('Interview', 'Performance')),
-       timings STRING[] NOT NULL DEFAULT ARRAY[]
+       timings STRING[] NOT NULL DEFAULT ARRAY[],
+       competition UUID NOT NULL REFERENCES competitions (id),
+       version INT NOT NULL DEFAULT 1,
+       deleted_at TIMESTAMP,
+       INDEX (competition)
 );
 
 CREATE TABLE score_sheet_template_sections (
@@ -21,7 +40,13 @@ CREATE TABLE score_sheet_template_sections (
        max_value INT NOT NULL DEFAULT 0,
        multiplier INT NOT NULL DEFAULT 1,
        display_order INT NOT NULL DEFAULT 0,
-       INDEX (score_sheet_template)
+       version INT NOT NULL DEFAULT 1,
+       previous_section UUID REFERENCES score_sheet_template_sections (id),
+       kind STRING NOT NULL DEFAULT 'Numeric' CHECK (kind IN ('Numeric', 'Checkbox', 'Scale', 'Count', 'Penalty')),
+       step DECIMAL(10,5) NOT NULL DEFAULT 0,
+       points DECIMAL(10,5) NOT NULL DEFAULT 1,
+       levels JSONB NOT NULL DEFAULT '[]',
+       INDEX (score_sheet_template, version)
 );
 
 CREATE TABLE divisions (
@@ -32,13 +57,17 @@ CREATE TABLE divisions (
        final_rounds INT NOT NULL DEFAULT 0,
        interview_template UUID REFERENCES score_sheet_templates (id),
        performance_template UUID REFERENCES score_sheet_templates (id),
+       competition UUID NOT NULL REFERENCES competitions (id),
+       deleted_at TIMESTAMP,
        INDEX (interview_template),
-       INDEX (performance_template)
+       INDEX (performance_template),
+       INDEX (competition)
 );
 
 CREATE TABLE institutions(
        id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
-       name STRING NOT NULL
+       name STRING NOT NULL,
+       deleted_at TIMESTAMP
 );
 
 CREATE TABLE teams (
@@ -47,8 +76,16 @@ CREATE TABLE teams (
        institution UUID NOT NULL REFERENCES institutions (id),
        division UUID NOT NULL REFERENCES divisions (id),
        import_id STRING,
+       competition UUID NOT NULL REFERENCES competitions (id),
+       version INT NOT NULL DEFAULT 1,
+       created_by UUID REFERENCES users (id),
+       created_at TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       idempotency_key STRING,
+       deleted_at TIMESTAMP,
        INDEX (institution),
-       INDEX (division)
+       INDEX (division),
+       INDEX (competition),
+       INDEX (created_by, idempotency_key)
 );
 
 CREATE TABLE team_members (
@@ -65,15 +102,24 @@ CREATE TABLE score_sheets (
        division UUID NOT NULL REFERENCES divisions (id),
        team UUID NOT NULL REFERENCES teams (id),
        template UUID NOT NULL REFERENCES score_sheet_templates (id),
+       template_version INT NOT NULL DEFAULT 1,
        timings JSONB NOT NULL DEFAULT json_build_array(),
        author UUID NOT NULL REFERENCES users (id),
        comments STRING NOT NULL,
        round INT NOT NULL DEFAULT 0,
+       status STRING NOT NULL DEFAULT 'Draft' CHECK (status IN ('Draft', 'Submitted', 'Locked')),
+       version INT NOT NULL DEFAULT 1,
+       idempotency_key STRING,
+       edited_at TIMESTAMP,
        created_at TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       updated_at TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       deleted_at TIMESTAMP,
        INDEX (division),
        INDEX (template),
        INDEX (team),
-       INDEX (author)
+       INDEX (author),
+       INDEX (author, idempotency_key),
+       INDEX (author, updated_at)
 );
 
 CREATE TABLE score_sheet_sections (
@@ -84,14 +130,52 @@ CREATE TABLE score_sheet_sections (
        INDEX (section)
 );
 
+CREATE TABLE score_sheet_revisions (
+       id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+       score_sheet UUID NOT NULL REFERENCES score_sheets (id),
+       version INT NOT NULL,
+       saved_by UUID REFERENCES users (id),
+       saved_at TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       snapshot JSONB NOT NULL,
+       UNIQUE INDEX (score_sheet, version)
+);
+
+CREATE TABLE round_locks (
+       id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+       division UUID NOT NULL REFERENCES divisions (id),
+       round INT NOT NULL,
+       locked_by UUID NOT NULL REFERENCES users (id),
+       locked_at TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       unlocked_by UUID REFERENCES users (id),
+       unlocked_at TIMESTAMP,
+       unlock_reason STRING,
+       INDEX (division, round)
+);
+
 CREATE TABLE team_checkins (
        id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
        team UUID NOT NULL REFERENCES teams (id),
        agent UUID NOT NULL REFERENCES users (id),
        comments string NOT NULL,
        in_time TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       competition UUID NOT NULL REFERENCES competitions (id),
+       idempotency_key STRING,
+       deleted_at TIMESTAMP,
        INDEX (team),
-       INDEX (agent)
+       INDEX (agent),
+       INDEX (competition),
+       INDEX (agent, idempotency_key)
+);
+
+CREATE TABLE judge_assignments (
+       id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+       judge UUID NOT NULL REFERENCES users (id),
+       division UUID NOT NULL REFERENCES divisions (id),
+       round INT,
+       competition UUID NOT NULL REFERENCES competitions (id),
+       INDEX (judge),
+       INDEX (division),
+       INDEX (competition)
 );
 
 CREATE TABLE sheet_token (
@@ -99,3 +183,60 @@ CREATE TABLE sheet_token (
        token string NOT NULL,
        token_type string NOT NULL DEFAULT 'auth'
 );
+
+
+CREATE TABLE sessions (
+       id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+       user_id UUID NOT NULL REFERENCES users (id),
+       token_hash STRING NOT NULL,
+       created_at TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       expires_at TIMESTAMP NOT NULL,
+       revoked_at TIMESTAMP,
+       competition UUID REFERENCES competitions (id),
+       UNIQUE INDEX (token_hash),
+       INDEX (user_id)
+);
+
+CREATE TABLE audit_log (
+       id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+       actor UUID REFERENCES users (id),
+       method STRING NOT NULL,
+       entity_type STRING NOT NULL,
+       entity_id STRING NOT NULL,
+       before JSONB,
+       after JSONB,
+       created_at TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       INDEX (entity_type, entity_id),
+       INDEX (actor),
+       INDEX (created_at)
+);
+
+CREATE TABLE login_attempts (
+       key STRING PRIMARY KEY,
+       failures INT NOT NULL DEFAULT 0,
+       last_failure_at TIMESTAMP,
+       locked_until TIMESTAMP,
+       pending INT NOT NULL DEFAULT 0,
+       pending_at TIMESTAMP,
+       INDEX (locked_until)
+);
+
+CREATE TABLE api_keys (
+       id UUID PRIMARY KEY DEFAULT gen_random_uuid(),
+       name STRING NOT NULL,
+       key_hash STRING NOT NULL,
+       scopes STRING[] NOT NULL,
+       created_by UUID NOT NULL REFERENCES users (id),
+       created_at TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       revoked_at TIMESTAMP,
+       UNIQUE INDEX (key_hash)
+);
+
+CREATE TABLE user_identities (
+       provider STRING NOT NULL,
+       subject STRING NOT NULL,
+       user_id UUID NOT NULL REFERENCES users (id),
+       created_at TIMESTAMP NOT NULL DEFAULT current_timestamp(),
+       PRIMARY KEY (provider, subject),
+       INDEX (user_id)
+);